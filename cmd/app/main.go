@@ -57,6 +57,7 @@ func main() {
 				apptypes.WithConfig(cfg.Gateway),
 				apptypes.WithServiceID(cfg.ID),
 				apptypes.WithServiceName(cfg.Name),
+				apptypes.WithDataDir(cfg.DataDir),
 				apptypes.WithAuthUseCase(svc.Auth()),
 				apptypes.WithWardenUseCase(a.warden),
 				apptypes.WithMongoUseCase(svc.Mongo()),
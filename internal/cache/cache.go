@@ -0,0 +1,321 @@
+package cache
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+)
+
+// State describes the freshness of a cached entry at the time it was looked up
+type State int
+
+const (
+	Miss State = iota
+	Fresh
+	Stale
+	Expired
+)
+
+// headersNotStored are never replayed from the cache, they are either hop by hop or re-computed by the gateway
+var headersNotStored = []string{
+	"Cache-Control", "Connection", "Content-Encoding", "Content-Length", "Date", "Etag", "Keep-Alive",
+	"Set-Cookie", "Transfer-Encoding",
+}
+
+type (
+	// Entry is a cached upstream response for a single module asset
+	Entry struct {
+		Key          string
+		AppID        string
+		Version      string
+		URI          string
+		Status       int
+		Header       http.Header
+		Body         []byte
+		ETag         string
+		UpstreamETag string
+		LastModified string
+		StoredAt     time.Time
+		Expires      time.Time
+		StaleUntil   time.Time
+	}
+
+	// AssetCache caches module assets in memory within a size budget and optionally on disk, entries are keyed by
+	// app, app version and uri so that registering a new version of an app never serves old assets
+	AssetCache struct {
+		*Policy
+		log          zerolog.Logger
+		mu           sync.Mutex
+		ll           *list.List
+		items        map[string]*list.Element
+		size         int64
+		budget       int64
+		disk         *diskStore
+		versions     map[string]string
+		revalidating map[string]bool
+	}
+)
+
+// String returns the value reported in the cache status header
+func (s State) String() string {
+	switch s {
+	case Fresh:
+		return "HIT"
+	case Stale:
+		return "STALE"
+	case Expired:
+		return "REVALIDATED"
+	default:
+		return "MISS"
+	}
+}
+
+// Lookup returns a cached entry and its freshness, a change of app version purges all entries for the app
+func (c *AssetCache) Lookup(appID, version, uri string) (*Entry, State) {
+	c.observeVersion(appID, version)
+
+	key := c.key(appID, version, uri)
+
+	c.mu.Lock()
+	el, ok := c.items[key]
+	if ok {
+		c.ll.MoveToFront(el)
+	}
+	c.mu.Unlock()
+
+	var e *Entry
+	if ok {
+		e = el.Value.(*Entry)
+	} else if c.disk != nil {
+		if e = c.disk.read(appID, key); e != nil {
+			c.add(e)
+		}
+	}
+
+	if e == nil {
+		return nil, Miss
+	}
+
+	now := time.Now()
+	switch {
+	case now.Before(e.Expires):
+		return e, Fresh
+	case now.Before(e.StaleUntil):
+		return e, Stale
+	default:
+		return e, Expired
+	}
+}
+
+// Store caches an upstream response if the policy allows it, returns the stored entry
+func (c *AssetCache) Store(appID, version, uri string, status int, header http.Header, body []byte) (*Entry, bool) {
+	if status != http.StatusOK {
+		return nil, false
+	}
+
+	ttl, stale, ok := c.Freshness(uri, header)
+	if !ok {
+		return nil, false
+	}
+
+	c.observeVersion(appID, version)
+
+	now := time.Now()
+	sum := sha256.Sum256(body)
+	e := &Entry{
+		Key:          c.key(appID, version, uri),
+		AppID:        appID,
+		Version:      version,
+		URI:          uri,
+		Status:       status,
+		Header:       header.Clone(),
+		Body:         body,
+		ETag:         `"` + hex.EncodeToString(sum[:16]) + `"`,
+		UpstreamETag: header.Get("ETag"),
+		LastModified: header.Get("Last-Modified"),
+		StoredAt:     now,
+		Expires:      now.Add(ttl),
+		StaleUntil:   now.Add(ttl + stale),
+	}
+
+	for _, h := range headersNotStored {
+		e.Header.Del(h)
+	}
+
+	c.add(e)
+
+	if c.disk != nil {
+		go c.disk.write(e)
+	}
+
+	return e, true
+}
+
+// Refresh extends the lifetime of an entry after the upstream confirmed that it has not changed
+func (c *AssetCache) Refresh(e *Entry, header http.Header) *Entry {
+	merged := e.Header.Clone()
+	if cc := header.Get("Cache-Control"); cc != "" {
+		merged.Set("Cache-Control", cc)
+	}
+
+	ttl, stale, ok := c.Freshness(e.URI, merged)
+	if !ok {
+		ttl, stale = 0, 0
+	}
+
+	now := time.Now()
+	refreshed := *e
+	refreshed.StoredAt = now
+	refreshed.Expires = now.Add(ttl)
+	refreshed.StaleUntil = now.Add(ttl + stale)
+
+	c.add(&refreshed)
+
+	if c.disk != nil {
+		go c.disk.write(&refreshed)
+	}
+
+	return &refreshed
+}
+
+// BeginRevalidation marks an entry as being revalidated, returns false if a revalidation is already in flight
+func (c *AssetCache) BeginRevalidation(e *Entry) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.revalidating[e.Key] {
+		return false
+	}
+
+	c.revalidating[e.Key] = true
+
+	return true
+}
+
+// EndRevalidation clears the in flight revalidation marker
+func (c *AssetCache) EndRevalidation(e *Entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.revalidating, e.Key)
+}
+
+// Purge removes all cached entries for an app from memory and disk
+func (c *AssetCache) Purge(appID string) {
+	c.mu.Lock()
+	for el := c.ll.Front(); el != nil; {
+		next := el.Next()
+		if e := el.Value.(*Entry); e.AppID == appID {
+			c.removeElement(el)
+		}
+		el = next
+	}
+	c.mu.Unlock()
+
+	if c.disk != nil {
+		c.disk.purge(appID)
+	}
+
+	c.log.Info().Str("app", appID).Msgf("purged cached assets")
+}
+
+// observeVersion purges all entries of an app when a different version of the app is seen
+func (c *AssetCache) observeVersion(appID, version string) {
+	c.mu.Lock()
+	previous, known := c.versions[appID]
+	c.versions[appID] = version
+	c.mu.Unlock()
+
+	if known && previous != version {
+		c.log.Info().Str("app", appID).Str("from", previous).Str("to", version).Msgf("app version changed")
+		c.Purge(appID)
+	}
+}
+
+// add inserts or replaces an entry in the memory tier and evicts the least recently used entries over budget
+func (c *AssetCache) add(e *Entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[e.Key]; ok {
+		c.removeElement(el)
+	}
+
+	if entrySize(e) > c.budget {
+		return
+	}
+
+	c.items[e.Key] = c.ll.PushFront(e)
+	c.size += entrySize(e)
+
+	for c.size > c.budget {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// removeElement removes an element from the memory tier, the lock must be held
+func (c *AssetCache) removeElement(el *list.Element) {
+	e := el.Value.(*Entry)
+	c.ll.Remove(el)
+	delete(c.items, e.Key)
+	c.size -= entrySize(e)
+}
+
+// key generates the cache key for an asset
+func (c *AssetCache) key(appID, version, uri string) string {
+	return appID + "\x00" + version + "\x00" + uri
+}
+
+// entrySize approximates the memory used by an entry
+func entrySize(e *Entry) int64 {
+	size := int64(len(e.Body) + len(e.Key))
+	for k, v := range e.Header {
+		size += int64(len(k))
+		for _, s := range v {
+			size += int64(len(s))
+		}
+	}
+
+	return size
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// NewAssetCache creates the asset cache using the given policy, returns nil if caching is disabled
+func NewAssetCache(cfg *apptypes.AssetCacheConfig, policy *Policy, dataDir string, log zerolog.Logger) *AssetCache {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	c := &AssetCache{
+		Policy:       policy,
+		log:          log,
+		ll:           list.New(),
+		items:        make(map[string]*list.Element),
+		budget:       apptypes.DefaultAssetCacheMemory,
+		versions:     make(map[string]string),
+		revalidating: make(map[string]bool),
+	}
+
+	if cfg.MaxMemoryBytes > 0 {
+		c.budget = cfg.MaxMemoryBytes
+	}
+
+	if cfg.Disk {
+		if dataDir == "" {
+			log.Warn().Msgf("asset disk cache requires a data directory, only caching in memory")
+		} else {
+			c.disk = newDiskStore(dataDir, log)
+		}
+	}
+
+	return c
+}
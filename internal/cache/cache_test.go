@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAssetCache creates an enabled asset cache with the default policy
+func newAssetCache(t *testing.T, cfg *apptypes.AssetCacheConfig, dataDir string) *AssetCache {
+	t.Helper()
+
+	cfg.Enabled = true
+
+	c := NewAssetCache(cfg, newPolicy(t, cfg), dataDir, zerolog.Nop())
+	require.NotNil(t, c)
+
+	return c
+}
+
+func TestAssetCacheStates(t *testing.T) {
+	tests := []struct {
+		name         string
+		uri          string
+		cacheControl string
+		want         State
+	}{
+		{name: "fresh", uri: "/app/app/remoteEntry.js", cacheControl: "max-age=60", want: Fresh},
+		{name: "hashed chunks stay fresh", uri: "/app/app/main.0a1b2c3d4e.js", cacheControl: "no-cache", want: Fresh},
+		{name: "stale", uri: "/app/app/remoteEntry.js", cacheControl: "max-age=0, stale-while-revalidate=60", want: Stale},
+		{name: "expired", uri: "/app/app/remoteEntry.js", cacheControl: "max-age=0, stale-while-revalidate=0", want: Expired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newAssetCache(t, &apptypes.AssetCacheConfig{}, "")
+
+			header := http.Header{"Cache-Control": []string{tt.cacheControl}}
+			_, ok := c.Store("app", "1.0.0", tt.uri, http.StatusOK, header, []byte("body"))
+			require.True(t, ok)
+
+			e, state := c.Lookup("app", "1.0.0", tt.uri)
+			require.NotNil(t, e)
+			assert.Equal(t, tt.want, state)
+			assert.Equal(t, []byte("body"), e.Body)
+		})
+	}
+}
+
+func TestAssetCacheStore_NotCacheable(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		cacheControl string
+	}{
+		{name: "error status", status: http.StatusNotFound},
+		{name: "no store", status: http.StatusOK, cacheControl: "no-store"},
+		{name: "private", status: http.StatusOK, cacheControl: "private"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newAssetCache(t, &apptypes.AssetCacheConfig{}, "")
+
+			header := http.Header{"Cache-Control": []string{tt.cacheControl}}
+			_, ok := c.Store("app", "1.0.0", "/app/app/remoteEntry.js", tt.status, header, []byte("body"))
+			assert.False(t, ok)
+
+			_, state := c.Lookup("app", "1.0.0", "/app/app/remoteEntry.js")
+			assert.Equal(t, Miss, state)
+		})
+	}
+}
+
+func TestAssetCacheETag(t *testing.T) {
+	c := newAssetCache(t, &apptypes.AssetCacheConfig{}, "")
+	header := http.Header{
+		"Cache-Control": []string{"max-age=0, stale-while-revalidate=0"},
+		"Etag":          []string{`"upstream"`},
+		"Last-Modified": []string{"Mon, 19 Oct 2026 10:00:00 GMT"},
+		"Set-Cookie":    []string{"session=1"},
+	}
+
+	first, ok := c.Store("app", "1.0.0", "/app/app/remoteEntry.js", http.StatusOK, header, []byte("v1"))
+	require.True(t, ok)
+	assert.Equal(t, `"upstream"`, first.UpstreamETag)
+	assert.Equal(t, "Mon, 19 Oct 2026 10:00:00 GMT", first.LastModified)
+	assert.Empty(t, first.Header.Get("Set-Cookie"), "headers that must not be replayed are dropped")
+
+	same, _ := c.Store("app", "1.0.0", "/app/app/other.js", http.StatusOK, header, []byte("v1"))
+	changed, _ := c.Store("app", "1.0.0", "/app/app/remoteEntry.js", http.StatusOK, header, []byte("v2"))
+	assert.Equal(t, first.ETag, same.ETag, "the etag only depends on the content")
+	assert.NotEqual(t, first.ETag, changed.ETag)
+
+	// the upstream confirmed that the entry did not change, it keeps its etag and becomes fresh again
+	_, state := c.Lookup("app", "1.0.0", "/app/app/remoteEntry.js")
+	require.Equal(t, Expired, state)
+
+	refreshed := c.Refresh(changed, http.Header{"Cache-Control": []string{"max-age=60"}})
+	assert.Equal(t, changed.ETag, refreshed.ETag)
+
+	e, state := c.Lookup("app", "1.0.0", "/app/app/remoteEntry.js")
+	assert.Equal(t, Fresh, state)
+	assert.Equal(t, []byte("v2"), e.Body)
+}
+
+func TestAssetCacheVersionChangePurges(t *testing.T) {
+	c := newAssetCache(t, &apptypes.AssetCacheConfig{}, "")
+
+	_, ok := c.Store("app", "1.0.0", "/app/app/remoteEntry.js", http.StatusOK, http.Header{}, []byte("v1"))
+	require.True(t, ok)
+
+	_, state := c.Lookup("app", "2.0.0", "/app/app/remoteEntry.js")
+	assert.Equal(t, Miss, state)
+
+	_, state = c.Lookup("app", "1.0.0", "/app/app/remoteEntry.js")
+	assert.Equal(t, Miss, state, "entries of the previous version must be purged")
+}
+
+func TestAssetCacheMemoryBudget(t *testing.T) {
+	c := newAssetCache(t, &apptypes.AssetCacheConfig{MaxMemoryBytes: 100}, "")
+
+	for _, uri := range []string{"/a.js", "/b.js", "/c.js"} {
+		_, ok := c.Store("app", "1.0.0", uri, http.StatusOK, http.Header{}, make([]byte, 40))
+		require.True(t, ok)
+	}
+
+	_, state := c.Lookup("app", "1.0.0", "/a.js")
+	assert.Equal(t, Miss, state, "the least recently used entry is evicted")
+
+	_, state = c.Lookup("app", "1.0.0", "/c.js")
+	assert.Equal(t, Fresh, state)
+}
+
+func TestAssetCacheDisk(t *testing.T) {
+	var (
+		dir = t.TempDir()
+		cfg = &apptypes.AssetCacheConfig{Disk: true}
+		uri = "/app/app/main.0a1b2c3d4e.js"
+	)
+
+	c := newAssetCache(t, cfg, dir)
+	stored, ok := c.Store("app", "1.0.0", uri, http.StatusOK, http.Header{}, []byte("chunk"))
+	require.True(t, ok)
+
+	// the disk write is asynchronous, a new cache on the same directory simulates a restart
+	require.Eventually(t, func() bool {
+		_, state := newAssetCache(t, cfg, dir).Lookup("app", "1.0.0", uri)
+		return state == Fresh
+	}, time.Second*5, time.Millisecond*10)
+
+	restarted := newAssetCache(t, cfg, dir)
+	e, state := restarted.Lookup("app", "1.0.0", uri)
+	require.Equal(t, Fresh, state)
+	assert.Equal(t, stored.ETag, e.ETag)
+	assert.Equal(t, []byte("chunk"), e.Body)
+
+	restarted.Purge("app")
+
+	_, state = newAssetCache(t, cfg, dir).Lookup("app", "1.0.0", uri)
+	assert.Equal(t, Miss, state, "purged entries are removed from disk")
+}
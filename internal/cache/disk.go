@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog"
+)
+
+const dirPermission = os.FileMode(0o755)
+
+type (
+	// diskStore persists cached entries under the data directory so they survive restarts, entries are grouped
+	// by app so that an app can be purged by removing its directory
+	diskStore struct {
+		root string
+		log  zerolog.Logger
+	}
+)
+
+// read loads an entry from disk, returns nil if it does not exist or can not be decoded
+func (d *diskStore) read(appID, key string) *Entry {
+	return d.readFile(appID, d.path(appID, key))
+}
+
+// write persists an entry
+func (d *diskStore) write(e *Entry) {
+	d.writeFile(d.path(e.AppID, e.Key), e)
+}
+
+// readFile loads an entry from the given file, returns nil if it does not exist or can not be decoded
+func (d *diskStore) readFile(appID, p string) *Entry {
+	f, err := os.Open(p)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			d.log.Warn().Err(err).Str("app", appID).Msgf("failed to open cached asset")
+		}
+		return nil
+	}
+	defer f.Close()
+
+	var e Entry
+	if err := gob.NewDecoder(f).Decode(&e); err != nil {
+		d.log.Warn().Err(err).Str("app", appID).Msgf("failed to decode cached asset")
+		return nil
+	}
+
+	return &e
+}
+
+// writeFile persists an entry to the given file, it is written to a temporary location first so readers never see
+// partial files
+func (d *diskStore) writeFile(p string, e *Entry) {
+	if err := os.MkdirAll(filepath.Dir(p), dirPermission); err != nil {
+		d.log.Warn().Err(err).Str("app", e.AppID).Msgf("failed to create asset cache directory")
+		return
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(p), "tmp-*")
+	if err != nil {
+		d.log.Warn().Err(err).Str("app", e.AppID).Msgf("failed to create cached asset")
+		return
+	}
+
+	if err = gob.NewEncoder(f).Encode(e); err == nil {
+		err = f.Close()
+	} else {
+		_ = f.Close()
+	}
+
+	if err == nil {
+		err = os.Rename(f.Name(), p)
+	}
+
+	if err != nil {
+		d.log.Warn().Err(err).Str("app", e.AppID).Msgf("failed to write cached asset")
+		_ = os.Remove(f.Name())
+	}
+}
+
+// purge removes all entries for an app
+func (d *diskStore) purge(appID string) {
+	if err := os.RemoveAll(d.appDir(appID)); err != nil {
+		d.log.Warn().Err(err).Str("app", appID).Msgf("failed to purge cached assets from disk")
+	}
+}
+
+// appDir returns the directory for an app, the id is hashed so it is always a safe path segment
+func (d *diskStore) appDir(appID string) string {
+	return filepath.Join(d.root, hash(appID))
+}
+
+// path returns the file path of an entry
+func (d *diskStore) path(appID, key string) string {
+	return filepath.Join(d.appDir(appID), hash(key))
+}
+
+// hash returns a file system safe representation of a value
+func hash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func newDiskStore(dataDir string, log zerolog.Logger) *diskStore {
+	return &diskStore{
+		root: filepath.Join(dataDir, "cache", "assets"),
+		log:  log,
+	}
+}
@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+)
+
+type (
+	// Fallback keeps the last successfully served assets of every app on disk, when an app goes down these are
+	// served in a degraded mode so that pages which were already loaded keep working. Assets are stored per app
+	// version and only the latest version seen is kept, storing assets for a new version purges the previous one
+	Fallback struct {
+		policy   *Policy
+		disk     *diskStore
+		log      zerolog.Logger
+		mu       sync.Mutex
+		versions map[string]string
+	}
+)
+
+// Save stores a successfully served asset, content hashed assets are only written once. Every asset is written to
+// its own file through a temporary file and a rename so saves of different assets never wait for each other
+func (f *Fallback) Save(appID, version, uri string, header http.Header, body []byte) {
+	f.useVersion(appID, version)
+
+	p := f.path(appID, version, uri)
+	if f.policy.IsHashed(uri) {
+		if _, err := os.Stat(p); err == nil {
+			return
+		}
+	}
+
+	e := &Entry{
+		Key:      uri,
+		AppID:    appID,
+		Version:  version,
+		URI:      uri,
+		Status:   http.StatusOK,
+		Header:   header.Clone(),
+		Body:     body,
+		StoredAt: time.Now(),
+	}
+
+	for _, h := range headersNotStored {
+		e.Header.Del(h)
+	}
+
+	f.disk.writeFile(p, e)
+}
+
+// Load returns the last known good copy of an asset for the given app version, nil if there is none
+func (f *Fallback) Load(appID, version, uri string) *Entry {
+	return f.disk.readFile(appID, f.path(appID, version, uri))
+}
+
+// useVersion records the latest version of an app, when it changes the assets of all other versions are purged
+func (f *Fallback) useVersion(appID, version string) {
+	f.mu.Lock()
+	previous := f.versions[appID]
+	f.versions[appID] = version
+	f.mu.Unlock()
+
+	if previous == version {
+		return
+	}
+
+	entries, err := os.ReadDir(f.disk.appDir(appID))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			f.log.Warn().Err(err).Str("app", appID).Msgf("failed to list fallback assets")
+		}
+		return
+	}
+
+	keep := hash(version)
+	for _, entry := range entries {
+		if entry.Name() == keep {
+			continue
+		}
+
+		if err := os.RemoveAll(filepath.Join(f.disk.appDir(appID), entry.Name())); err != nil {
+			f.log.Warn().Err(err).Str("app", appID).Msgf("failed to purge fallback assets")
+		}
+	}
+}
+
+// path returns the file of an asset, assets are grouped by app and version
+func (f *Fallback) path(appID, version, uri string) string {
+	return filepath.Join(f.disk.appDir(appID), hash(version), hash(uri))
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// NewFallback creates the last known good asset store, returns nil if it is disabled or there is no data directory
+func NewFallback(cfg *apptypes.FallbackConfig, policy *Policy, dataDir string, log zerolog.Logger) *Fallback {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	if dataDir == "" {
+		log.Warn().Msgf("fallback assets require a data directory, fallback serving is disabled")
+		return nil
+	}
+
+	return &Fallback{
+		policy:   policy,
+		disk:     &diskStore{root: filepath.Join(dataDir, "fallback"), log: log},
+		log:      log,
+		versions: make(map[string]string),
+	}
+}
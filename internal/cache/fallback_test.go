@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFallbackKeepsLatestVersion(t *testing.T) {
+	f := NewFallback(&apptypes.FallbackConfig{Enabled: true}, newPolicy(t, nil), t.TempDir(), zerolog.Nop())
+	require.NotNil(t, f)
+
+	f.Save("app", "1.0.0", "/app/app/remoteEntry.js", http.Header{}, []byte("v1"))
+	require.NotNil(t, f.Load("app", "1.0.0", "/app/app/remoteEntry.js"))
+
+	f.Save("app", "2.0.0", "/app/app/main.js", http.Header{}, []byte("v2"))
+
+	assert.Nil(t, f.Load("app", "1.0.0", "/app/app/remoteEntry.js"), "assets of the previous version must be purged")
+	assert.Nil(t, f.Load("app", "2.0.0", "/app/app/remoteEntry.js"), "assets are never served for another version")
+
+	e := f.Load("app", "2.0.0", "/app/app/main.js")
+	require.NotNil(t, e)
+	assert.Equal(t, "2.0.0", e.Version)
+	assert.Equal(t, []byte("v2"), e.Body)
+}
+
+func TestFallbackSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+
+	f := NewFallback(&apptypes.FallbackConfig{Enabled: true}, newPolicy(t, nil), dir, zerolog.Nop())
+	f.Save("app", "1.0.0", "/app/app/remoteEntry.js", http.Header{"Set-Cookie": []string{"a=b"}}, []byte("v1"))
+
+	restarted := NewFallback(&apptypes.FallbackConfig{Enabled: true}, newPolicy(t, nil), dir, zerolog.Nop())
+	e := restarted.Load("app", "1.0.0", "/app/app/remoteEntry.js")
+	require.NotNil(t, e)
+	assert.Equal(t, []byte("v1"), e.Body)
+	assert.Empty(t, e.Header.Get("Set-Cookie"))
+
+	// the first save after a restart purges versions stored by the previous run
+	restarted.Save("app", "2.0.0", "/app/app/remoteEntry.js", http.Header{}, []byte("v2"))
+	assert.Nil(t, restarted.Load("app", "1.0.0", "/app/app/remoteEntry.js"))
+}
+
+func TestFallbackConcurrentSaves(t *testing.T) {
+	f := NewFallback(&apptypes.FallbackConfig{Enabled: true}, newPolicy(t, nil), t.TempDir(), zerolog.Nop())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		uri := fmt.Sprintf("/app/app/chunk-%d.js", i%10)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f.Save("app", "1.0.0", uri, http.Header{}, []byte(uri))
+		}()
+	}
+	wg.Wait()
+
+	// assets saved concurrently, including repeated saves of the same asset, are complete
+	for i := 0; i < 10; i++ {
+		uri := fmt.Sprintf("/app/app/chunk-%d.js", i)
+
+		e := f.Load("app", "1.0.0", uri)
+		require.NotNil(t, e, uri)
+		assert.Equal(t, uri, string(e.Body))
+	}
+}
@@ -0,0 +1,159 @@
+package cache
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+)
+
+type (
+	// Policy decides how long module assets may be cached by the gateway and what caching headers are sent to
+	// clients, content hashed chunks never change so they are immutable while entry files must be revalidated
+	Policy struct {
+		entryMaxAge          time.Duration
+		staleWhileRevalidate time.Duration
+		immutableMaxAge      time.Duration
+		hashed               *regexp.Regexp
+	}
+
+	cacheControl map[string]string
+)
+
+// Freshness returns how long a response stays fresh and how long after that it may be served stale while it is
+// revalidated, ok is false if the upstream does not allow the response to be stored
+func (p *Policy) Freshness(uri string, header http.Header) (ttl, stale time.Duration, ok bool) {
+	cc := parseCacheControl(header.Get("Cache-Control"))
+	if cc.has("no-store") || cc.has("private") {
+		return 0, 0, false
+	}
+
+	if p.IsHashed(uri) {
+		return p.immutableMaxAge, 0, true
+	}
+
+	ttl, stale = p.entryMaxAge, p.staleWhileRevalidate
+
+	if age, found := cc.seconds("s-maxage"); found {
+		ttl = age
+	} else if age, found := cc.seconds("max-age"); found {
+		ttl = age
+	}
+
+	if cc.has("no-cache") {
+		ttl = 0
+	}
+
+	if swr, found := cc.seconds("stale-while-revalidate"); found {
+		stale = swr
+	}
+
+	return ttl, stale, true
+}
+
+// ClientCacheControl returns the cache control header sent to clients, hashed chunks are immutable while everything
+// else must be revalidated by the browser which is cheap because the gateway answers with a 304
+func (p *Policy) ClientCacheControl(uri string, upstream http.Header) string {
+	cc := parseCacheControl(upstream.Get("Cache-Control"))
+	if cc.has("no-store") || cc.has("private") {
+		return upstream.Get("Cache-Control")
+	}
+
+	if p.IsHashed(uri) {
+		return fmt.Sprintf("public, max-age=%d, immutable", int(p.immutableMaxAge.Seconds()))
+	}
+
+	return "no-cache"
+}
+
+// IsHashed returns true if the file name contains a content hash
+func (p *Policy) IsHashed(uri string) bool {
+	if i := strings.IndexAny(uri, "?#"); i > -1 {
+		uri = uri[:i]
+	}
+
+	return p.hashed.MatchString(path.Base(uri))
+}
+
+// has returns true if the directive is present
+func (c cacheControl) has(directive string) bool {
+	_, ok := c[directive]
+	return ok
+}
+
+// seconds returns the value of a directive as a duration
+func (c cacheControl) seconds(directive string) (time.Duration, bool) {
+	v, ok := c[directive]
+	if !ok {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+
+	return time.Duration(n) * time.Second, true
+}
+
+// parseCacheControl parses a cache control header into its directives
+func parseCacheControl(value string) cacheControl {
+	cc := cacheControl{}
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		k, v, _ := strings.Cut(part, "=")
+		cc[strings.ToLower(strings.TrimSpace(k))] = strings.Trim(strings.TrimSpace(v), `"`)
+	}
+
+	return cc
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// NewPolicy creates the caching policy, returns an error if the configured hashed asset pattern does not compile
+func NewPolicy(cfg *apptypes.AssetCacheConfig) (*Policy, error) {
+	p := &Policy{
+		entryMaxAge:          apptypes.DefaultAssetEntryMaxAge,
+		staleWhileRevalidate: apptypes.DefaultAssetStaleWhileRevalidate,
+		immutableMaxAge:      apptypes.DefaultAssetImmutableMaxAge,
+		hashed:               regexp.MustCompile(apptypes.DefaultHashedAssetPattern),
+	}
+
+	if cfg == nil {
+		return p, nil
+	}
+
+	if cfg.EntryMaxAge > 0 {
+		p.entryMaxAge = cfg.EntryMaxAge
+	}
+
+	if cfg.StaleWhileRevalidate > 0 {
+		p.staleWhileRevalidate = cfg.StaleWhileRevalidate
+	}
+
+	if cfg.ImmutableMaxAge > 0 {
+		p.immutableMaxAge = cfg.ImmutableMaxAge
+	}
+
+	if cfg.HashedAssetPattern != "" {
+		hashed, err := regexp.Compile(cfg.HashedAssetPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hashed asset pattern: %w", err)
+		}
+		p.hashed = hashed
+	}
+
+	return p, nil
+}
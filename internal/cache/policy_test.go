@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPolicy creates a policy that must be valid
+func newPolicy(t *testing.T, cfg *apptypes.AssetCacheConfig) *Policy {
+	t.Helper()
+
+	p, err := NewPolicy(cfg)
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestNewPolicy_InvalidPattern(t *testing.T) {
+	_, err := NewPolicy(&apptypes.AssetCacheConfig{HashedAssetPattern: "[a-"})
+	require.Error(t, err)
+}
+
+func TestPolicyFreshness(t *testing.T) {
+	p := newPolicy(t, &apptypes.AssetCacheConfig{
+		EntryMaxAge:          time.Second * 5,
+		StaleWhileRevalidate: time.Second * 30,
+		ImmutableMaxAge:      time.Hour,
+	})
+
+	tests := []struct {
+		name         string
+		uri          string
+		cacheControl string
+		ttl          time.Duration
+		stale        time.Duration
+		ok           bool
+	}{
+		{name: "hashed chunks are immutable", uri: "/app/app/main.0a1b2c3d4e.js", ttl: time.Hour, ok: true},
+		{name: "hashed chunks ignore the upstream max age", uri: "/app/app/main.0a1b2c3d4e.js?v=1", cacheControl: "max-age=10", ttl: time.Hour, ok: true},
+		{name: "entry files use the configured defaults", uri: "/app/app/remoteEntry.js", ttl: time.Second * 5, stale: time.Second * 30, ok: true},
+		{name: "max age", uri: "/app/app/remoteEntry.js", cacheControl: "public, max-age=60", ttl: time.Minute, stale: time.Second * 30, ok: true},
+		{name: "shared max age wins", uri: "/app/app/remoteEntry.js", cacheControl: "max-age=60, s-maxage=120", ttl: time.Minute * 2, stale: time.Second * 30, ok: true},
+		{name: "no cache is revalidated straight away", uri: "/app/app/remoteEntry.js", cacheControl: "no-cache, max-age=60", stale: time.Second * 30, ok: true},
+		{name: "stale while revalidate", uri: "/app/app/remoteEntry.js", cacheControl: "max-age=1, stale-while-revalidate=90", ttl: time.Second, stale: time.Second * 90, ok: true},
+		{name: "invalid max age is ignored", uri: "/app/app/remoteEntry.js", cacheControl: "max-age=-1", ttl: time.Second * 5, stale: time.Second * 30, ok: true},
+		{name: "no store", uri: "/app/app/main.0a1b2c3d4e.js", cacheControl: "no-store"},
+		{name: "private", uri: "/app/app/remoteEntry.js", cacheControl: "private, max-age=60"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.cacheControl != "" {
+				header.Set("Cache-Control", tt.cacheControl)
+			}
+
+			ttl, stale, ok := p.Freshness(tt.uri, header)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.ttl, ttl)
+			assert.Equal(t, tt.stale, stale)
+		})
+	}
+}
+
+func TestPolicyClientCacheControl(t *testing.T) {
+	p := newPolicy(t, &apptypes.AssetCacheConfig{ImmutableMaxAge: time.Hour})
+
+	tests := []struct {
+		name     string
+		uri      string
+		upstream string
+		want     string
+	}{
+		{name: "hashed chunk", uri: "/app/app/main.0a1b2c3d4e.js", want: "public, max-age=3600, immutable"},
+		{name: "entry file", uri: "/app/app/remoteEntry.js", upstream: "max-age=60", want: "no-cache"},
+		{name: "private responses are passed through", uri: "/app/app/main.0a1b2c3d4e.js", upstream: "private", want: "private"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.upstream != "" {
+				header.Set("Cache-Control", tt.upstream)
+			}
+
+			assert.Equal(t, tt.want, p.ClientCacheControl(tt.uri, header))
+		})
+	}
+}
+
+func TestPolicyCustomPattern(t *testing.T) {
+	p := newPolicy(t, &apptypes.AssetCacheConfig{HashedAssetPattern: `^chunk-`})
+
+	assert.True(t, p.IsHashed("/app/app/chunk-main.js"))
+	assert.False(t, p.IsHashed("/app/app/main.0a1b2c3d4e.js"))
+}
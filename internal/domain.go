@@ -1,21 +1,31 @@
 package internal
 
 import (
+	"encoding/json"
+	"fmt"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"path"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/azarc-io/verathread-gateway/internal/cache"
 	pvtgraph "github.com/azarc-io/verathread-gateway/internal/gql/graph/private"
 	pvtresolvers "github.com/azarc-io/verathread-gateway/internal/gql/graph/private/resolvers"
 	pubgraph "github.com/azarc-io/verathread-gateway/internal/gql/graph/public"
 	pubresolvers "github.com/azarc-io/verathread-gateway/internal/gql/graph/public/resolvers"
 	middleware2 "github.com/azarc-io/verathread-gateway/internal/middleware"
+	"github.com/azarc-io/verathread-gateway/internal/preferences"
+	"github.com/azarc-io/verathread-gateway/internal/registry"
 	"github.com/azarc-io/verathread-gateway/internal/service"
+	"github.com/azarc-io/verathread-gateway/internal/supervisor"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
 	graphqluc "github.com/azarc-io/verathread-next-common/usecase/graphql"
 	"github.com/erni27/imcache"
+	"github.com/nats-io/nats.go"
 
 	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
 
@@ -40,6 +50,11 @@ type (
 		publicAPI  graphqluc.GraphQLUseCase
 		privateAPI graphqluc.GraphQLUseCase
 		proxy      *proxy
+		errorPages *errorPages
+		watcher    *supervisor.Supervisor
+		snapshot   *registry.Snapshot
+		election   apptypes.LeaderElection
+		trusted    []*net.IPNet
 	}
 )
 
@@ -48,6 +63,8 @@ type (
 /************************************************************************/
 
 func (d *Domain) PreStart() error {
+	var err error
+
 	// healthz
 	healthz.Register("gateway", time.Second*1, func() error {
 		if !d.ready {
@@ -56,14 +73,66 @@ func (d *Domain) PreStart() error {
 		return nil
 	})
 
-	// create service to handle inbound requests
-	d.is = service.NewService(d.opts, d.log)
+	// module assets are cached and kept as a fallback according to the configured policy
+	if d.proxy.policy, err = cache.NewPolicy(d.opts.Config.AssetCache); err != nil {
+		return err
+	}
+	d.proxy.assets = cache.NewAssetCache(d.opts.Config.AssetCache, d.proxy.policy, d.opts.DataDir, d.log)
+	d.proxy.fallback = cache.NewFallback(d.opts.Config.Fallback, d.proxy.policy, d.opts.DataDir, d.log)
+
+	// create the registry that stores registered apps, redis unless configured otherwise
+	reg, err := registry.New(d.opts, d.log)
+	if err != nil {
+		return err
+	}
+
+	// the leader election matches the registry so that the gateway does not depend on redis unless it uses it
+	if d.election, err = registry.NewElection(d.opts, d.log); err != nil {
+		return err
+	}
+
+	// keep serving the last known good registry while it is unavailable, reported as degraded on the private status
+	// endpoint rather than down, health only fails when there is no snapshot to serve from
+	if d.snapshot = registry.NewSnapshot(reg, d.opts, d.log); d.snapshot != nil {
+		reg = d.snapshot
+		healthz.Register("registry", time.Second*1, d.snapshot.Health)
+		d.opts.PrivateHTTPUseCase.Server().GET("/registry/status", d.registryStatus)
+	}
+
+	// create service to handle inbound requests, user preferences are kept apart from the registry in mongo
+	d.is = service.NewService(d.opts, reg, preferences.New(d.opts, d.log), d.log)
+
+	// leader only registry watcher, reports as unhealthy while it is failing
+	d.watcher = supervisor.New("registry-watcher", d.is.Watch, d.log)
+	healthz.Register("registry-watcher", time.Second*1, d.watcher.Health)
+
+	// errors raised by any public route are rendered as graphql errors or branded error pages
+	pages, err := newErrorPages(d.opts.Config.ErrorPages, d.log)
+	if err != nil {
+		return err
+	}
+	d.errorPages = pages
+	d.opts.PublicHTTPUseCase.Server().HTTPErrorHandler = pages.handle
+	d.opts.PublicHTTPUseCase.Server().GET("/errors/:status", pages.serve)
+
+	// users and client addresses used for access decisions are only taken from the trusted authenticating proxies
+	if d.trusted, err = middleware2.TrustedNetworks(d.opts.Config.Trust); err != nil {
+		return err
+	}
+
+	// the user and developer overrides must be resolved before any of the routes below are handled
+	d.opts.PublicHTTPUseCase.Server().Use(middleware2.UserMiddleware(d.opts.Config.Preferences, d.opts.Config.Trust, d.trusted))
+	d.opts.PublicHTTPUseCase.Server().Use(middleware2.OverrideMiddleware(d.opts.Config.Overrides, d.log))
+	d.opts.PublicHTTPUseCase.Server().Use(middleware2.LocaleMiddleware())
 
 	// register the application gateways own graphql endpoints
 	if err := d.registerGqlAPI(); err != nil {
 		return err
 	}
 
+	// plain json shell endpoints for consumers without a graphql client, registered before the shell app catches all
+	newShellEndpoints(d.is, d.opts.Config.Shell, d.opts.Config.Preferences).register(d.opts.PublicHTTPUseCase.Server())
+
 	// register the shell app route
 	d.registerShellAppRoute()
 
@@ -75,24 +144,47 @@ func (d *Domain) PreStart() error {
 
 func (d *Domain) PostStart() error {
 	// watches for leadership changed events in order to prevent contention on rebuilding of the shell's configuration
-	// data in the cache
-	d.opts.RedisUseCase.SubscribeToElectionEvents(func(onPromote <-chan time.Time, onDemote <-chan time.Time) {
+	// data in the cache, the supervisor guarantees that a single watcher runs while this instance is the leader
+	d.election.SubscribeToElectionEvents(func(onPromote <-chan time.Time, onDemote <-chan time.Time) {
 		for {
 			select {
 			case <-onPromote:
-				go func() {
-					if err := d.is.Watch(); err != nil {
-						panic(err)
-					}
-				}()
+				d.watcher.Start(d.opts.Context)
 			case <-onDemote:
-				if err := d.is.UnWatch(); err != nil {
-					panic(err)
-				}
+				d.watcher.Stop()
 			}
 		}
 	})
 
+	// every replica evicts its cached proxy targets and proxies when an app changes
+	if _, err := d.opts.NatsUseCase.Client().Subscribe(apptypes.ProxyTargetInvalidatedSubject, d.invalidateProxyTarget); err != nil {
+		return err
+	}
+
+	// every replica keeps its own navigation search index and rebuilds it whenever the navigation is rebuilt
+	go d.rebuildSearchIndex(nil)
+
+	if _, err := d.opts.NatsUseCase.Client().Subscribe(apptypes.ShellConfigurationUpdatedSubject, d.rebuildSearchIndex); err != nil {
+		return err
+	}
+
+	// every replica caches the maintenance windows and reloads them whenever maintenance changes or the navigation is
+	// rebuilt, the navigation entries of apps in maintenance are resolved when loading
+	for _, subject := range []string{apptypes.MaintenanceChangedSubject, apptypes.ShellConfigurationUpdatedSubject} {
+		if _, err := d.opts.NatsUseCase.Client().Subscribe(subject, d.reloadMaintenance); err != nil {
+			return err
+		}
+	}
+
+	// every replica refreshes its registry snapshot whenever the navigation is rebuilt
+	if d.snapshot != nil {
+		go d.refreshSnapshot(nil)
+
+		if _, err := d.opts.NatsUseCase.Client().Subscribe(apptypes.ShellConfigurationUpdatedSubject, d.refreshSnapshot); err != nil {
+			return err
+		}
+	}
+
 	// flag service is ready so health starts reporting ok status
 	d.ready = true
 
@@ -101,6 +193,7 @@ func (d *Domain) PostStart() error {
 
 func (d *Domain) PreStop() error {
 	// TODO mark unavailable so services return a service is going down error
+	d.watcher.Stop()
 	return nil
 }
 
@@ -166,7 +259,7 @@ func (d *Domain) registerShellAppRoute() {
 					d.proxy.proxyHTTP(tgt, c).ServeHTTP(res, req)
 				}
 
-				return nil
+				return d.proxy.proxyError(c)
 			}
 		})
 	} else if d.opts.Config.WebDir != "" {
@@ -176,7 +269,12 @@ func (d *Domain) registerShellAppRoute() {
 		g1.Use(
 			func(next echo.HandlerFunc) echo.HandlerFunc {
 				return func(c echo.Context) error {
-					c.Response().Header().Set("Cache-Control", "max-age=31536000")
+					// index.html is served for every route of the shell and must be revalidated to pick up new builds
+					cacheControl := "max-age=31536000"
+					if ext := path.Ext(c.Request().URL.Path); ext == "" || ext == ".html" {
+						cacheControl = "no-cache"
+					}
+					c.Response().Header().Set("Cache-Control", cacheControl)
 					return next(c)
 				}
 			},
@@ -211,6 +309,7 @@ func (d *Domain) registerProxyRouter() {
 	// routes graph requests to an app by its service name, the app must have registered itself in advance
 	grp1 := d.opts.PublicHTTPUseCase.Server().Group("/app/:appId/graphql")
 	grp1.Use(middleware2.ACAOHeaderOverwriteMiddleware)
+	grp1.Use(middleware2.MaintenanceMiddleware(d.opts.Config.Maintenance, d.trusted, d.is, d.log))
 	grp1.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			req := c.Request()
@@ -251,17 +350,20 @@ func (d *Domain) registerProxyRouter() {
 					log.Info().Msgf("proxy gql http   to %s%s", tgt.APIURL, req.URL)
 					d.proxy.proxyHTTP(tgt, c).ServeHTTP(res, req)
 				}
-			} else {
-				d.log.Warn().Msgf("no proxy target found for <%s>", app)
+
+				return d.proxy.proxyError(c)
 			}
 
-			return nil
+			d.log.Warn().Msgf("no proxy target found for <%s>", app)
+
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("app %s is not registered", app))
 		}
 	})
 
 	// routes loading of web modules by service name, the app must have registered itself in advance
 	grp2 := d.opts.PublicHTTPUseCase.Server().Group("/app/:appId")
 	grp2.Use(middleware2.ACAOHeaderOverwriteMiddleware)
+	grp2.Use(middleware2.MaintenanceMiddleware(d.opts.Config.Maintenance, d.trusted, d.is, d.log))
 	grp2.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			req := c.Request()
@@ -286,31 +388,103 @@ func (d *Domain) registerProxyRouter() {
 					return err
 				}
 
+				// follow a developer override for this session only
+				if override, ok := apputil.OverridesFromContext(req.Context())[app]; ok {
+					d.log.Debug().Msgf("applying developer override <%s>:<%s>", app, override)
+					tgt = tgt.WithWebURL(override)
+				}
+
 				d.log.Debug().Msgf("found web proxy target <%s>:<%s>", app, tgt.WebURL)
 
 				c.Set(apptypes.TargetURLKey, tgt.WebURL)
 				c.Set(apptypes.AppNameKey, tgt.Name)
+				c.Set(apptypes.AssetRequestKey, true)
+
+				// loading the remote entry of an app counts as a visit to the app
+				if strings.HasSuffix(c.Request().URL.Path, "/"+apptypes.ProxyRemoteEntryFile) {
+					go d.recordRecentApp(apputil.UserFromContext(req.Context()), app)
+				}
 
 				// Proxy
 				switch {
 				case c.IsWebSocket():
 					log.Info().Msgf("proxy socket to %s%s", tgt.WebURL, req.URL)
 					d.proxy.proxyRaw(tgt, c).ServeHTTP(res, req)
+				case d.proxy.serveCached(tgt, c):
+					log.Debug().Msgf("served cached asset %s%s", tgt.WebURL, req.URL)
 				case req.Header.Get(echo.HeaderAccept) == "text/event-stream":
 					// TODO SSE
 				default:
 					log.Info().Msgf("proxy http   to %s%s", tgt.WebURL, req.URL)
 					d.proxy.proxyHTTP(tgt, c).ServeHTTP(res, req)
 				}
-			} else {
-				d.log.Warn().Msgf("no proxy target found for <%s>", app)
+
+				return d.proxy.proxyError(c)
 			}
 
-			return nil
+			d.log.Warn().Msgf("no proxy target found for <%s>", app)
+
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("app %s is not registered", app))
 		}
 	})
 }
 
+// registryStatus reports whether the registry is being served from the snapshot
+func (d *Domain) registryStatus(c echo.Context) error {
+	return c.JSON(http.StatusOK, d.snapshot.Status())
+}
+
+// refreshSnapshot persists the current registry state to the local snapshot
+func (d *Domain) refreshSnapshot(_ *nats.Msg) {
+	if err := d.snapshot.Refresh(d.opts.Context); err != nil {
+		d.log.Warn().Err(err).Msgf("could not refresh registry snapshot")
+	}
+}
+
+// recordRecentApp adds an app to the recently used apps of a user, runs outside of the request so that module loads
+// are not slowed down by the registry
+func (d *Domain) recordRecentApp(user, app string) {
+	if user == "" {
+		return
+	}
+
+	if err := d.is.RecordRecentApp(apputil.WithUser(d.opts.Context, user), app); err != nil {
+		d.log.Warn().Err(err).Str("app", app).Msgf("could not record recently used app")
+	}
+}
+
+// rebuildSearchIndex rebuilds the local navigation search index
+func (d *Domain) rebuildSearchIndex(_ *nats.Msg) {
+	if err := d.is.RebuildSearchIndex(d.opts.Context); err != nil {
+		d.log.Warn().Err(err).Msgf("could not rebuild navigation search index")
+	}
+}
+
+// reloadMaintenance reloads the cached maintenance windows
+func (d *Domain) reloadMaintenance(_ *nats.Msg) {
+	if err := d.is.ReloadMaintenance(d.opts.Context); err != nil {
+		d.log.Warn().Err(err).Msgf("could not reload maintenance windows")
+	}
+}
+
+// invalidateProxyTarget handles proxy target invalidations broadcast by any replica
+func (d *Domain) invalidateProxyTarget(msg *nats.Msg) {
+	var ev apptypes.ProxyTargetInvalidatedEvent
+	if err := json.Unmarshal(msg.Data, &ev); err != nil {
+		d.log.Warn().Err(err).Msgf("could not unmarshal proxy target invalidation")
+		return
+	}
+
+	urls := ev.URLs
+	if tgt, ok := d.is.EvictProxyTarget(ev.App); ok {
+		urls = append(urls, tgt.WebURL.String(), tgt.APIURL.String())
+	}
+
+	d.proxy.evict(urls...)
+
+	d.log.Debug().Str("app", ev.App).Msgf("invalidated proxy target")
+}
+
 /************************************************************************/
 /* API
 /************************************************************************/
@@ -330,7 +504,10 @@ func (d *Domain) registerGqlAPI() error {
 		})),
 	)
 
-	// private api
+	// private api, changes made through it are attributed to the caller in the audit trail
+	d.opts.PrivateHTTPUseCase.Server().Use(middleware2.AuditActorMiddleware(d.opts.Config.Audit, d.trusted))
+	d.opts.PrivateHTTPUseCase.Server().Use(middleware2.UserMiddleware(d.opts.Config.Preferences, d.opts.Config.Trust, d.trusted))
+	d.opts.PrivateHTTPUseCase.Server().Use(middleware2.LocaleMiddleware())
 	d.privateAPI = graphqluc.NewGraphQLUseCase(
 		graphqluc.WithLogger(d.log),
 		graphqluc.WithHTTPUseCase(d.opts.PrivateHTTPUseCase),
@@ -376,6 +553,7 @@ func NewGateway(opts ...apptypes.APIGatewayOption) *Domain {
 	// has to be set here so that options are applied first
 	// this is the list of file names that should be scanned for tokens
 	g.proxy.filesToScan = g.opts.Config.AssetsToScan
+	g.proxy.httpClient = g.httpClient
 
 	return g
 }
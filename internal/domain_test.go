@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"encoding/json"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/azarc-io/verathread-gateway/internal/testutil"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/erni27/imcache"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// targetService caches proxy targets like the internal service of a replica
+	targetService struct {
+		apptypes.InternalService
+
+		mu      sync.Mutex
+		targets map[string]*apptypes.ProxyTarget
+	}
+)
+
+func (s *targetService) EvictProxyTarget(app string) (*apptypes.ProxyTarget, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target, ok := s.targets[app]
+	delete(s.targets, app)
+
+	return target, ok
+}
+
+func (s *targetService) cached(app string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.targets[app]
+	return ok
+}
+
+// newReplica creates a domain with a cached proxy target and http proxies for the app
+func newReplica(t *testing.T, target *apptypes.ProxyTarget, urls ...string) *Domain {
+	t.Helper()
+
+	is := &targetService{targets: map[string]*apptypes.ProxyTarget{target.ID: target}}
+
+	d := &Domain{
+		log: zerolog.Nop(),
+		is:  is,
+		proxy: &proxy{
+			log:            zerolog.Nop(),
+			httpProxyCache: imcache.NewSharded[string, *httputil.ReverseProxy](apptypes.CacheShards, imcache.DefaultStringHasher64{}),
+		},
+	}
+
+	for _, u := range append(urls, target.WebURL.String(), target.APIURL.String()) {
+		d.proxy.httpProxyCache.Set(u, &httputil.ReverseProxy{}, imcache.WithNoExpiration())
+	}
+
+	return d
+}
+
+func TestInvalidateProxyTarget_AllReplicas(t *testing.T) {
+	var (
+		srv    = testutil.RunNats(t)
+		webURL = &url.URL{Scheme: "http", Host: "app-web:8080"}
+		apiURL = &url.URL{Scheme: "http", Host: "app-api:8080"}
+		target = &apptypes.ProxyTarget{ID: "app", WebURL: webURL, APIURL: apiURL}
+		oldURL = "http://app-old:8080"
+	)
+
+	one := newReplica(t, target, oldURL)
+	other := newReplica(t, target, oldURL)
+
+	// every replica subscribes with its own connection, as it does on startup
+	for _, d := range []*Domain{one, other} {
+		nc := testutil.ConnectNats(t, srv).Client()
+
+		_, err := nc.Subscribe(apptypes.ProxyTargetInvalidatedSubject, d.invalidateProxyTarget)
+		require.NoError(t, err)
+		require.NoError(t, nc.Flush())
+	}
+
+	// a third replica changed the app and broadcasts the invalidation together with the url the app moved away from
+	b, err := json.Marshal(&apptypes.ProxyTargetInvalidatedEvent{App: "app", URLs: []string{oldURL}})
+	require.NoError(t, err)
+
+	nc := testutil.ConnectNats(t, srv).Client()
+	require.NoError(t, nc.Publish(apptypes.ProxyTargetInvalidatedSubject, b))
+	require.NoError(t, nc.Flush())
+
+	for _, d := range []*Domain{one, other} {
+		is := d.is.(*targetService)
+
+		require.Eventually(t, func() bool {
+			if is.cached("app") {
+				return false
+			}
+			for _, u := range []string{oldURL, webURL.String(), apiURL.String()} {
+				if _, cached := d.proxy.httpProxyCache.Get(u); cached {
+					return false
+				}
+			}
+			return true
+		}, time.Second*5, time.Millisecond*10, "every replica evicts the proxy target and the proxies of the app")
+	}
+}
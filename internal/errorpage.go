@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/azarc-io/verathread-gateway/internal/templates"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+const (
+	appUnavailableTemplate = "app-unavailable.html"
+	errorTemplate          = "error.html"
+)
+
+type (
+	// errorPages turns errors raised while handling public requests into consistent responses, graphql requests get a
+	// graphql style json body while module and shell requests get a html page rendered from a template
+	errorPages struct {
+		log       zerolog.Logger
+		templates *template.Template
+	}
+
+	errorPageData struct {
+		Status     int
+		StatusText string
+		Message    string
+		App        string
+		RequestID  string
+		Path       string
+	}
+
+	gqlErrorResponse struct {
+		Errors []gqlError `json:"errors"`
+		Data   any        `json:"data"`
+	}
+
+	gqlError struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	}
+)
+
+// handle is installed as the http error handler of the public server
+func (p *errorPages) handle(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	var (
+		status  = http.StatusInternalServerError
+		message = http.StatusText(status)
+		he      *echo.HTTPError
+	)
+
+	if errors.As(err, &he) {
+		status = he.Code
+		message = fmt.Sprint(he.Message)
+		if he.Internal != nil {
+			p.log.Debug().Err(he.Internal).Msgf("internal error for %s", c.Request().URL.Path)
+		}
+	} else if err != nil {
+		p.log.Error().Err(err).Msgf("unhandled error for %s", c.Request().URL.Path)
+	}
+
+	app, _ := c.Get(apptypes.AppNameKey).(string)
+	if app == "" {
+		app = c.Param("appId")
+	}
+
+	data := &errorPageData{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Message:    message,
+		App:        app,
+		RequestID:  requestID(c),
+		Path:       c.Request().URL.Path,
+	}
+
+	if err := p.write(c, data); err != nil {
+		p.log.Error().Err(err).Msgf("failed to write error response")
+	}
+}
+
+// serve renders an error page on request, this allows the shell to show the same pages e.g. /errors/503?app=example
+func (p *errorPages) serve(c echo.Context) error {
+	status, err := strconv.Atoi(c.Param("status"))
+	if err != nil || http.StatusText(status) == "" {
+		status = http.StatusInternalServerError
+	}
+
+	return p.render(c, http.StatusOK, &errorPageData{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Message:    c.QueryParam("message"),
+		App:        c.QueryParam("app"),
+		RequestID:  c.QueryParam("requestId"),
+	}, isUnavailableStatus(status))
+}
+
+// write responds with either a graphql style error or a html page depending on the request
+func (p *errorPages) write(c echo.Context, data *errorPageData) error {
+	if c.Request().Method == http.MethodHead {
+		return c.NoContent(data.Status)
+	}
+
+	if wantsJSON(c.Request()) {
+		return c.JSON(data.Status, &gqlErrorResponse{
+			Errors: []gqlError{{
+				Message: data.Message,
+				Extensions: map[string]any{
+					"code":      strings.ToUpper(strings.ReplaceAll(data.StatusText, " ", "_")),
+					"status":    data.Status,
+					"requestId": data.RequestID,
+					"app":       data.App,
+				},
+			}},
+		})
+	}
+
+	return p.render(c, data.Status, data, data.App != "" && isUnavailableStatus(data.Status))
+}
+
+// render renders the most specific template available for the status
+func (p *errorPages) render(c echo.Context, code int, data *errorPageData, unavailable bool) error {
+	names := []string{strconv.Itoa(data.Status) + ".html", errorTemplate}
+	if unavailable {
+		names = append([]string{appUnavailableTemplate}, names...)
+	}
+
+	for _, name := range names {
+		if t := p.templates.Lookup(name); t != nil {
+			var buf bytes.Buffer
+			if err := t.Execute(&buf, data); err != nil {
+				return err
+			}
+
+			return c.HTMLBlob(code, buf.Bytes())
+		}
+	}
+
+	return c.String(code, data.StatusText)
+}
+
+// requestID returns the id of the current request
+func requestID(c echo.Context) string {
+	if id := c.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+		return id
+	}
+
+	return c.Request().Header.Get(echo.HeaderXRequestID)
+}
+
+// wantsJSON returns true for graphql requests, the shell endpoints and clients that do not accept html
+func wantsJSON(req *http.Request) bool {
+	if strings.HasSuffix(req.URL.Path, "/graphql") || strings.HasSuffix(req.URL.Path, "/query") ||
+		strings.HasPrefix(req.URL.Path, "/shell/") {
+		return true
+	}
+
+	accept := req.Header.Get(echo.HeaderAccept)
+
+	return strings.Contains(accept, echo.MIMEApplicationJSON) && !strings.Contains(accept, echo.MIMETextHTML)
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// newErrorPages loads the built-in error templates, templates found in the configured directory replace them
+func newErrorPages(cfg *apptypes.ErrorPagesConfig, log zerolog.Logger) (*errorPages, error) {
+	t, err := template.ParseFS(templates.Errors, "*.html")
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg != nil && cfg.Dir != "" {
+		files, err := filepath.Glob(filepath.Join(cfg.Dir, "*.html"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			b, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := t.New(filepath.Base(file)).Parse(string(b)); err != nil {
+				return nil, fmt.Errorf("failed to parse error page %s: %w", file, err)
+			}
+		}
+
+		log.Info().Msgf("loaded %d error pages from: %s", len(files), cfg.Dir)
+	}
+
+	return &errorPages{log: log, templates: t}, nil
+}
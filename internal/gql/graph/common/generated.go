@@ -48,11 +48,71 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AppRegistrationRevision struct {
+		Navigation   func(childComplexity int) int
+		Package      func(childComplexity int) int
+		RegisteredAt func(childComplexity int) int
+		Revision     func(childComplexity int) int
+		RollbackOf   func(childComplexity int) int
+		Version      func(childComplexity int) int
+	}
+
+	AuditChange struct {
+		After  func(childComplexity int) int
+		Before func(childComplexity int) int
+		Field  func(childComplexity int) int
+	}
+
+	AuditEvent struct {
+		Actor     func(childComplexity int) int
+		App       func(childComplexity int) int
+		Changes   func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Type      func(childComplexity int) int
+	}
+
+	AuditEventsPage struct {
+		Data func(childComplexity int) int
+		Page func(childComplexity int) int
+	}
+
 	KeepAliveAppOutput struct {
 		Ok                   func(childComplexity int) int
 		RegistrationRequired func(childComplexity int) int
 	}
 
+	MaintenanceWindow struct {
+		App       func(childComplexity int) int
+		Message   func(childComplexity int) int
+		StartedAt func(childComplexity int) int
+		Until     func(childComplexity int) int
+	}
+
+	NavigationOverride struct {
+		Category  func(childComplexity int) int
+		Hidden    func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Icon      func(childComplexity int) int
+		Order     func(childComplexity int) int
+		SubTitle  func(childComplexity int) int
+		Title     func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+	}
+
+	NavigationSearchResult struct {
+		App         func(childComplexity int) int
+		Breadcrumbs func(childComplexity int) int
+		Category    func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Icon        func(childComplexity int) int
+		Path        func(childComplexity int) int
+		Score       func(childComplexity int) int
+		State       func(childComplexity int) int
+		SubTitle    func(childComplexity int) int
+		Title       func(childComplexity int) int
+	}
+
 	PageInfo struct {
 		Next      func(childComplexity int) int
 		Page      func(childComplexity int) int
@@ -65,6 +125,11 @@ type ComplexityRoot struct {
 	Query struct {
 	}
 
+	RecentApp struct {
+		App       func(childComplexity int) int
+		VisitedAt func(childComplexity int) int
+	}
+
 	RegisterAppOutput struct {
 		ID func(childComplexity int) int
 	}
@@ -82,9 +147,22 @@ type ComplexityRoot struct {
 	}
 
 	ShellConfiguration struct {
+		BaseVersion  func(childComplexity int) int
 		Categories   func(childComplexity int) int
 		DefaultRoute func(childComplexity int) int
+		Hash         func(childComplexity int) int
+		Locale       func(childComplexity int) int
+		Locales      func(childComplexity int) int
+		Maintenance  func(childComplexity int) int
+		Patch        func(childComplexity int) int
 		Slots        func(childComplexity int) int
+		Version      func(childComplexity int) int
+	}
+
+	ShellConfigurationPatchOperation struct {
+		Op    func(childComplexity int) int
+		Path  func(childComplexity int) int
+		Value func(childComplexity int) int
 	}
 
 	ShellConfigurationSubscription struct {
@@ -99,7 +177,9 @@ type ComplexityRoot struct {
 		Hidden       func(childComplexity int) int
 		ID           func(childComplexity int) int
 		Icon         func(childComplexity int) int
+		Maintenance  func(childComplexity int) int
 		Module       func(childComplexity int) int
+		State        func(childComplexity int) int
 		SubTitle     func(childComplexity int) int
 		Title        func(childComplexity int) int
 	}
@@ -107,6 +187,8 @@ type ComplexityRoot struct {
 	ShellNavigationCategory struct {
 		Category func(childComplexity int) int
 		Entries  func(childComplexity int) int
+		Hidden   func(childComplexity int) int
+		Icon     func(childComplexity int) int
 		Priority func(childComplexity int) int
 		Title    func(childComplexity int) int
 	}
@@ -115,14 +197,17 @@ type ComplexityRoot struct {
 		AuthRequired func(childComplexity int) int
 		Children     func(childComplexity int) int
 		Healthy      func(childComplexity int) int
+		Hidden       func(childComplexity int) int
 		Icon         func(childComplexity int) int
 		Module       func(childComplexity int) int
+		State        func(childComplexity int) int
 		SubTitle     func(childComplexity int) int
 		Title        func(childComplexity int) int
 	}
 
 	ShellNavigationModule struct {
 		ExposedModule func(childComplexity int) int
+		Format        func(childComplexity int) int
 		ModuleName    func(childComplexity int) int
 		Outlet        func(childComplexity int) int
 		Path          func(childComplexity int) int
@@ -130,6 +215,7 @@ type ComplexityRoot struct {
 	}
 
 	ShellNavigationSlot struct {
+		App          func(childComplexity int) int
 		AuthRequired func(childComplexity int) int
 		Description  func(childComplexity int) int
 		Module       func(childComplexity int) int
@@ -139,11 +225,18 @@ type ComplexityRoot struct {
 
 	ShellNavigationSlotModule struct {
 		ExposedModule func(childComplexity int) int
+		Format        func(childComplexity int) int
 		ModuleName    func(childComplexity int) int
 		Path          func(childComplexity int) int
 		RemoteEntry   func(childComplexity int) int
 	}
 
+	SignOverrideOutput struct {
+		ExpiresAt func(childComplexity int) int
+		Signature func(childComplexity int) int
+		Value     func(childComplexity int) int
+	}
+
 	TagValue struct {
 		Value func(childComplexity int) int
 	}
@@ -152,6 +245,13 @@ type ComplexityRoot struct {
 		Key    func(childComplexity int) int
 		Values func(childComplexity int) int
 	}
+
+	UserPreferences struct {
+		DefaultRoute func(childComplexity int) int
+		Hidden       func(childComplexity int) int
+		Pinned       func(childComplexity int) int
+		Recents      func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -173,6 +273,125 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AppRegistrationRevision.navigation":
+		if e.complexity.AppRegistrationRevision.Navigation == nil {
+			break
+		}
+
+		return e.complexity.AppRegistrationRevision.Navigation(childComplexity), true
+
+	case "AppRegistrationRevision.package":
+		if e.complexity.AppRegistrationRevision.Package == nil {
+			break
+		}
+
+		return e.complexity.AppRegistrationRevision.Package(childComplexity), true
+
+	case "AppRegistrationRevision.registeredAt":
+		if e.complexity.AppRegistrationRevision.RegisteredAt == nil {
+			break
+		}
+
+		return e.complexity.AppRegistrationRevision.RegisteredAt(childComplexity), true
+
+	case "AppRegistrationRevision.revision":
+		if e.complexity.AppRegistrationRevision.Revision == nil {
+			break
+		}
+
+		return e.complexity.AppRegistrationRevision.Revision(childComplexity), true
+
+	case "AppRegistrationRevision.rollbackOf":
+		if e.complexity.AppRegistrationRevision.RollbackOf == nil {
+			break
+		}
+
+		return e.complexity.AppRegistrationRevision.RollbackOf(childComplexity), true
+
+	case "AppRegistrationRevision.version":
+		if e.complexity.AppRegistrationRevision.Version == nil {
+			break
+		}
+
+		return e.complexity.AppRegistrationRevision.Version(childComplexity), true
+
+	case "AuditChange.after":
+		if e.complexity.AuditChange.After == nil {
+			break
+		}
+
+		return e.complexity.AuditChange.After(childComplexity), true
+
+	case "AuditChange.before":
+		if e.complexity.AuditChange.Before == nil {
+			break
+		}
+
+		return e.complexity.AuditChange.Before(childComplexity), true
+
+	case "AuditChange.field":
+		if e.complexity.AuditChange.Field == nil {
+			break
+		}
+
+		return e.complexity.AuditChange.Field(childComplexity), true
+
+	case "AuditEvent.actor":
+		if e.complexity.AuditEvent.Actor == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Actor(childComplexity), true
+
+	case "AuditEvent.app":
+		if e.complexity.AuditEvent.App == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.App(childComplexity), true
+
+	case "AuditEvent.changes":
+		if e.complexity.AuditEvent.Changes == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Changes(childComplexity), true
+
+	case "AuditEvent.createdAt":
+		if e.complexity.AuditEvent.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.CreatedAt(childComplexity), true
+
+	case "AuditEvent.id":
+		if e.complexity.AuditEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.ID(childComplexity), true
+
+	case "AuditEvent.type":
+		if e.complexity.AuditEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Type(childComplexity), true
+
+	case "AuditEventsPage.data":
+		if e.complexity.AuditEventsPage.Data == nil {
+			break
+		}
+
+		return e.complexity.AuditEventsPage.Data(childComplexity), true
+
+	case "AuditEventsPage.page":
+		if e.complexity.AuditEventsPage.Page == nil {
+			break
+		}
+
+		return e.complexity.AuditEventsPage.Page(childComplexity), true
+
 	case "KeepAliveAppOutput.ok":
 		if e.complexity.KeepAliveAppOutput.Ok == nil {
 			break
@@ -187,6 +406,160 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.KeepAliveAppOutput.RegistrationRequired(childComplexity), true
 
+	case "MaintenanceWindow.app":
+		if e.complexity.MaintenanceWindow.App == nil {
+			break
+		}
+
+		return e.complexity.MaintenanceWindow.App(childComplexity), true
+
+	case "MaintenanceWindow.message":
+		if e.complexity.MaintenanceWindow.Message == nil {
+			break
+		}
+
+		return e.complexity.MaintenanceWindow.Message(childComplexity), true
+
+	case "MaintenanceWindow.startedAt":
+		if e.complexity.MaintenanceWindow.StartedAt == nil {
+			break
+		}
+
+		return e.complexity.MaintenanceWindow.StartedAt(childComplexity), true
+
+	case "MaintenanceWindow.until":
+		if e.complexity.MaintenanceWindow.Until == nil {
+			break
+		}
+
+		return e.complexity.MaintenanceWindow.Until(childComplexity), true
+
+	case "NavigationOverride.category":
+		if e.complexity.NavigationOverride.Category == nil {
+			break
+		}
+
+		return e.complexity.NavigationOverride.Category(childComplexity), true
+
+	case "NavigationOverride.hidden":
+		if e.complexity.NavigationOverride.Hidden == nil {
+			break
+		}
+
+		return e.complexity.NavigationOverride.Hidden(childComplexity), true
+
+	case "NavigationOverride.id":
+		if e.complexity.NavigationOverride.ID == nil {
+			break
+		}
+
+		return e.complexity.NavigationOverride.ID(childComplexity), true
+
+	case "NavigationOverride.icon":
+		if e.complexity.NavigationOverride.Icon == nil {
+			break
+		}
+
+		return e.complexity.NavigationOverride.Icon(childComplexity), true
+
+	case "NavigationOverride.order":
+		if e.complexity.NavigationOverride.Order == nil {
+			break
+		}
+
+		return e.complexity.NavigationOverride.Order(childComplexity), true
+
+	case "NavigationOverride.subTitle":
+		if e.complexity.NavigationOverride.SubTitle == nil {
+			break
+		}
+
+		return e.complexity.NavigationOverride.SubTitle(childComplexity), true
+
+	case "NavigationOverride.title":
+		if e.complexity.NavigationOverride.Title == nil {
+			break
+		}
+
+		return e.complexity.NavigationOverride.Title(childComplexity), true
+
+	case "NavigationOverride.updatedAt":
+		if e.complexity.NavigationOverride.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.NavigationOverride.UpdatedAt(childComplexity), true
+
+	case "NavigationSearchResult.app":
+		if e.complexity.NavigationSearchResult.App == nil {
+			break
+		}
+
+		return e.complexity.NavigationSearchResult.App(childComplexity), true
+
+	case "NavigationSearchResult.breadcrumbs":
+		if e.complexity.NavigationSearchResult.Breadcrumbs == nil {
+			break
+		}
+
+		return e.complexity.NavigationSearchResult.Breadcrumbs(childComplexity), true
+
+	case "NavigationSearchResult.category":
+		if e.complexity.NavigationSearchResult.Category == nil {
+			break
+		}
+
+		return e.complexity.NavigationSearchResult.Category(childComplexity), true
+
+	case "NavigationSearchResult.id":
+		if e.complexity.NavigationSearchResult.ID == nil {
+			break
+		}
+
+		return e.complexity.NavigationSearchResult.ID(childComplexity), true
+
+	case "NavigationSearchResult.icon":
+		if e.complexity.NavigationSearchResult.Icon == nil {
+			break
+		}
+
+		return e.complexity.NavigationSearchResult.Icon(childComplexity), true
+
+	case "NavigationSearchResult.path":
+		if e.complexity.NavigationSearchResult.Path == nil {
+			break
+		}
+
+		return e.complexity.NavigationSearchResult.Path(childComplexity), true
+
+	case "NavigationSearchResult.score":
+		if e.complexity.NavigationSearchResult.Score == nil {
+			break
+		}
+
+		return e.complexity.NavigationSearchResult.Score(childComplexity), true
+
+	case "NavigationSearchResult.state":
+		if e.complexity.NavigationSearchResult.State == nil {
+			break
+		}
+
+		return e.complexity.NavigationSearchResult.State(childComplexity), true
+
+	case "NavigationSearchResult.subTitle":
+		if e.complexity.NavigationSearchResult.SubTitle == nil {
+			break
+		}
+
+		return e.complexity.NavigationSearchResult.SubTitle(childComplexity), true
+
+	case "NavigationSearchResult.title":
+		if e.complexity.NavigationSearchResult.Title == nil {
+			break
+		}
+
+		return e.complexity.NavigationSearchResult.Title(childComplexity), true
+
 	case "PageInfo.next":
 		if e.complexity.PageInfo.Next == nil {
 			break
@@ -229,6 +602,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PageInfo.TotalPage(childComplexity), true
 
+	case "RecentApp.app":
+		if e.complexity.RecentApp.App == nil {
+			break
+		}
+
+		return e.complexity.RecentApp.App(childComplexity), true
+
+	case "RecentApp.visitedAt":
+		if e.complexity.RecentApp.VisitedAt == nil {
+			break
+		}
+
+		return e.complexity.RecentApp.VisitedAt(childComplexity), true
+
 	case "RegisterAppOutput.id":
 		if e.complexity.RegisterAppOutput.ID == nil {
 			break
@@ -278,6 +665,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RegisteredAppsPage.Page(childComplexity), true
 
+	case "ShellConfiguration.baseVersion":
+		if e.complexity.ShellConfiguration.BaseVersion == nil {
+			break
+		}
+
+		return e.complexity.ShellConfiguration.BaseVersion(childComplexity), true
+
 	case "ShellConfiguration.categories":
 		if e.complexity.ShellConfiguration.Categories == nil {
 			break
@@ -292,6 +686,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellConfiguration.DefaultRoute(childComplexity), true
 
+	case "ShellConfiguration.hash":
+		if e.complexity.ShellConfiguration.Hash == nil {
+			break
+		}
+
+		return e.complexity.ShellConfiguration.Hash(childComplexity), true
+
+	case "ShellConfiguration.locale":
+		if e.complexity.ShellConfiguration.Locale == nil {
+			break
+		}
+
+		return e.complexity.ShellConfiguration.Locale(childComplexity), true
+
+	case "ShellConfiguration.locales":
+		if e.complexity.ShellConfiguration.Locales == nil {
+			break
+		}
+
+		return e.complexity.ShellConfiguration.Locales(childComplexity), true
+
+	case "ShellConfiguration.maintenance":
+		if e.complexity.ShellConfiguration.Maintenance == nil {
+			break
+		}
+
+		return e.complexity.ShellConfiguration.Maintenance(childComplexity), true
+
+	case "ShellConfiguration.patch":
+		if e.complexity.ShellConfiguration.Patch == nil {
+			break
+		}
+
+		return e.complexity.ShellConfiguration.Patch(childComplexity), true
+
 	case "ShellConfiguration.slots":
 		if e.complexity.ShellConfiguration.Slots == nil {
 			break
@@ -299,6 +728,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellConfiguration.Slots(childComplexity), true
 
+	case "ShellConfiguration.version":
+		if e.complexity.ShellConfiguration.Version == nil {
+			break
+		}
+
+		return e.complexity.ShellConfiguration.Version(childComplexity), true
+
+	case "ShellConfigurationPatchOperation.op":
+		if e.complexity.ShellConfigurationPatchOperation.Op == nil {
+			break
+		}
+
+		return e.complexity.ShellConfigurationPatchOperation.Op(childComplexity), true
+
+	case "ShellConfigurationPatchOperation.path":
+		if e.complexity.ShellConfigurationPatchOperation.Path == nil {
+			break
+		}
+
+		return e.complexity.ShellConfigurationPatchOperation.Path(childComplexity), true
+
+	case "ShellConfigurationPatchOperation.value":
+		if e.complexity.ShellConfigurationPatchOperation.Value == nil {
+			break
+		}
+
+		return e.complexity.ShellConfigurationPatchOperation.Value(childComplexity), true
+
 	case "ShellConfigurationSubscription.configuration":
 		if e.complexity.ShellConfigurationSubscription.Configuration == nil {
 			break
@@ -355,6 +812,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellNavigation.Icon(childComplexity), true
 
+	case "ShellNavigation.maintenance":
+		if e.complexity.ShellNavigation.Maintenance == nil {
+			break
+		}
+
+		return e.complexity.ShellNavigation.Maintenance(childComplexity), true
+
 	case "ShellNavigation.module":
 		if e.complexity.ShellNavigation.Module == nil {
 			break
@@ -362,6 +826,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellNavigation.Module(childComplexity), true
 
+	case "ShellNavigation.state":
+		if e.complexity.ShellNavigation.State == nil {
+			break
+		}
+
+		return e.complexity.ShellNavigation.State(childComplexity), true
+
 	case "ShellNavigation.subTitle":
 		if e.complexity.ShellNavigation.SubTitle == nil {
 			break
@@ -390,6 +861,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellNavigationCategory.Entries(childComplexity), true
 
+	case "ShellNavigationCategory.hidden":
+		if e.complexity.ShellNavigationCategory.Hidden == nil {
+			break
+		}
+
+		return e.complexity.ShellNavigationCategory.Hidden(childComplexity), true
+
+	case "ShellNavigationCategory.icon":
+		if e.complexity.ShellNavigationCategory.Icon == nil {
+			break
+		}
+
+		return e.complexity.ShellNavigationCategory.Icon(childComplexity), true
+
 	case "ShellNavigationCategory.priority":
 		if e.complexity.ShellNavigationCategory.Priority == nil {
 			break
@@ -425,6 +910,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellNavigationChild.Healthy(childComplexity), true
 
+	case "ShellNavigationChild.hidden":
+		if e.complexity.ShellNavigationChild.Hidden == nil {
+			break
+		}
+
+		return e.complexity.ShellNavigationChild.Hidden(childComplexity), true
+
 	case "ShellNavigationChild.icon":
 		if e.complexity.ShellNavigationChild.Icon == nil {
 			break
@@ -439,8 +931,15 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellNavigationChild.Module(childComplexity), true
 
-	case "ShellNavigationChild.subTitle":
-		if e.complexity.ShellNavigationChild.SubTitle == nil {
+	case "ShellNavigationChild.state":
+		if e.complexity.ShellNavigationChild.State == nil {
+			break
+		}
+
+		return e.complexity.ShellNavigationChild.State(childComplexity), true
+
+	case "ShellNavigationChild.subTitle":
+		if e.complexity.ShellNavigationChild.SubTitle == nil {
 			break
 		}
 
@@ -460,6 +959,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellNavigationModule.ExposedModule(childComplexity), true
 
+	case "ShellNavigationModule.format":
+		if e.complexity.ShellNavigationModule.Format == nil {
+			break
+		}
+
+		return e.complexity.ShellNavigationModule.Format(childComplexity), true
+
 	case "ShellNavigationModule.moduleName":
 		if e.complexity.ShellNavigationModule.ModuleName == nil {
 			break
@@ -488,6 +994,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellNavigationModule.RemoteEntry(childComplexity), true
 
+	case "ShellNavigationSlot.app":
+		if e.complexity.ShellNavigationSlot.App == nil {
+			break
+		}
+
+		return e.complexity.ShellNavigationSlot.App(childComplexity), true
+
 	case "ShellNavigationSlot.authRequired":
 		if e.complexity.ShellNavigationSlot.AuthRequired == nil {
 			break
@@ -530,6 +1043,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellNavigationSlotModule.ExposedModule(childComplexity), true
 
+	case "ShellNavigationSlotModule.format":
+		if e.complexity.ShellNavigationSlotModule.Format == nil {
+			break
+		}
+
+		return e.complexity.ShellNavigationSlotModule.Format(childComplexity), true
+
 	case "ShellNavigationSlotModule.moduleName":
 		if e.complexity.ShellNavigationSlotModule.ModuleName == nil {
 			break
@@ -551,6 +1071,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ShellNavigationSlotModule.RemoteEntry(childComplexity), true
 
+	case "SignOverrideOutput.expiresAt":
+		if e.complexity.SignOverrideOutput.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.SignOverrideOutput.ExpiresAt(childComplexity), true
+
+	case "SignOverrideOutput.signature":
+		if e.complexity.SignOverrideOutput.Signature == nil {
+			break
+		}
+
+		return e.complexity.SignOverrideOutput.Signature(childComplexity), true
+
+	case "SignOverrideOutput.value":
+		if e.complexity.SignOverrideOutput.Value == nil {
+			break
+		}
+
+		return e.complexity.SignOverrideOutput.Value(childComplexity), true
+
 	case "TagValue.Value":
 		if e.complexity.TagValue.Value == nil {
 			break
@@ -572,6 +1113,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TagValues.Values(childComplexity), true
 
+	case "UserPreferences.defaultRoute":
+		if e.complexity.UserPreferences.DefaultRoute == nil {
+			break
+		}
+
+		return e.complexity.UserPreferences.DefaultRoute(childComplexity), true
+
+	case "UserPreferences.hidden":
+		if e.complexity.UserPreferences.Hidden == nil {
+			break
+		}
+
+		return e.complexity.UserPreferences.Hidden(childComplexity), true
+
+	case "UserPreferences.pinned":
+		if e.complexity.UserPreferences.Pinned == nil {
+			break
+		}
+
+		return e.complexity.UserPreferences.Pinned(childComplexity), true
+
+	case "UserPreferences.recents":
+		if e.complexity.UserPreferences.Recents == nil {
+			break
+		}
+
+		return e.complexity.UserPreferences.Recents(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -580,7 +1149,12 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAuditEventQueryFields,
+		ec.unmarshalInputAuditEventsSort,
+		ec.unmarshalInputAuditEventsWhereRules,
+		ec.unmarshalInputClearMaintenanceInput,
 		ec.unmarshalInputKeepAliveAppInput,
+		ec.unmarshalInputOverrideInput,
 		ec.unmarshalInputPage,
 		ec.unmarshalInputQueryOperatorAndDate,
 		ec.unmarshalInputQueryOperatorAndValue,
@@ -592,10 +1166,16 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputRegisterAppSlot,
 		ec.unmarshalInputRegisterAppSlotModule,
 		ec.unmarshalInputRegisterChildAppNavigationInput,
+		ec.unmarshalInputRegisterNavigationCategoryInput,
+		ec.unmarshalInputRegisterSharedDependencyInput,
 		ec.unmarshalInputRegisteredAppQueryFields,
 		ec.unmarshalInputRegisteredAppsSort,
 		ec.unmarshalInputRegisteredAppsWhereRules,
+		ec.unmarshalInputSetMaintenanceInput,
+		ec.unmarshalInputSetNavigationOverrideInput,
+		ec.unmarshalInputSignOverrideInput,
 		ec.unmarshalInputSort,
+		ec.unmarshalInputTranslationInput,
 	)
 	first := true
 
@@ -684,6 +1264,15 @@ var sources = []*ast.Source{
 
 enum RefRoot {
     AppRef
+    AuditRef
+}
+
+# how the remote entry of an app is loaded, RemoteEntry is a webpack module federation container, Esm a native
+# es module and SystemJs a SystemJS module
+enum ModuleFormat {
+    RemoteEntry
+    Esm
+    SystemJs
 }
 
 enum ShellConfigEventType {
@@ -692,18 +1281,13 @@ enum ShellConfigEventType {
     Removed
     Added
     Rebuild
+    Maintenance
 }
 
 #********************************************************************************************
 # APP REGISTRATION
 #********************************************************************************************
 
-enum RegisterAppCategory {
-    App
-    Setting
-    Dashboard
-}
-
 input RegisterAppInput {
     name: String!
     id: String!
@@ -714,9 +1298,28 @@ input RegisterAppInput {
     webUrl: String!
     apiUrl: String!
     navigation: [RegisterAppNavigationInput]
-    slot1: RegisterAppSlot
-    slot2: RegisterAppSlot
-    slot3: RegisterAppSlot
+    # navigation categories owned by the app, only apps allowed by the gateway config can register categories
+    categories: [RegisterNavigationCategoryInput!]
+    # contributions to named shell slots such as header-right or user-menu
+    slots: [RegisterAppSlot!]
+    slot1: RegisterAppSlot @deprecated(reason: "use slots, contributes to the slot-1 slot")
+    slot2: RegisterAppSlot @deprecated(reason: "use slots, contributes to the slot-2 slot")
+    slot3: RegisterAppSlot @deprecated(reason: "use slots, contributes to the slot-3 slot")
+    # the format of the remote entry, defaults to RemoteEntry
+    format: ModuleFormat
+    # dependencies the app shares with the shell and other apps
+    shared: [RegisterSharedDependencyInput!]
+}
+
+input RegisterSharedDependencyInput {
+    name: String!
+    version: String!
+    # the semver range the app accepts, defaults to the version
+    requiredVersion: String
+    singleton: Boolean
+    eager: Boolean
+    # an es module build of the dependency, shared dependencies with a url are added to the import map
+    url: String
 }
 
 input KeepAliveAppInput {
@@ -730,11 +1333,36 @@ input RegisterAppNavigationInput {
     subTitle: String!
     authRequired: Boolean!
     hidden: Boolean!
-    category: RegisterAppCategory!
+    # the key of a category defined in the gateway config or registered by an app e.g. App, Setting or Dashboard
+    category: String!
     children: [RegisterChildAppNavigationInput]
     proxy: Boolean!
     icon: String!
     module: RegisterAppModule!
+    # entries with a lower order are placed first within the priority of the app, defaults to 0
+    order: Int
+    translations: [TranslationInput!]
+    # extra terms the entry can be found by in the navigation search, synonyms rank above keywords
+    keywords: [String!]
+    synonyms: [String!]
+}
+
+# a localized variant of the texts of a navigation entry or slot, the locale is a BCP-47 tag e.g. de or de-CH and
+# fields that are not set fall back along the locale chain
+input TranslationInput {
+    locale: String!
+    title: String
+    subTitle: String
+    description: String
+}
+
+input RegisterNavigationCategoryInput {
+    key: String!
+    title: String!
+    icon: String
+    # categories with a lower priority are placed first, defaults to 0
+    priority: Int
+    hidden: Boolean
 }
 
 input RegisterAppModule {
@@ -751,19 +1379,35 @@ input RegisterAppSlotModule {
 }
 
 input RegisterAppSlot {
+    # the name of the shell slot to contribute to, required for contributions in slots
+    slot: String
     description: String!
     authRequired: Boolean!
     module: RegisterAppSlotModule!
+    # contributions with a lower order are placed first within the slot, defaults to 0
+    order: Int
+    translations: [TranslationInput!]
 }
 
 input RegisterChildAppNavigationInput {
     title: String!
     subTitle: String!
     authRequired: Boolean!
+    # used as the module path when the module does not set one
     path: String!
     children: [RegisterChildAppNavigationInput]
     icon: String!
+    hidden: Boolean
     module: RegisterAppModule!
+    # serve the module through the gateway proxy, inherited from the parent when not set
+    proxy: Boolean
+    # the remote entry file of the module relative to the web url, inherited from the parent when not set
+    remoteEntryFile: String
+    # children with a lower order are placed first, defaults to 0
+    order: Int
+    translations: [TranslationInput!]
+    keywords: [String!]
+    synonyms: [String!]
 }
 
 type RegisterAppOutput {
@@ -775,10 +1419,182 @@ type KeepAliveAppOutput {
     ok: Boolean!
 }
 
+#********************************************************************************************
+# REGISTRATION HISTORY
+#********************************************************************************************
+
+type AppRegistrationRevision {
+    revision: Int!
+    version: String!
+    package: String!
+    registeredAt: Time!
+    # the revision that was restored when this revision was created by a rollback
+    rollbackOf: Int
+    navigation: [ShellNavigation]
+}
+
+#********************************************************************************************
+# DEVELOPER OVERRIDES
+#********************************************************************************************
+
+input OverrideInput {
+    app: String!
+    url: String!
+}
+
+# overrides are signed for the user making the request who must have the override role
+input SignOverrideInput {
+    overrides: [OverrideInput!]!
+}
+
+type SignOverrideOutput {
+    value: String!
+    signature: String!
+    # the signature is rejected after this time and has to be requested again
+    expiresAt: Time!
+}
+
+#********************************************************************************************
+# MAINTENANCE
+#********************************************************************************************
+
+input SetMaintenanceInput {
+    # the app to put into maintenance, the whole gateway is put into maintenance when omitted
+    app: String
+    message: String
+    until: Time
+    # user ids or ip addresses that can still use the app
+    whitelist: [String!]
+}
+
+input ClearMaintenanceInput {
+    # the app to take out of maintenance, the whole gateway is taken out of maintenance when omitted
+    app: String
+}
+
+type MaintenanceWindow {
+    app: String
+    message: String
+    until: Time
+    startedAt: Time!
+}
+
+#********************************************************************************************
+# NAVIGATION OVERRIDES
+#********************************************************************************************
+
+# replaces fields of a navigation entry registered by an app, omitted fields keep the registered value
+input SetNavigationOverrideInput {
+    # the id of the navigation entry as returned in the shell configuration
+    id: String!
+    title: String
+    subTitle: String
+    icon: String
+    category: String
+    hidden: Boolean
+    order: Int
+}
+
+type NavigationOverride {
+    id: String!
+    title: String
+    subTitle: String
+    icon: String
+    category: String
+    hidden: Boolean
+    order: Int
+    updatedAt: Time!
+}
+
+#********************************************************************************************
+# USER PREFERENCES
+#********************************************************************************************
+
+type UserPreferences {
+    # the route the shell opens when the user lands on it, only used while the route leads to a healthy entry
+    defaultRoute: String
+    # ids of the navigation entries pinned to the favourites category, in the order they were pinned
+    pinned: [String!]!
+    # ids of the navigation entries the user has hidden from their navigation
+    hidden: [String!]!
+    # the apps the user loaded most recently, most recent first
+    recents: [RecentApp!]!
+}
+
+type RecentApp {
+    app: String!
+    visitedAt: Time!
+}
+
+#********************************************************************************************
+# AUDIT TRAIL
+#********************************************************************************************
+
+enum AuditEventType {
+    Registered
+    Updated
+    Unregistered
+    Unavailable
+    Available
+    RolledBack
+    MaintenanceSet
+    MaintenanceCleared
+    NavigationOverrideSet
+    NavigationOverrideCleared
+    Rebuilt
+}
+
+# a single field that changed, values are json encoded
+type AuditChange {
+    field: String! @ref(field: "field")
+    before: String @ref(field: "before")
+    after: String @ref(field: "after")
+}
+
+type AuditEvent {
+    id: String! @ref(field: "_id")
+    type: AuditEventType! @ref(field: "type")
+    app: String @ref(field: "app")
+    actor: String! @ref(field: "actor")
+    changes: [AuditChange] @ref(field: "changes")
+    createdAt: Time! @ref(field: "created_at")
+}
+
+type AuditEventsPage {
+    data: [AuditEvent] @refRoot(id: AuditRef)
+    page: PageInfo!
+}
+
+input AuditEventsWhereRules {
+    condition: QueryCondition! @queryCondition
+    fields: [AuditEventQueryFields] @queryOp
+    rules: [AuditEventsWhereRules] @queryRules
+}
+
+input AuditEventQueryFields {
+    createdAt: QueryOperatorAndDate @ref(field: "created_at") @queryType(type: Date)
+    type: QueryOperatorAndValue @ref(field: "type")
+    app: QueryOperatorAndValue @ref(field: "app")
+    actor: QueryOperatorAndValue @ref(field: "actor")
+}
+
+input AuditEventsSort {
+    createdAt: SortType @ref(field: "created_at") @queryType(type: Date)
+    type: SortType @ref(field: "type")
+    app: SortType @ref(field: "app")
+    actor: SortType @ref(field: "actor")
+}
+
 #********************************************************************************************
 # SHELL NAVIGATION
 #********************************************************************************************
 
+enum ShellNavigationState {
+    Healthy
+    Unhealthy
+    Maintenance
+}
+
 type RegisteredApp {
     pkg: String! @ref(field: "package")
     name: String @ref(field: "name")
@@ -798,6 +1614,8 @@ type ShellNavigation {
     authRequired: Boolean! @ref(field: "authRequired")
     children: [ShellNavigationChild] @ref(field: "children")
     healthy: Boolean! @ref(field: "available")
+    state: ShellNavigationState! @ref(field: "state")
+    maintenance: MaintenanceWindow @ref(field: "maintenance")
     module: ShellNavigationModule! @ref(field: "module")
     icon: String! @ref(field: "icon")
     hidden: Boolean! @ref(field: "hidden")
@@ -810,12 +1628,16 @@ type ShellNavigationChild {
     authRequired: Boolean! @ref(field: "authRequired")
     children: [ShellNavigationChild] @ref(field: "children")
     healthy: Boolean! @ref(field: "available")
+    state: ShellNavigationState! @ref(field: "state")
     icon: String! @ref(field: "icon")
+    hidden: Boolean! @ref(field: "hidden")
 }
 
 type ShellNavigationSlot {
+    # the position of the contribution within its slot
     priority: Int
     slot: String! @ref(field: "slot")
+    app: String @ref(field: "app")
     description: String! @ref(field: "description")
     authRequired: Boolean @ref(field: "authRequired")
     module: ShellNavigationSlotModule! @ref(field: "module")
@@ -827,6 +1649,7 @@ type ShellNavigationModule {
     exposedModule: String! @ref(field: "exposedModule")
     moduleName: String! @ref(field: "moduleName")
     outlet: String! @ref(field: "outlet")
+    format: ModuleFormat! @ref(field: "format")
 }
 
 type ShellNavigationSlotModule {
@@ -834,19 +1657,58 @@ type ShellNavigationSlotModule {
     remoteEntry: String! @ref(field: "remoteEntry")
     exposedModule: String! @ref(field: "exposedModule")
     moduleName: String! @ref(field: "moduleName")
+    format: ModuleFormat! @ref(field: "format")
 }
 
 type ShellNavigationCategory {
     title: String!
     priority: Int!
-    category: RegisterAppCategory!
+    category: String!
+    icon: String
+    hidden: Boolean!
     entries: [ShellNavigation]
 }
 
 type ShellConfiguration {
+    # increases every time the configuration is rebuilt
+    version: Int!
+    # hash of the content of the configuration as returned to the caller
+    hash: String
+    # set when the configuration is returned as a patch against the configuration of this version, the other fields
+    # are then left empty and the patch has to be applied to the configuration the caller already holds
+    baseVersion: Int
+    patch: [ShellConfigurationPatchOperation!]
     defaultRoute: String
+    # the locale the texts of the configuration are resolved for
+    locale: String
+    # the locales apps have provided translations for, including the default locale
+    locales: [String!]
     categories: [ShellNavigationCategory]
     slots: [ShellNavigationSlot]
+    maintenance: MaintenanceWindow
+}
+
+# a navigation entry or child matching a navigation search, the id is the id of the top level entry and the path
+# identifies the matching entry within it
+type NavigationSearchResult {
+    id: String!
+    app: String!
+    title: String!
+    subTitle: String!
+    icon: String!
+    path: String!
+    category: String!
+    # the titles of the category and parents leading to the entry
+    breadcrumbs: [String!]!
+    state: ShellNavigationState!
+    score: Float!
+}
+
+# a JSON-Patch style operation, op is one of add, remove or replace and path is a JSON pointer
+type ShellConfigurationPatchOperation {
+    op: String!
+    path: String!
+    value: Any
 }
 
 type ShellConfigurationSubscription {
@@ -1124,8 +1986,8 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _KeepAliveAppOutput_registrationRequired(ctx context.Context, field graphql.CollectedField, obj *model.KeepAliveAppOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_KeepAliveAppOutput_registrationRequired(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_revision(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_revision(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1138,7 +2000,7 @@ func (ec *executionContext) _KeepAliveAppOutput_registrationRequired(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RegistrationRequired, nil
+		return obj.Revision, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1150,26 +2012,26 @@ func (ec *executionContext) _KeepAliveAppOutput_registrationRequired(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_KeepAliveAppOutput_registrationRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_revision(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "KeepAliveAppOutput",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _KeepAliveAppOutput_ok(ctx context.Context, field graphql.CollectedField, obj *model.KeepAliveAppOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_KeepAliveAppOutput_ok(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_version(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1182,7 +2044,7 @@ func (ec *executionContext) _KeepAliveAppOutput_ok(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ok, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1194,26 +2056,26 @@ func (ec *executionContext) _KeepAliveAppOutput_ok(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_KeepAliveAppOutput_ok(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "KeepAliveAppOutput",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_total(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_package(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_package(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1226,7 +2088,7 @@ func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
+		return obj.Package, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1238,26 +2100,26 @@ func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_package(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_next(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_next(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_registeredAt(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_registeredAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1270,7 +2132,7 @@ func (ec *executionContext) _PageInfo_next(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Next, nil
+		return obj.RegisteredAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1282,26 +2144,26 @@ func (ec *executionContext) _PageInfo_next(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_next(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_registeredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_prev(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_prev(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_rollbackOf(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_rollbackOf(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1314,26 +2176,23 @@ func (ec *executionContext) _PageInfo_prev(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Prev, nil
+		return obj.RollbackOf, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_prev(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_rollbackOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1344,8 +2203,8 @@ func (ec *executionContext) fieldContext_PageInfo_prev(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_page(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_navigation(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_navigation(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1358,38 +2217,59 @@ func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.Navigation, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.([]*model.ShellNavigation)
 	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
+	return ec.marshalOShellNavigation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_navigation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ShellNavigation_id(ctx, field)
+			case "title":
+				return ec.fieldContext_ShellNavigation_title(ctx, field)
+			case "subTitle":
+				return ec.fieldContext_ShellNavigation_subTitle(ctx, field)
+			case "authRequired":
+				return ec.fieldContext_ShellNavigation_authRequired(ctx, field)
+			case "children":
+				return ec.fieldContext_ShellNavigation_children(ctx, field)
+			case "healthy":
+				return ec.fieldContext_ShellNavigation_healthy(ctx, field)
+			case "state":
+				return ec.fieldContext_ShellNavigation_state(ctx, field)
+			case "maintenance":
+				return ec.fieldContext_ShellNavigation_maintenance(ctx, field)
+			case "module":
+				return ec.fieldContext_ShellNavigation_module(ctx, field)
+			case "icon":
+				return ec.fieldContext_ShellNavigation_icon(ctx, field)
+			case "hidden":
+				return ec.fieldContext_ShellNavigation_hidden(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_perPage(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_perPage(ctx, field)
+func (ec *executionContext) _AuditChange_field(ctx context.Context, field graphql.CollectedField, obj *model.AuditChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditChange_field(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1402,7 +2282,7 @@ func (ec *executionContext) _PageInfo_perPage(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PerPage, nil
+		return obj.Field, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1414,26 +2294,26 @@ func (ec *executionContext) _PageInfo_perPage(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_perPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditChange_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "AuditChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_totalPage(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_totalPage(ctx, field)
+func (ec *executionContext) _AuditChange_before(ctx context.Context, field graphql.CollectedField, obj *model.AuditChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditChange_before(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1446,38 +2326,35 @@ func (ec *executionContext) _PageInfo_totalPage(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalPage, nil
+		return obj.Before, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_totalPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditChange_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "AuditChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _AuditChange_after(ctx context.Context, field graphql.CollectedField, obj *model.AuditChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditChange_after(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1490,7 +2367,7 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.After, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1499,59 +2376,26 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditChange_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AuditChange",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _AuditEvent_id(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1564,49 +2408,38 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "AuditEvent",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RegisterAppOutput_id(ctx context.Context, field graphql.CollectedField, obj *model.RegisterAppOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisterAppOutput_id(ctx, field)
+func (ec *executionContext) _AuditEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1619,7 +2452,7 @@ func (ec *executionContext) _RegisterAppOutput_id(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1631,26 +2464,26 @@ func (ec *executionContext) _RegisterAppOutput_id(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.AuditEventType)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAuditEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RegisterAppOutput_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RegisterAppOutput",
+		Object:     "AuditEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type AuditEventType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RegisteredApp_pkg(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredApp_pkg(ctx, field)
+func (ec *executionContext) _AuditEvent_app(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_app(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1663,26 +2496,23 @@ func (ec *executionContext) _RegisteredApp_pkg(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pkg, nil
+		return obj.App, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RegisteredApp_pkg(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_app(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RegisteredApp",
+		Object:     "AuditEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1693,8 +2523,8 @@ func (ec *executionContext) fieldContext_RegisteredApp_pkg(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _RegisteredApp_name(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredApp_name(ctx, field)
+func (ec *executionContext) _AuditEvent_actor(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_actor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1707,23 +2537,26 @@ func (ec *executionContext) _RegisteredApp_name(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Actor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RegisteredApp_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_actor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RegisteredApp",
+		Object:     "AuditEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1734,8 +2567,8 @@ func (ec *executionContext) fieldContext_RegisteredApp_name(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _RegisteredApp_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredApp_createdAt(ctx, field)
+func (ec *executionContext) _AuditEvent_changes(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_changes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1748,7 +2581,7 @@ func (ec *executionContext) _RegisteredApp_createdAt(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Changes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1757,26 +2590,34 @@ func (ec *executionContext) _RegisteredApp_createdAt(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.([]*model.AuditChange)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOAuditChange2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditChange(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RegisteredApp_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RegisteredApp",
+		Object:     "AuditEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_AuditChange_field(ctx, field)
+			case "before":
+				return ec.fieldContext_AuditChange_before(ctx, field)
+			case "after":
+				return ec.fieldContext_AuditChange_after(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditChange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RegisteredApp_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredApp_updatedAt(ctx, field)
+func (ec *executionContext) _AuditEvent_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1789,23 +2630,26 @@ func (ec *executionContext) _RegisteredApp_updatedAt(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RegisteredApp_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RegisteredApp",
+		Object:     "AuditEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1816,8 +2660,8 @@ func (ec *executionContext) fieldContext_RegisteredApp_updatedAt(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _RegisteredAppsPage_data(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredAppsPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredAppsPage_data(ctx, field)
+func (ec *executionContext) _AuditEventsPage_data(ctx context.Context, field graphql.CollectedField, obj *model.AuditEventsPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEventsPage_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1839,36 +2683,40 @@ func (ec *executionContext) _RegisteredAppsPage_data(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.RegisteredApp)
+	res := resTmp.([]*model.AuditEvent)
 	fc.Result = res
-	return ec.marshalORegisteredApp2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredApp(ctx, field.Selections, res)
+	return ec.marshalOAuditEvent2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEvent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RegisteredAppsPage_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEventsPage_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RegisteredAppsPage",
+		Object:     "AuditEventsPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "pkg":
-				return ec.fieldContext_RegisteredApp_pkg(ctx, field)
-			case "name":
-				return ec.fieldContext_RegisteredApp_name(ctx, field)
+			case "id":
+				return ec.fieldContext_AuditEvent_id(ctx, field)
+			case "type":
+				return ec.fieldContext_AuditEvent_type(ctx, field)
+			case "app":
+				return ec.fieldContext_AuditEvent_app(ctx, field)
+			case "actor":
+				return ec.fieldContext_AuditEvent_actor(ctx, field)
+			case "changes":
+				return ec.fieldContext_AuditEvent_changes(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_RegisteredApp_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_RegisteredApp_updatedAt(ctx, field)
+				return ec.fieldContext_AuditEvent_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RegisteredApp", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AuditEvent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RegisteredAppsPage_page(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredAppsPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredAppsPage_page(ctx, field)
+func (ec *executionContext) _AuditEventsPage_page(ctx context.Context, field graphql.CollectedField, obj *model.AuditEventsPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEventsPage_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1898,9 +2746,9 @@ func (ec *executionContext) _RegisteredAppsPage_page(ctx context.Context, field
 	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RegisteredAppsPage_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEventsPage_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RegisteredAppsPage",
+		Object:     "AuditEventsPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1925,8 +2773,8 @@ func (ec *executionContext) fieldContext_RegisteredAppsPage_page(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellConfiguration_defaultRoute(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellConfiguration_defaultRoute(ctx, field)
+func (ec *executionContext) _KeepAliveAppOutput_registrationRequired(ctx context.Context, field graphql.CollectedField, obj *model.KeepAliveAppOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_KeepAliveAppOutput_registrationRequired(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1939,35 +2787,38 @@ func (ec *executionContext) _ShellConfiguration_defaultRoute(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultRoute, nil
+		return obj.RegistrationRequired, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellConfiguration_defaultRoute(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_KeepAliveAppOutput_registrationRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellConfiguration",
+		Object:     "KeepAliveAppOutput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellConfiguration_categories(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellConfiguration_categories(ctx, field)
+func (ec *executionContext) _KeepAliveAppOutput_ok(ctx context.Context, field graphql.CollectedField, obj *model.KeepAliveAppOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_KeepAliveAppOutput_ok(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -1980,45 +2831,38 @@ func (ec *executionContext) _ShellConfiguration_categories(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Categories, nil
+		return obj.Ok, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ShellNavigationCategory)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOShellNavigationCategory2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationCategory(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellConfiguration_categories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_KeepAliveAppOutput_ok(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellConfiguration",
+		Object:     "KeepAliveAppOutput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "title":
-				return ec.fieldContext_ShellNavigationCategory_title(ctx, field)
-			case "priority":
-				return ec.fieldContext_ShellNavigationCategory_priority(ctx, field)
-			case "category":
-				return ec.fieldContext_ShellNavigationCategory_category(ctx, field)
-			case "entries":
-				return ec.fieldContext_ShellNavigationCategory_entries(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationCategory", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellConfiguration_slots(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellConfiguration_slots(ctx, field)
+func (ec *executionContext) _MaintenanceWindow_app(ctx context.Context, field graphql.CollectedField, obj *model.MaintenanceWindow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MaintenanceWindow_app(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2031,7 +2875,7 @@ func (ec *executionContext) _ShellConfiguration_slots(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Slots, nil
+		return obj.App, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2040,38 +2884,26 @@ func (ec *executionContext) _ShellConfiguration_slots(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ShellNavigationSlot)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOShellNavigationSlot2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationSlot(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellConfiguration_slots(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MaintenanceWindow_app(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellConfiguration",
+		Object:     "MaintenanceWindow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "priority":
-				return ec.fieldContext_ShellNavigationSlot_priority(ctx, field)
-			case "slot":
-				return ec.fieldContext_ShellNavigationSlot_slot(ctx, field)
-			case "description":
-				return ec.fieldContext_ShellNavigationSlot_description(ctx, field)
-			case "authRequired":
-				return ec.fieldContext_ShellNavigationSlot_authRequired(ctx, field)
-			case "module":
-				return ec.fieldContext_ShellNavigationSlot_module(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationSlot", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellConfigurationSubscription_configuration(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationSubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellConfigurationSubscription_configuration(ctx, field)
+func (ec *executionContext) _MaintenanceWindow_message(ctx context.Context, field graphql.CollectedField, obj *model.MaintenanceWindow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MaintenanceWindow_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2084,46 +2916,35 @@ func (ec *executionContext) _ShellConfigurationSubscription_configuration(ctx co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Configuration, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ShellConfiguration)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNShellConfiguration2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfiguration(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellConfigurationSubscription_configuration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MaintenanceWindow_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellConfigurationSubscription",
+		Object:     "MaintenanceWindow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "defaultRoute":
-				return ec.fieldContext_ShellConfiguration_defaultRoute(ctx, field)
-			case "categories":
-				return ec.fieldContext_ShellConfiguration_categories(ctx, field)
-			case "slots":
-				return ec.fieldContext_ShellConfiguration_slots(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellConfiguration", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellConfigurationSubscription_eventType(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationSubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellConfigurationSubscription_eventType(ctx, field)
+func (ec *executionContext) _MaintenanceWindow_until(ctx context.Context, field graphql.CollectedField, obj *model.MaintenanceWindow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MaintenanceWindow_until(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2136,38 +2957,35 @@ func (ec *executionContext) _ShellConfigurationSubscription_eventType(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EventType, nil
+		return obj.Until, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ShellConfigEventType)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNShellConfigEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigEventType(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellConfigurationSubscription_eventType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MaintenanceWindow_until(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellConfigurationSubscription",
+		Object:     "MaintenanceWindow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ShellConfigEventType does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigation_id(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_id(ctx, field)
+func (ec *executionContext) _MaintenanceWindow_startedAt(ctx context.Context, field graphql.CollectedField, obj *model.MaintenanceWindow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MaintenanceWindow_startedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2180,7 +2998,7 @@ func (ec *executionContext) _ShellNavigation_id(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.StartedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2192,26 +3010,26 @@ func (ec *executionContext) _ShellNavigation_id(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MaintenanceWindow_startedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
+		Object:     "MaintenanceWindow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigation_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_title(ctx, field)
+func (ec *executionContext) _NavigationOverride_id(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2224,7 +3042,7 @@ func (ec *executionContext) _ShellNavigation_title(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2241,9 +3059,9 @@ func (ec *executionContext) _ShellNavigation_title(ctx context.Context, field gr
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2254,8 +3072,8 @@ func (ec *executionContext) fieldContext_ShellNavigation_title(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigation_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_subTitle(ctx, field)
+func (ec *executionContext) _NavigationOverride_title(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2268,26 +3086,23 @@ func (ec *executionContext) _ShellNavigation_subTitle(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubTitle, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2298,8 +3113,8 @@ func (ec *executionContext) fieldContext_ShellNavigation_subTitle(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigation_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_authRequired(ctx, field)
+func (ec *executionContext) _NavigationOverride_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_subTitle(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2312,38 +3127,35 @@ func (ec *executionContext) _ShellNavigation_authRequired(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AuthRequired, nil
+		return obj.SubTitle, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigation_children(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_children(ctx, field)
+func (ec *executionContext) _NavigationOverride_icon(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_icon(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2356,7 +3168,7 @@ func (ec *executionContext) _ShellNavigation_children(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Children, nil
+		return obj.Icon, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2365,42 +3177,26 @@ func (ec *executionContext) _ShellNavigation_children(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ShellNavigationChild)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOShellNavigationChild2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationChild(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_children(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "title":
-				return ec.fieldContext_ShellNavigationChild_title(ctx, field)
-			case "subTitle":
-				return ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
-			case "module":
-				return ec.fieldContext_ShellNavigationChild_module(ctx, field)
-			case "authRequired":
-				return ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
-			case "children":
-				return ec.fieldContext_ShellNavigationChild_children(ctx, field)
-			case "healthy":
-				return ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
-			case "icon":
-				return ec.fieldContext_ShellNavigationChild_icon(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationChild", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigation_healthy(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_healthy(ctx, field)
+func (ec *executionContext) _NavigationOverride_category(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_category(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2413,38 +3209,35 @@ func (ec *executionContext) _ShellNavigation_healthy(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Healthy, nil
+		return obj.Category, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigation_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_module(ctx, field)
+func (ec *executionContext) _NavigationOverride_hidden(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_hidden(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2457,50 +3250,35 @@ func (ec *executionContext) _ShellNavigation_module(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Module, nil
+		return obj.Hidden, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ShellNavigationModule)
+	res := resTmp.(*bool)
 	fc.Result = res
-	return ec.marshalNShellNavigationModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationModule(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "path":
-				return ec.fieldContext_ShellNavigationModule_path(ctx, field)
-			case "remoteEntry":
-				return ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
-			case "exposedModule":
-				return ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
-			case "moduleName":
-				return ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
-			case "outlet":
-				return ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationModule", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigation_icon(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_icon(ctx, field)
+func (ec *executionContext) _NavigationOverride_order(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_order(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2513,38 +3291,35 @@ func (ec *executionContext) _ShellNavigation_icon(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Icon, nil
+		return obj.Order, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_order(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigation_hidden(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_hidden(ctx, field)
+func (ec *executionContext) _NavigationOverride_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2557,7 +3332,7 @@ func (ec *executionContext) _ShellNavigation_hidden(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hidden, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2569,26 +3344,26 @@ func (ec *executionContext) _ShellNavigation_hidden(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationCategory_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationCategory_title(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_id(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2601,7 +3376,7 @@ func (ec *executionContext) _ShellNavigationCategory_title(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2618,9 +3393,9 @@ func (ec *executionContext) _ShellNavigationCategory_title(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationCategory_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationCategory",
+		Object:     "NavigationSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2631,8 +3406,8 @@ func (ec *executionContext) fieldContext_ShellNavigationCategory_title(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationCategory_priority(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationCategory_priority(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_app(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_app(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2645,7 +3420,7 @@ func (ec *executionContext) _ShellNavigationCategory_priority(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Priority, nil
+		return obj.App, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2657,26 +3432,26 @@ func (ec *executionContext) _ShellNavigationCategory_priority(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationCategory_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_app(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationCategory",
+		Object:     "NavigationSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationCategory_category(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationCategory_category(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_title(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2689,7 +3464,7 @@ func (ec *executionContext) _ShellNavigationCategory_category(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Category, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2701,26 +3476,26 @@ func (ec *executionContext) _ShellNavigationCategory_category(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.RegisterAppCategory)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNRegisterAppCategory2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppCategory(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationCategory_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationCategory",
+		Object:     "NavigationSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RegisterAppCategory does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationCategory_entries(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationCategory_entries(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_subTitle(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2733,55 +3508,38 @@ func (ec *executionContext) _ShellNavigationCategory_entries(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Entries, nil
+		return obj.SubTitle, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ShellNavigation)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOShellNavigation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigation(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationCategory_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationCategory",
+		Object:     "NavigationSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ShellNavigation_id(ctx, field)
-			case "title":
-				return ec.fieldContext_ShellNavigation_title(ctx, field)
-			case "subTitle":
-				return ec.fieldContext_ShellNavigation_subTitle(ctx, field)
-			case "authRequired":
-				return ec.fieldContext_ShellNavigation_authRequired(ctx, field)
-			case "children":
-				return ec.fieldContext_ShellNavigation_children(ctx, field)
-			case "healthy":
-				return ec.fieldContext_ShellNavigation_healthy(ctx, field)
-			case "module":
-				return ec.fieldContext_ShellNavigation_module(ctx, field)
-			case "icon":
-				return ec.fieldContext_ShellNavigation_icon(ctx, field)
-			case "hidden":
-				return ec.fieldContext_ShellNavigation_hidden(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigation", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationChild_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_title(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_icon(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_icon(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2794,7 +3552,7 @@ func (ec *executionContext) _ShellNavigationChild_title(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.Icon, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2811,9 +3569,9 @@ func (ec *executionContext) _ShellNavigationChild_title(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
+		Object:     "NavigationSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2824,8 +3582,8 @@ func (ec *executionContext) fieldContext_ShellNavigationChild_title(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationChild_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_path(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2838,7 +3596,7 @@ func (ec *executionContext) _ShellNavigationChild_subTitle(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubTitle, nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2855,9 +3613,9 @@ func (ec *executionContext) _ShellNavigationChild_subTitle(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
+		Object:     "NavigationSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2868,8 +3626,8 @@ func (ec *executionContext) fieldContext_ShellNavigationChild_subTitle(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationChild_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_module(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_category(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_category(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2882,7 +3640,7 @@ func (ec *executionContext) _ShellNavigationChild_module(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Module, nil
+		return obj.Category, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2894,38 +3652,26 @@ func (ec *executionContext) _ShellNavigationChild_module(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ShellNavigationModule)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNShellNavigationModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationModule(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
+		Object:     "NavigationSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "path":
-				return ec.fieldContext_ShellNavigationModule_path(ctx, field)
-			case "remoteEntry":
-				return ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
-			case "exposedModule":
-				return ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
-			case "moduleName":
-				return ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
-			case "outlet":
-				return ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationModule", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationChild_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_breadcrumbs(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_breadcrumbs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2938,7 +3684,7 @@ func (ec *executionContext) _ShellNavigationChild_authRequired(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AuthRequired, nil
+		return obj.Breadcrumbs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2950,26 +3696,26 @@ func (ec *executionContext) _ShellNavigationChild_authRequired(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_breadcrumbs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
+		Object:     "NavigationSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationChild_children(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_children(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_state(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_state(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2982,51 +3728,38 @@ func (ec *executionContext) _ShellNavigationChild_children(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Children, nil
+		return obj.State, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ShellNavigationChild)
+	res := resTmp.(model.ShellNavigationState)
 	fc.Result = res
-	return ec.marshalOShellNavigationChild2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationChild(ctx, field.Selections, res)
+	return ec.marshalNShellNavigationState2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_children(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
+		Object:     "NavigationSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "title":
-				return ec.fieldContext_ShellNavigationChild_title(ctx, field)
-			case "subTitle":
-				return ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
-			case "module":
-				return ec.fieldContext_ShellNavigationChild_module(ctx, field)
-			case "authRequired":
-				return ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
-			case "children":
-				return ec.fieldContext_ShellNavigationChild_children(ctx, field)
-			case "healthy":
-				return ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
-			case "icon":
-				return ec.fieldContext_ShellNavigationChild_icon(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationChild", field.Name)
+			return nil, errors.New("field of type ShellNavigationState does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationChild_healthy(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_score(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_score(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3039,7 +3772,7 @@ func (ec *executionContext) _ShellNavigationChild_healthy(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Healthy, nil
+		return obj.Score, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3051,26 +3784,26 @@ func (ec *executionContext) _ShellNavigationChild_healthy(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
+		Object:     "NavigationSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationChild_icon(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_icon(ctx, field)
+func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_total(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3083,7 +3816,7 @@ func (ec *executionContext) _ShellNavigationChild_icon(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Icon, nil
+		return obj.Total, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3095,26 +3828,26 @@ func (ec *executionContext) _ShellNavigationChild_icon(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationModule_path(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationModule_path(ctx, field)
+func (ec *executionContext) _PageInfo_next(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_next(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3127,7 +3860,7 @@ func (ec *executionContext) _ShellNavigationModule_path(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return obj.Next, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3139,26 +3872,26 @@ func (ec *executionContext) _ShellNavigationModule_path(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationModule_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_next(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationModule",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationModule_remoteEntry(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
+func (ec *executionContext) _PageInfo_prev(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_prev(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3171,7 +3904,7 @@ func (ec *executionContext) _ShellNavigationModule_remoteEntry(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RemoteEntry, nil
+		return obj.Prev, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3183,26 +3916,26 @@ func (ec *executionContext) _ShellNavigationModule_remoteEntry(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationModule_remoteEntry(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_prev(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationModule",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationModule_exposedModule(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
+func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3215,7 +3948,7 @@ func (ec *executionContext) _ShellNavigationModule_exposedModule(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExposedModule, nil
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3227,26 +3960,26 @@ func (ec *executionContext) _ShellNavigationModule_exposedModule(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationModule_exposedModule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationModule",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationModule_moduleName(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
+func (ec *executionContext) _PageInfo_perPage(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_perPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3259,7 +3992,7 @@ func (ec *executionContext) _ShellNavigationModule_moduleName(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ModuleName, nil
+		return obj.PerPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3271,26 +4004,26 @@ func (ec *executionContext) _ShellNavigationModule_moduleName(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationModule_moduleName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_perPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationModule",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationModule_outlet(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
+func (ec *executionContext) _PageInfo_totalPage(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_totalPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3303,7 +4036,7 @@ func (ec *executionContext) _ShellNavigationModule_outlet(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Outlet, nil
+		return obj.TotalPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3315,26 +4048,26 @@ func (ec *executionContext) _ShellNavigationModule_outlet(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationModule_outlet(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_totalPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationModule",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlot_priority(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlot_priority(ctx, field)
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3347,7 +4080,7 @@ func (ec *executionContext) _ShellNavigationSlot_priority(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Priority, nil
+		return ec.introspectType(fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3356,26 +4089,59 @@ func (ec *executionContext) _ShellNavigationSlot_priority(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlot_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlot",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlot_slot(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlot_slot(ctx, field)
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3388,38 +4154,49 @@ func (ec *executionContext) _ShellNavigationSlot_slot(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Slot, nil
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlot_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlot",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlot_description(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlot_description(ctx, field)
+func (ec *executionContext) _RecentApp_app(ctx context.Context, field graphql.CollectedField, obj *model.RecentApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RecentApp_app(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3432,7 +4209,7 @@ func (ec *executionContext) _ShellNavigationSlot_description(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.App, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3449,9 +4226,9 @@ func (ec *executionContext) _ShellNavigationSlot_description(ctx context.Context
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlot_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecentApp_app(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlot",
+		Object:     "RecentApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3462,8 +4239,8 @@ func (ec *executionContext) fieldContext_ShellNavigationSlot_description(_ conte
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlot_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlot_authRequired(ctx, field)
+func (ec *executionContext) _RecentApp_visitedAt(ctx context.Context, field graphql.CollectedField, obj *model.RecentApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RecentApp_visitedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3476,35 +4253,38 @@ func (ec *executionContext) _ShellNavigationSlot_authRequired(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AuthRequired, nil
+		return obj.VisitedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlot_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RecentApp_visitedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlot",
+		Object:     "RecentApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlot_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlot_module(ctx, field)
+func (ec *executionContext) _RegisterAppOutput_id(ctx context.Context, field graphql.CollectedField, obj *model.RegisterAppOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisterAppOutput_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3517,7 +4297,7 @@ func (ec *executionContext) _ShellNavigationSlot_module(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Module, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3529,36 +4309,26 @@ func (ec *executionContext) _ShellNavigationSlot_module(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ShellNavigationSlotModule)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNShellNavigationSlotModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationSlotModule(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlot_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegisterAppOutput_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlot",
+		Object:     "RegisterAppOutput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "path":
-				return ec.fieldContext_ShellNavigationSlotModule_path(ctx, field)
-			case "remoteEntry":
-				return ec.fieldContext_ShellNavigationSlotModule_remoteEntry(ctx, field)
-			case "exposedModule":
-				return ec.fieldContext_ShellNavigationSlotModule_exposedModule(ctx, field)
-			case "moduleName":
-				return ec.fieldContext_ShellNavigationSlotModule_moduleName(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationSlotModule", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlotModule_path(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlotModule_path(ctx, field)
+func (ec *executionContext) _RegisteredApp_pkg(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredApp_pkg(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3571,7 +4341,7 @@ func (ec *executionContext) _ShellNavigationSlotModule_path(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return obj.Pkg, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3588,9 +4358,9 @@ func (ec *executionContext) _ShellNavigationSlotModule_path(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlotModule_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegisteredApp_pkg(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlotModule",
+		Object:     "RegisteredApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3601,8 +4371,8 @@ func (ec *executionContext) fieldContext_ShellNavigationSlotModule_path(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlotModule_remoteEntry(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlotModule_remoteEntry(ctx, field)
+func (ec *executionContext) _RegisteredApp_name(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredApp_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3615,26 +4385,23 @@ func (ec *executionContext) _ShellNavigationSlotModule_remoteEntry(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RemoteEntry, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlotModule_remoteEntry(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegisteredApp_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlotModule",
+		Object:     "RegisteredApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3645,8 +4412,8 @@ func (ec *executionContext) fieldContext_ShellNavigationSlotModule_remoteEntry(_
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlotModule_exposedModule(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlotModule_exposedModule(ctx, field)
+func (ec *executionContext) _RegisteredApp_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredApp_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3659,38 +4426,35 @@ func (ec *executionContext) _ShellNavigationSlotModule_exposedModule(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExposedModule, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlotModule_exposedModule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegisteredApp_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlotModule",
+		Object:     "RegisteredApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlotModule_moduleName(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlotModule_moduleName(ctx, field)
+func (ec *executionContext) _RegisteredApp_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredApp_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3703,38 +4467,35 @@ func (ec *executionContext) _ShellNavigationSlotModule_moduleName(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ModuleName, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlotModule_moduleName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegisteredApp_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlotModule",
+		Object:     "RegisteredApp",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TagValue_Value(ctx context.Context, field graphql.CollectedField, obj *model.TagValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TagValue_Value(ctx, field)
+func (ec *executionContext) _RegisteredAppsPage_data(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredAppsPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredAppsPage_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3747,38 +4508,45 @@ func (ec *executionContext) _TagValue_Value(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(any)
+	res := resTmp.([]*model.RegisteredApp)
 	fc.Result = res
-	return ec.marshalNAny2interface(ctx, field.Selections, res)
+	return ec.marshalORegisteredApp2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredApp(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TagValue_Value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegisteredAppsPage_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TagValue",
+		Object:     "RegisteredAppsPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			switch field.Name {
+			case "pkg":
+				return ec.fieldContext_RegisteredApp_pkg(ctx, field)
+			case "name":
+				return ec.fieldContext_RegisteredApp_name(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RegisteredApp_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RegisteredApp_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RegisteredApp", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TagValues_Key(ctx context.Context, field graphql.CollectedField, obj *model.TagValues) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TagValues_Key(ctx, field)
+func (ec *executionContext) _RegisteredAppsPage_page(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredAppsPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredAppsPage_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3791,7 +4559,7 @@ func (ec *executionContext) _TagValues_Key(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3803,26 +4571,40 @@ func (ec *executionContext) _TagValues_Key(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*genericdb.PageInfo)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TagValues_Key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegisteredAppsPage_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TagValues",
+		Object:     "RegisteredAppsPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "next":
+				return ec.fieldContext_PageInfo_next(ctx, field)
+			case "prev":
+				return ec.fieldContext_PageInfo_prev(ctx, field)
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "perPage":
+				return ec.fieldContext_PageInfo_perPage(ctx, field)
+			case "totalPage":
+				return ec.fieldContext_PageInfo_totalPage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TagValues_Values(ctx context.Context, field graphql.CollectedField, obj *model.TagValues) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TagValues_Values(ctx, field)
+func (ec *executionContext) _ShellConfiguration_version(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3835,39 +4617,38 @@ func (ec *executionContext) _TagValues_Values(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Values, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TagValue)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOTagValue2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTagValue(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TagValues_Values(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfiguration_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TagValues",
+		Object:     "ShellConfiguration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "Value":
-				return ec.fieldContext_TagValue_Value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TagValue", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
+func (ec *executionContext) _ShellConfiguration_hash(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3880,26 +4661,23 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Hash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfiguration_hash(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "ShellConfiguration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3910,8 +4688,8 @@ func (ec *executionContext) fieldContext___Directive_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
+func (ec *executionContext) _ShellConfiguration_baseVersion(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_baseVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3924,7 +4702,7 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.BaseVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3933,26 +4711,26 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfiguration_baseVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "ShellConfiguration",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+func (ec *executionContext) _ShellConfiguration_patch(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_patch(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3965,38 +4743,43 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.Patch, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.([]*model.ShellConfigurationPatchOperation)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOShellConfigurationPatchOperation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationPatchOperationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfiguration_patch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "ShellConfiguration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			switch field.Name {
+			case "op":
+				return ec.fieldContext_ShellConfigurationPatchOperation_op(ctx, field)
+			case "path":
+				return ec.fieldContext_ShellConfigurationPatchOperation_path(ctx, field)
+			case "value":
+				return ec.fieldContext_ShellConfigurationPatchOperation_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellConfigurationPatchOperation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
+func (ec *executionContext) _ShellConfiguration_defaultRoute(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_defaultRoute(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4009,48 +4792,35 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.DefaultRoute, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfiguration_defaultRoute(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "ShellConfiguration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+func (ec *executionContext) _ShellConfiguration_locale(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_locale(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4063,38 +4833,35 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return obj.Locale, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfiguration_locale(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "ShellConfiguration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+func (ec *executionContext) _ShellConfiguration_locales(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_locales(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4107,26 +4874,23 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Locales, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfiguration_locales(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "ShellConfiguration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4137,8 +4901,8 @@ func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+func (ec *executionContext) _ShellConfiguration_categories(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_categories(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4151,7 +4915,7 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Categories, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4160,29 +4924,43 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.ShellNavigationCategory)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOShellNavigationCategory2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationCategory(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfiguration_categories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "ShellConfiguration",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
+			switch field.Name {
+			case "title":
+				return ec.fieldContext_ShellNavigationCategory_title(ctx, field)
+			case "priority":
+				return ec.fieldContext_ShellNavigationCategory_priority(ctx, field)
+			case "category":
+				return ec.fieldContext_ShellNavigationCategory_category(ctx, field)
+			case "icon":
+				return ec.fieldContext_ShellNavigationCategory_icon(ctx, field)
+			case "hidden":
+				return ec.fieldContext_ShellNavigationCategory_hidden(ctx, field)
+			case "entries":
+				return ec.fieldContext_ShellNavigationCategory_entries(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationCategory", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_slots(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_slots(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
@@ -4192,38 +4970,49 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.Slots, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*model.ShellNavigationSlot)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOShellNavigationSlot2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationSlot(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfiguration_slots(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "ShellConfiguration",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "priority":
+				return ec.fieldContext_ShellNavigationSlot_priority(ctx, field)
+			case "slot":
+				return ec.fieldContext_ShellNavigationSlot_slot(ctx, field)
+			case "app":
+				return ec.fieldContext_ShellNavigationSlot_app(ctx, field)
+			case "description":
+				return ec.fieldContext_ShellNavigationSlot_description(ctx, field)
+			case "authRequired":
+				return ec.fieldContext_ShellNavigationSlot_authRequired(ctx, field)
+			case "module":
+				return ec.fieldContext_ShellNavigationSlot_module(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationSlot", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+func (ec *executionContext) _ShellConfiguration_maintenance(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_maintenance(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4236,7 +5025,7 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.Maintenance, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4245,26 +5034,36 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.MaintenanceWindow)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMaintenanceWindow2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐMaintenanceWindow(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfiguration_maintenance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "ShellConfiguration",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "app":
+				return ec.fieldContext_MaintenanceWindow_app(ctx, field)
+			case "message":
+				return ec.fieldContext_MaintenanceWindow_message(ctx, field)
+			case "until":
+				return ec.fieldContext_MaintenanceWindow_until(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_MaintenanceWindow_startedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MaintenanceWindow", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
+func (ec *executionContext) _ShellConfigurationPatchOperation_op(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationPatchOperation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfigurationPatchOperation_op(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4277,7 +5076,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Op, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4294,9 +5093,9 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfigurationPatchOperation_op(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "ShellConfigurationPatchOperation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4307,8 +5106,8 @@ func (ec *executionContext) fieldContext___Field_name(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
+func (ec *executionContext) _ShellConfigurationPatchOperation_path(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationPatchOperation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfigurationPatchOperation_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4321,25 +5120,28 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfigurationPatchOperation_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "ShellConfigurationPatchOperation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -4348,8 +5150,8 @@ func (ec *executionContext) fieldContext___Field_description(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
+func (ec *executionContext) _ShellConfigurationPatchOperation_value(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationPatchOperation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfigurationPatchOperation_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4362,48 +5164,35 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(any)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfigurationPatchOperation_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "ShellConfigurationPatchOperation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
+func (ec *executionContext) _ShellConfigurationSubscription_configuration(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationSubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfigurationSubscription_configuration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4416,7 +5205,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Configuration, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4428,48 +5217,48 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.ShellConfiguration)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNShellConfiguration2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfiguration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfigurationSubscription_configuration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "ShellConfigurationSubscription",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "version":
+				return ec.fieldContext_ShellConfiguration_version(ctx, field)
+			case "hash":
+				return ec.fieldContext_ShellConfiguration_hash(ctx, field)
+			case "baseVersion":
+				return ec.fieldContext_ShellConfiguration_baseVersion(ctx, field)
+			case "patch":
+				return ec.fieldContext_ShellConfiguration_patch(ctx, field)
+			case "defaultRoute":
+				return ec.fieldContext_ShellConfiguration_defaultRoute(ctx, field)
+			case "locale":
+				return ec.fieldContext_ShellConfiguration_locale(ctx, field)
+			case "locales":
+				return ec.fieldContext_ShellConfiguration_locales(ctx, field)
+			case "categories":
+				return ec.fieldContext_ShellConfiguration_categories(ctx, field)
+			case "slots":
+				return ec.fieldContext_ShellConfiguration_slots(ctx, field)
+			case "maintenance":
+				return ec.fieldContext_ShellConfiguration_maintenance(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ShellConfiguration", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+func (ec *executionContext) _ShellConfigurationSubscription_eventType(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationSubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfigurationSubscription_eventType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4482,7 +5271,7 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.EventType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4494,26 +5283,26 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.ShellConfigEventType)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNShellConfigEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigEventType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellConfigurationSubscription_eventType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "ShellConfigurationSubscription",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type ShellConfigEventType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+func (ec *executionContext) _ShellNavigation_id(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4526,25 +5315,28 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "ShellNavigation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -4553,8 +5345,8 @@ func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+func (ec *executionContext) _ShellNavigation_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4567,7 +5359,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4584,9 +5376,9 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "ShellNavigation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4597,8 +5389,8 @@ func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+func (ec *executionContext) _ShellNavigation_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_subTitle(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4611,25 +5403,28 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.SubTitle, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "ShellNavigation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -4638,8 +5433,8 @@ func (ec *executionContext) fieldContext___InputValue_description(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+func (ec *executionContext) _ShellNavigation_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_authRequired(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4652,7 +5447,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.AuthRequired, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4664,48 +5459,26 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "ShellNavigation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+func (ec *executionContext) _ShellNavigation_children(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_children(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4718,7 +5491,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.Children, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4727,26 +5500,46 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.ShellNavigationChild)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOShellNavigationChild2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationChild(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_children(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "ShellNavigation",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "title":
+				return ec.fieldContext_ShellNavigationChild_title(ctx, field)
+			case "subTitle":
+				return ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
+			case "module":
+				return ec.fieldContext_ShellNavigationChild_module(ctx, field)
+			case "authRequired":
+				return ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
+			case "children":
+				return ec.fieldContext_ShellNavigationChild_children(ctx, field)
+			case "healthy":
+				return ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
+			case "state":
+				return ec.fieldContext_ShellNavigationChild_state(ctx, field)
+			case "icon":
+				return ec.fieldContext_ShellNavigationChild_icon(ctx, field)
+			case "hidden":
+				return ec.fieldContext_ShellNavigationChild_hidden(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationChild", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_description(ctx, field)
+func (ec *executionContext) _ShellNavigation_healthy(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_healthy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4759,35 +5552,38 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Healthy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ShellNavigation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_types(ctx, field)
+func (ec *executionContext) _ShellNavigation_state(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_state(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4800,7 +5596,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.State, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4812,48 +5608,26 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(model.ShellNavigationState)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNShellNavigationState2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ShellNavigation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type ShellNavigationState does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+func (ec *executionContext) _ShellNavigation_maintenance(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_maintenance(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4866,60 +5640,45 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.Maintenance, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.MaintenanceWindow)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOMaintenanceWindow2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐMaintenanceWindow(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_maintenance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ShellNavigation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			case "app":
+				return ec.fieldContext_MaintenanceWindow_app(ctx, field)
+			case "message":
+				return ec.fieldContext_MaintenanceWindow_message(ctx, field)
+			case "until":
+				return ec.fieldContext_MaintenanceWindow_until(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_MaintenanceWindow_startedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MaintenanceWindow", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+func (ec *executionContext) _ShellNavigation_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_module(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4932,57 +5691,52 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.Module, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.ShellNavigationModule)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNShellNavigationModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationModule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ShellNavigation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "path":
+				return ec.fieldContext_ShellNavigationModule_path(ctx, field)
+			case "remoteEntry":
+				return ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
+			case "exposedModule":
+				return ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
+			case "moduleName":
+				return ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
+			case "outlet":
+				return ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
+			case "format":
+				return ec.fieldContext_ShellNavigationModule_format(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationModule", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+func (ec *executionContext) _ShellNavigation_icon(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_icon(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4995,57 +5749,38 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.Icon, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ShellNavigation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+func (ec *executionContext) _ShellNavigation_hidden(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_hidden(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5058,7 +5793,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.Hidden, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5070,38 +5805,26 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigation_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ShellNavigation",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_kind(ctx, field)
+func (ec *executionContext) _ShellNavigationCategory_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5114,7 +5837,7 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5128,24 +5851,24 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigationCategory_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ShellNavigationCategory",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_name(ctx, field)
+func (ec *executionContext) _ShellNavigationCategory_priority(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_priority(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5158,35 +5881,38 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return obj.Priority, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigationCategory_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ShellNavigationCategory",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_description(ctx, field)
+func (ec *executionContext) _ShellNavigationCategory_category(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_category(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5199,25 +5925,28 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Category, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigationCategory_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ShellNavigationCategory",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5226,8 +5955,8 @@ func (ec *executionContext) fieldContext___Type_description(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_fields(ctx, field)
+func (ec *executionContext) _ShellNavigationCategory_icon(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_icon(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5240,7 +5969,7 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.Icon, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5249,51 +5978,26 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigationCategory_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ShellNavigationCategory",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+func (ec *executionContext) _ShellNavigationCategory_hidden(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_hidden(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5306,57 +6010,38 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return obj.Hidden, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigationCategory_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ShellNavigationCategory",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+func (ec *executionContext) _ShellNavigationCategory_entries(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_entries(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5369,7 +6054,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return obj.Entries, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5378,48 +6063,50 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.([]*model.ShellNavigation)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOShellNavigation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigationCategory_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ShellNavigationCategory",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "id":
+				return ec.fieldContext_ShellNavigation_id(ctx, field)
+			case "title":
+				return ec.fieldContext_ShellNavigation_title(ctx, field)
+			case "subTitle":
+				return ec.fieldContext_ShellNavigation_subTitle(ctx, field)
+			case "authRequired":
+				return ec.fieldContext_ShellNavigation_authRequired(ctx, field)
+			case "children":
+				return ec.fieldContext_ShellNavigation_children(ctx, field)
+			case "healthy":
+				return ec.fieldContext_ShellNavigation_healthy(ctx, field)
+			case "state":
+				return ec.fieldContext_ShellNavigation_state(ctx, field)
+			case "maintenance":
+				return ec.fieldContext_ShellNavigation_maintenance(ctx, field)
+			case "module":
+				return ec.fieldContext_ShellNavigation_module(ctx, field)
+			case "icon":
+				return ec.fieldContext_ShellNavigation_icon(ctx, field)
+			case "hidden":
+				return ec.fieldContext_ShellNavigation_hidden(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+func (ec *executionContext) _ShellNavigationChild_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5432,56 +6119,38 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigationChild_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ShellNavigationChild",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+func (ec *executionContext) _ShellNavigationChild_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5494,46 +6163,39 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return obj.SubTitle, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigationChild_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ShellNavigationChild",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_ofType(ctx, field)
-	if err != nil {
+func (ec *executionContext) _ShellNavigationChild_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_module(ctx, field)
+	if err != nil {
 		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
@@ -5545,57 +6207,52 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return obj.Module, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.ShellNavigationModule)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNShellNavigationModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationModule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigationChild_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ShellNavigationChild",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "path":
+				return ec.fieldContext_ShellNavigationModule_path(ctx, field)
+			case "remoteEntry":
+				return ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
+			case "exposedModule":
+				return ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
+			case "moduleName":
+				return ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
+			case "outlet":
+				return ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
+			case "format":
+				return ec.fieldContext_ShellNavigationModule_format(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationModule", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+func (ec *executionContext) _ShellNavigationChild_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5608,824 +6265,5046 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpecifiedByURL(), nil
+		return obj.AuthRequired, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ShellNavigationChild_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ShellNavigationChild",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputKeepAliveAppInput(ctx context.Context, obj interface{}) (model.KeepAliveAppInput, error) {
-	var it model.KeepAliveAppInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+func (ec *executionContext) _ShellNavigationChild_children(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_children(ctx, field)
+	if err != nil {
+		return graphql.Null
 	}
-
-	fieldsInOrder := [...]string{"pkg", "version", "name"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "pkg":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pkg"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Pkg = data
-		case "version":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Version = data
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Children, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
 	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputPage(ctx context.Context, obj interface{}) (genericdb.Page, error) {
-	var it genericdb.Page
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	if resTmp == nil {
+		return graphql.Null
 	}
+	res := resTmp.([]*model.ShellNavigationChild)
+	fc.Result = res
+	return ec.marshalOShellNavigationChild2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationChild(ctx, field.Selections, res)
+}
 
-	fieldsInOrder := [...]string{"limit", "page"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "limit":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-			data, err := ec.unmarshalOInt2int64(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Limit = data
-		case "page":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
-			data, err := ec.unmarshalOInt2int64(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) fieldContext_ShellNavigationChild_children(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "title":
+				return ec.fieldContext_ShellNavigationChild_title(ctx, field)
+			case "subTitle":
+				return ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
+			case "module":
+				return ec.fieldContext_ShellNavigationChild_module(ctx, field)
+			case "authRequired":
+				return ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
+			case "children":
+				return ec.fieldContext_ShellNavigationChild_children(ctx, field)
+			case "healthy":
+				return ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
+			case "state":
+				return ec.fieldContext_ShellNavigationChild_state(ctx, field)
+			case "icon":
+				return ec.fieldContext_ShellNavigationChild_icon(ctx, field)
+			case "hidden":
+				return ec.fieldContext_ShellNavigationChild_hidden(ctx, field)
 			}
-			it.Page = data
-		}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationChild", field.Name)
+		},
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputQueryOperatorAndDate(ctx context.Context, obj interface{}) (model.QueryOperatorAndDate, error) {
-	var it model.QueryOperatorAndDate
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+func (ec *executionContext) _ShellNavigationChild_healthy(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
+	if err != nil {
+		return graphql.Null
 	}
-
-	fieldsInOrder := [...]string{"op", "value"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		switch k {
-		case "op":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
-			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Op = data
-		case "value":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Value = data
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Healthy, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
 
-	return it, nil
+func (ec *executionContext) fieldContext_ShellNavigationChild_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputQueryOperatorAndValue(ctx context.Context, obj interface{}) (model.QueryOperatorAndValue, error) {
-	var it model.QueryOperatorAndValue
+func (ec *executionContext) _ShellNavigationChild_state(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_state(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.State, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ShellNavigationState)
+	fc.Result = res
+	return ec.marshalNShellNavigationState2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationState(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShellNavigationState does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_icon(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_icon(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Icon, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_hidden(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_hidden(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hidden, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_path(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_path(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_remoteEntry(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RemoteEntry, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_remoteEntry(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_exposedModule(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExposedModule, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_exposedModule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_moduleName(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ModuleName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_moduleName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_outlet(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Outlet, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_outlet(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_format(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_format(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Format, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ModuleFormat)
+	fc.Result = res
+	return ec.marshalNModuleFormat2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ModuleFormat does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_priority(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_priority(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Priority, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_slot(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_slot(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Slot, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_app(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_app(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.App, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_app(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_description(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_authRequired(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuthRequired, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_module(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Module, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ShellNavigationSlotModule)
+	fc.Result = res
+	return ec.marshalNShellNavigationSlotModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationSlotModule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_ShellNavigationSlotModule_path(ctx, field)
+			case "remoteEntry":
+				return ec.fieldContext_ShellNavigationSlotModule_remoteEntry(ctx, field)
+			case "exposedModule":
+				return ec.fieldContext_ShellNavigationSlotModule_exposedModule(ctx, field)
+			case "moduleName":
+				return ec.fieldContext_ShellNavigationSlotModule_moduleName(ctx, field)
+			case "format":
+				return ec.fieldContext_ShellNavigationSlotModule_format(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationSlotModule", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlotModule_path(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlotModule_path(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlotModule_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlotModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlotModule_remoteEntry(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlotModule_remoteEntry(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RemoteEntry, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlotModule_remoteEntry(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlotModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlotModule_exposedModule(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlotModule_exposedModule(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExposedModule, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlotModule_exposedModule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlotModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlotModule_moduleName(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlotModule_moduleName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ModuleName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlotModule_moduleName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlotModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlotModule_format(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlotModule_format(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Format, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ModuleFormat)
+	fc.Result = res
+	return ec.marshalNModuleFormat2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlotModule_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlotModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ModuleFormat does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignOverrideOutput_value(ctx context.Context, field graphql.CollectedField, obj *model.SignOverrideOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SignOverrideOutput_value(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SignOverrideOutput_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignOverrideOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignOverrideOutput_signature(ctx context.Context, field graphql.CollectedField, obj *model.SignOverrideOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SignOverrideOutput_signature(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Signature, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SignOverrideOutput_signature(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignOverrideOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignOverrideOutput_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.SignOverrideOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SignOverrideOutput_expiresAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExpiresAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SignOverrideOutput_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignOverrideOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TagValue_Value(ctx context.Context, field graphql.CollectedField, obj *model.TagValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TagValue_Value(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(any)
+	fc.Result = res
+	return ec.marshalNAny2interface(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TagValue_Value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TagValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Any does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TagValues_Key(ctx context.Context, field graphql.CollectedField, obj *model.TagValues) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TagValues_Key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TagValues_Key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TagValues",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TagValues_Values(ctx context.Context, field graphql.CollectedField, obj *model.TagValues) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TagValues_Values(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Values, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TagValue)
+	fc.Result = res
+	return ec.marshalOTagValue2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTagValue(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TagValues_Values(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TagValues",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "Value":
+				return ec.fieldContext_TagValue_Value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TagValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPreferences_defaultRoute(ctx context.Context, field graphql.CollectedField, obj *model.UserPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserPreferences_defaultRoute(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultRoute, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserPreferences_defaultRoute(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPreferences",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPreferences_pinned(ctx context.Context, field graphql.CollectedField, obj *model.UserPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserPreferences_pinned(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pinned, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserPreferences_pinned(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPreferences",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPreferences_hidden(ctx context.Context, field graphql.CollectedField, obj *model.UserPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserPreferences_hidden(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hidden, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserPreferences_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPreferences",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPreferences_recents(ctx context.Context, field graphql.CollectedField, obj *model.UserPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserPreferences_recents(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Recents, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RecentApp)
+	fc.Result = res
+	return ec.marshalNRecentApp2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRecentAppᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserPreferences_recents(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPreferences",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "app":
+				return ec.fieldContext_RecentApp_app(ctx, field)
+			case "visitedAt":
+				return ec.fieldContext_RecentApp_visitedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecentApp", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_types(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpecifiedByURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAuditEventQueryFields(ctx context.Context, obj interface{}) (model.AuditEventQueryFields, error) {
+	var it model.AuditEventQueryFields
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"createdAt", "type", "app", "actor"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "createdAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
+			data, err := ec.unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedAt = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalOQueryOperatorAndValue2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndValue(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "app":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("app"))
+			data, err := ec.unmarshalOQueryOperatorAndValue2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndValue(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.App = data
+		case "actor":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actor"))
+			data, err := ec.unmarshalOQueryOperatorAndValue2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndValue(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Actor = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAuditEventsSort(ctx context.Context, obj interface{}) (model.AuditEventsSort, error) {
+	var it model.AuditEventsSort
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"createdAt", "type", "app", "actor"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "createdAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedAt = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "app":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("app"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.App = data
+		case "actor":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actor"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Actor = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAuditEventsWhereRules(ctx context.Context, obj interface{}) (model.AuditEventsWhereRules, error) {
+	var it model.AuditEventsWhereRules
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"condition", "fields", "rules"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "condition":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
+			data, err := ec.unmarshalNQueryCondition2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐQueryCondition(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Condition = data
+		case "fields":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fields"))
+			data, err := ec.unmarshalOAuditEventQueryFields2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventQueryFields(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Fields = data
+		case "rules":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rules"))
+			data, err := ec.unmarshalOAuditEventsWhereRules2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventsWhereRules(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Rules = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputClearMaintenanceInput(ctx context.Context, obj interface{}) (model.ClearMaintenanceInput, error) {
+	var it model.ClearMaintenanceInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"app"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "app":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("app"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.App = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputKeepAliveAppInput(ctx context.Context, obj interface{}) (model.KeepAliveAppInput, error) {
+	var it model.KeepAliveAppInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"pkg", "version", "name"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "pkg":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pkg"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pkg = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOverrideInput(ctx context.Context, obj interface{}) (model.OverrideInput, error) {
+	var it model.OverrideInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"app", "url"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "app":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("app"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.App = data
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URL = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPage(ctx context.Context, obj interface{}) (genericdb.Page, error) {
+	var it genericdb.Page
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"limit", "page"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2int64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "page":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+			data, err := ec.unmarshalOInt2int64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Page = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputQueryOperatorAndDate(ctx context.Context, obj interface{}) (model.QueryOperatorAndDate, error) {
+	var it model.QueryOperatorAndDate
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"op", "value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "op":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
+			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Op = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputQueryOperatorAndValue(ctx context.Context, obj interface{}) (model.QueryOperatorAndValue, error) {
+	var it model.QueryOperatorAndValue
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"op", "value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "op":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
+			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Op = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputQueryOperatorFieldAndValue(ctx context.Context, obj interface{}) (model.QueryOperatorFieldAndValue, error) {
+	var it model.QueryOperatorFieldAndValue
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"field", "op", "value", "exists"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "op":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
+			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Op = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		case "exists":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exists"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Exists = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputQueryValue(ctx context.Context, obj interface{}) (model.QueryValue, error) {
+	var it model.QueryValue
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterAppInput(ctx context.Context, obj interface{}) (model.RegisterAppInput, error) {
+	var it model.RegisterAppInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "id", "package", "version", "remoteEntryFile", "proxy", "webUrl", "apiUrl", "navigation", "categories", "slots", "slot1", "slot2", "slot3", "format", "shared"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "package":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("package"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Package = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		case "remoteEntryFile":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("remoteEntryFile"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RemoteEntryFile = data
+		case "proxy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proxy"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Proxy = data
+		case "webUrl":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("webUrl"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WebURL = data
+		case "apiUrl":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("apiUrl"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.APIURL = data
+		case "navigation":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("navigation"))
+			data, err := ec.unmarshalORegisterAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppNavigationInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Navigation = data
+		case "categories":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("categories"))
+			data, err := ec.unmarshalORegisterNavigationCategoryInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterNavigationCategoryInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Categories = data
+		case "slots":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slots"))
+			data, err := ec.unmarshalORegisterAppSlot2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlotᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slots = data
+		case "slot1":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot1"))
+			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slot1 = data
+		case "slot2":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot2"))
+			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slot2 = data
+		case "slot3":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot3"))
+			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slot3 = data
+		case "format":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("format"))
+			data, err := ec.unmarshalOModuleFormat2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Format = data
+		case "shared":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shared"))
+			data, err := ec.unmarshalORegisterSharedDependencyInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterSharedDependencyInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Shared = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterAppModule(ctx context.Context, obj interface{}) (model.RegisterAppModule, error) {
+	var it model.RegisterAppModule
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"path", "exposedModule", "moduleName", "outlet"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "path":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Path = data
+		case "exposedModule":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exposedModule"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExposedModule = data
+		case "moduleName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("moduleName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ModuleName = data
+		case "outlet":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("outlet"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Outlet = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterAppNavigationInput(ctx context.Context, obj interface{}) (model.RegisterAppNavigationInput, error) {
+	var it model.RegisterAppNavigationInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"title", "subTitle", "authRequired", "hidden", "category", "children", "proxy", "icon", "module", "order", "translations", "keywords", "synonyms"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "subTitle":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subTitle"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SubTitle = data
+		case "authRequired":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AuthRequired = data
+		case "hidden":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hidden = data
+		case "category":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("category"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Category = data
+		case "children":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("children"))
+			data, err := ec.unmarshalORegisterChildAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterChildAppNavigationInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Children = data
+		case "proxy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proxy"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Proxy = data
+		case "icon":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Icon = data
+		case "module":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
+			data, err := ec.unmarshalNRegisterAppModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppModule(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Module = data
+		case "order":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Order = data
+		case "translations":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("translations"))
+			data, err := ec.unmarshalOTranslationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Translations = data
+		case "keywords":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keywords"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Keywords = data
+		case "synonyms":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("synonyms"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Synonyms = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterAppSlot(ctx context.Context, obj interface{}) (model.RegisterAppSlot, error) {
+	var it model.RegisterAppSlot
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"slot", "description", "authRequired", "module", "order", "translations"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "slot":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slot = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "authRequired":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AuthRequired = data
+		case "module":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
+			data, err := ec.unmarshalNRegisterAppSlotModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlotModule(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Module = data
+		case "order":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Order = data
+		case "translations":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("translations"))
+			data, err := ec.unmarshalOTranslationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Translations = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterAppSlotModule(ctx context.Context, obj interface{}) (model.RegisterAppSlotModule, error) {
+	var it model.RegisterAppSlotModule
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"path", "exposedModule", "moduleName"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "path":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Path = data
+		case "exposedModule":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exposedModule"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExposedModule = data
+		case "moduleName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("moduleName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ModuleName = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterChildAppNavigationInput(ctx context.Context, obj interface{}) (model.RegisterChildAppNavigationInput, error) {
+	var it model.RegisterChildAppNavigationInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"title", "subTitle", "authRequired", "path", "children", "icon", "hidden", "module", "proxy", "remoteEntryFile", "order", "translations", "keywords", "synonyms"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "subTitle":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subTitle"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SubTitle = data
+		case "authRequired":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AuthRequired = data
+		case "path":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Path = data
+		case "children":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("children"))
+			data, err := ec.unmarshalORegisterChildAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterChildAppNavigationInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Children = data
+		case "icon":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Icon = data
+		case "hidden":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hidden = data
+		case "module":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
+			data, err := ec.unmarshalNRegisterAppModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppModule(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Module = data
+		case "proxy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proxy"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Proxy = data
+		case "remoteEntryFile":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("remoteEntryFile"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RemoteEntryFile = data
+		case "order":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Order = data
+		case "translations":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("translations"))
+			data, err := ec.unmarshalOTranslationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Translations = data
+		case "keywords":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keywords"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Keywords = data
+		case "synonyms":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("synonyms"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Synonyms = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterNavigationCategoryInput(ctx context.Context, obj interface{}) (model.RegisterNavigationCategoryInput, error) {
+	var it model.RegisterNavigationCategoryInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"op", "value"}
+	fieldsInOrder := [...]string{"key", "title", "icon", "priority", "hidden"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "op":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
-			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+		case "key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Op = data
-		case "value":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalOAny2interface(ctx, v)
+			it.Key = data
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Value = data
+			it.Title = data
+		case "icon":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Icon = data
+		case "priority":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Priority = data
+		case "hidden":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hidden = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputQueryOperatorFieldAndValue(ctx context.Context, obj interface{}) (model.QueryOperatorFieldAndValue, error) {
-	var it model.QueryOperatorFieldAndValue
+func (ec *executionContext) unmarshalInputRegisterSharedDependencyInput(ctx context.Context, obj interface{}) (model.RegisterSharedDependencyInput, error) {
+	var it model.RegisterSharedDependencyInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"field", "op", "value", "exists"}
+	fieldsInOrder := [...]string{"name", "version", "requiredVersion", "singleton", "eager", "url"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "field":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Field = data
-		case "op":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
-			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+			it.Name = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Op = data
-		case "value":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalOAny2interface(ctx, v)
+			it.Version = data
+		case "requiredVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requiredVersion"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Value = data
-		case "exists":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exists"))
+			it.RequiredVersion = data
+		case "singleton":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("singleton"))
 			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Exists = data
+			it.Singleton = data
+		case "eager":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eager"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Eager = data
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URL = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputQueryValue(ctx context.Context, obj interface{}) (model.QueryValue, error) {
-	var it model.QueryValue
+func (ec *executionContext) unmarshalInputRegisteredAppQueryFields(ctx context.Context, obj interface{}) (model.RegisteredAppQueryFields, error) {
+	var it model.RegisteredAppQueryFields
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"value"}
+	fieldsInOrder := [...]string{"createdAt", "updatedAt", "name"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "value":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalOAny2interface(ctx, v)
+		case "createdAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
+			data, err := ec.unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Value = data
+			it.CreatedAt = data
+		case "updatedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("updatedAt"))
+			data, err := ec.unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UpdatedAt = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOQueryOperatorAndValue2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndValue(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterAppInput(ctx context.Context, obj interface{}) (model.RegisterAppInput, error) {
-	var it model.RegisterAppInput
+func (ec *executionContext) unmarshalInputRegisteredAppsSort(ctx context.Context, obj interface{}) (model.RegisteredAppsSort, error) {
+	var it model.RegisteredAppsSort
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "id", "package", "version", "remoteEntryFile", "proxy", "webUrl", "apiUrl", "navigation", "slot1", "slot2", "slot3"}
+	fieldsInOrder := [...]string{"createdAt", "updatedAt", "name"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
-		case "id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ID = data
-		case "package":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("package"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Package = data
-		case "version":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Version = data
-		case "remoteEntryFile":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("remoteEntryFile"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.RemoteEntryFile = data
-		case "proxy":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proxy"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Proxy = data
-		case "webUrl":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("webUrl"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+		case "createdAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.WebURL = data
-		case "apiUrl":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("apiUrl"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			it.CreatedAt = data
+		case "updatedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("updatedAt"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.APIURL = data
-		case "navigation":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("navigation"))
-			data, err := ec.unmarshalORegisterAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppNavigationInput(ctx, v)
+			it.UpdatedAt = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Navigation = data
-		case "slot1":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot1"))
-			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			it.Name = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisteredAppsWhereRules(ctx context.Context, obj interface{}) (model.RegisteredAppsWhereRules, error) {
+	var it model.RegisteredAppsWhereRules
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"condition", "fields", "rules"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "condition":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
+			data, err := ec.unmarshalNQueryCondition2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐQueryCondition(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Slot1 = data
-		case "slot2":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot2"))
-			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			it.Condition = data
+		case "fields":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fields"))
+			data, err := ec.unmarshalORegisteredAppQueryFields2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppQueryFields(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Slot2 = data
-		case "slot3":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot3"))
-			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			it.Fields = data
+		case "rules":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rules"))
+			data, err := ec.unmarshalORegisteredAppsWhereRules2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppsWhereRules(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Slot3 = data
+			it.Rules = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterAppModule(ctx context.Context, obj interface{}) (model.RegisterAppModule, error) {
-	var it model.RegisterAppModule
+func (ec *executionContext) unmarshalInputSetMaintenanceInput(ctx context.Context, obj interface{}) (model.SetMaintenanceInput, error) {
+	var it model.SetMaintenanceInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"path", "exposedModule", "moduleName", "outlet"}
+	fieldsInOrder := [...]string{"app", "message", "until", "whitelist"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "path":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+		case "app":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("app"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Path = data
-		case "exposedModule":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exposedModule"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			it.App = data
+		case "message":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ExposedModule = data
-		case "moduleName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("moduleName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			it.Message = data
+		case "until":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("until"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ModuleName = data
-		case "outlet":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("outlet"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			it.Until = data
+		case "whitelist":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("whitelist"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Outlet = data
+			it.Whitelist = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterAppNavigationInput(ctx context.Context, obj interface{}) (model.RegisterAppNavigationInput, error) {
-	var it model.RegisterAppNavigationInput
+func (ec *executionContext) unmarshalInputSetNavigationOverrideInput(ctx context.Context, obj interface{}) (model.SetNavigationOverrideInput, error) {
+	var it model.SetNavigationOverrideInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"title", "subTitle", "authRequired", "hidden", "category", "children", "proxy", "icon", "module"}
+	fieldsInOrder := [...]string{"id", "title", "subTitle", "icon", "category", "hidden", "order"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
 		case "title":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Title = data
 		case "subTitle":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subTitle"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.SubTitle = data
-		case "authRequired":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.AuthRequired = data
-		case "hidden":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+		case "icon":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Hidden = data
+			it.Icon = data
 		case "category":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("category"))
-			data, err := ec.unmarshalNRegisterAppCategory2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppCategory(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Category = data
-		case "children":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("children"))
-			data, err := ec.unmarshalORegisterChildAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterChildAppNavigationInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Children = data
-		case "proxy":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proxy"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Proxy = data
-		case "icon":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+		case "hidden":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Icon = data
-		case "module":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
-			data, err := ec.unmarshalNRegisterAppModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppModule(ctx, v)
+			it.Hidden = data
+		case "order":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Module = data
+			it.Order = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterAppSlot(ctx context.Context, obj interface{}) (model.RegisterAppSlot, error) {
-	var it model.RegisterAppSlot
+func (ec *executionContext) unmarshalInputSignOverrideInput(ctx context.Context, obj interface{}) (model.SignOverrideInput, error) {
+	var it model.SignOverrideInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"description", "authRequired", "module"}
+	fieldsInOrder := [...]string{"overrides"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "description":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Description = data
-		case "authRequired":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.AuthRequired = data
-		case "module":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
-			data, err := ec.unmarshalNRegisterAppSlotModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlotModule(ctx, v)
+		case "overrides":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("overrides"))
+			data, err := ec.unmarshalNOverrideInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐOverrideInputᚄ(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Module = data
+			it.Overrides = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterAppSlotModule(ctx context.Context, obj interface{}) (model.RegisterAppSlotModule, error) {
-	var it model.RegisterAppSlotModule
+func (ec *executionContext) unmarshalInputSort(ctx context.Context, obj interface{}) (genericdb.Sort, error) {
+	var it genericdb.Sort
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"path", "exposedModule", "moduleName"}
+	fieldsInOrder := [...]string{"key", "type"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "path":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Path = data
-		case "exposedModule":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exposedModule"))
+		case "key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ExposedModule = data
-		case "moduleName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("moduleName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			it.Key = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNSortType2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ModuleName = data
+			it.Type = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterChildAppNavigationInput(ctx context.Context, obj interface{}) (model.RegisterChildAppNavigationInput, error) {
-	var it model.RegisterChildAppNavigationInput
+func (ec *executionContext) unmarshalInputTranslationInput(ctx context.Context, obj interface{}) (model.TranslationInput, error) {
+	var it model.TranslationInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"title", "subTitle", "authRequired", "path", "children", "icon", "module"}
+	fieldsInOrder := [...]string{"locale", "title", "subTitle", "description"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
 		case "title":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Title = data
 		case "subTitle":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subTitle"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.SubTitle = data
-		case "authRequired":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.AuthRequired = data
-		case "path":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			it.Description = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var appRegistrationRevisionImplementors = []string{"AppRegistrationRevision"}
+
+func (ec *executionContext) _AppRegistrationRevision(ctx context.Context, sel ast.SelectionSet, obj *model.AppRegistrationRevision) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, appRegistrationRevisionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AppRegistrationRevision")
+		case "revision":
+			out.Values[i] = ec._AppRegistrationRevision_revision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Path = data
-		case "children":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("children"))
-			data, err := ec.unmarshalORegisterChildAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterChildAppNavigationInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "version":
+			out.Values[i] = ec._AppRegistrationRevision_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Children = data
-		case "icon":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "package":
+			out.Values[i] = ec._AppRegistrationRevision_package(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Icon = data
-		case "module":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
-			data, err := ec.unmarshalNRegisterAppModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppModule(ctx, v)
-			if err != nil {
-				return it, err
+		case "registeredAt":
+			out.Values[i] = ec._AppRegistrationRevision_registeredAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Module = data
+		case "rollbackOf":
+			out.Values[i] = ec._AppRegistrationRevision_rollbackOf(ctx, field, obj)
+		case "navigation":
+			out.Values[i] = ec._AppRegistrationRevision_navigation(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputRegisteredAppQueryFields(ctx context.Context, obj interface{}) (model.RegisteredAppQueryFields, error) {
-	var it model.RegisteredAppQueryFields
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"createdAt", "updatedAt", "name"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+	return out
+}
+
+var auditChangeImplementors = []string{"AuditChange"}
+
+func (ec *executionContext) _AuditChange(ctx context.Context, sel ast.SelectionSet, obj *model.AuditChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditChange")
+		case "field":
+			out.Values[i] = ec._AuditChange_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "before":
+			out.Values[i] = ec._AuditChange_before(ctx, field, obj)
+		case "after":
+			out.Values[i] = ec._AuditChange_after(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		switch k {
-		case "createdAt":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
-			data, err := ec.unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx, v)
-			if err != nil {
-				return it, err
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditEventImplementors = []string{"AuditEvent"}
+
+func (ec *executionContext) _AuditEvent(ctx context.Context, sel ast.SelectionSet, obj *model.AuditEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditEvent")
+		case "id":
+			out.Values[i] = ec._AuditEvent_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.CreatedAt = data
-		case "updatedAt":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("updatedAt"))
-			data, err := ec.unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx, v)
-			if err != nil {
-				return it, err
+		case "type":
+			out.Values[i] = ec._AuditEvent_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "app":
+			out.Values[i] = ec._AuditEvent_app(ctx, field, obj)
+		case "actor":
+			out.Values[i] = ec._AuditEvent_actor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.UpdatedAt = data
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalOQueryOperatorAndValue2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndValue(ctx, v)
-			if err != nil {
-				return it, err
+		case "changes":
+			out.Values[i] = ec._AuditEvent_changes(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._AuditEvent_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Name = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputRegisteredAppsSort(ctx context.Context, obj interface{}) (model.RegisteredAppsSort, error) {
-	var it model.RegisteredAppsSort
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"createdAt", "updatedAt", "name"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "createdAt":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
-			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CreatedAt = data
-		case "updatedAt":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("updatedAt"))
-			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.UpdatedAt = data
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var auditEventsPageImplementors = []string{"AuditEventsPage"}
+
+func (ec *executionContext) _AuditEventsPage(ctx context.Context, sel ast.SelectionSet, obj *model.AuditEventsPage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditEventsPageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditEventsPage")
+		case "data":
+			out.Values[i] = ec._AuditEventsPage_data(ctx, field, obj)
+		case "page":
+			out.Values[i] = ec._AuditEventsPage_page(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Name = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputRegisteredAppsWhereRules(ctx context.Context, obj interface{}) (model.RegisteredAppsWhereRules, error) {
-	var it model.RegisteredAppsWhereRules
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"condition", "fields", "rules"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "condition":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
-			data, err := ec.unmarshalNQueryCondition2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐQueryCondition(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Condition = data
-		case "fields":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fields"))
-			data, err := ec.unmarshalORegisteredAppQueryFields2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppQueryFields(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var keepAliveAppOutputImplementors = []string{"KeepAliveAppOutput"}
+
+func (ec *executionContext) _KeepAliveAppOutput(ctx context.Context, sel ast.SelectionSet, obj *model.KeepAliveAppOutput) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, keepAliveAppOutputImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("KeepAliveAppOutput")
+		case "registrationRequired":
+			out.Values[i] = ec._KeepAliveAppOutput_registrationRequired(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Fields = data
-		case "rules":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rules"))
-			data, err := ec.unmarshalORegisteredAppsWhereRules2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppsWhereRules(ctx, v)
-			if err != nil {
-				return it, err
+		case "ok":
+			out.Values[i] = ec._KeepAliveAppOutput_ok(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Rules = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputSort(ctx context.Context, obj interface{}) (genericdb.Sort, error) {
-	var it genericdb.Sort
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"key", "type"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "key":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Key = data
-		case "type":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
-			data, err := ec.unmarshalNSortType2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var maintenanceWindowImplementors = []string{"MaintenanceWindow"}
+
+func (ec *executionContext) _MaintenanceWindow(ctx context.Context, sel ast.SelectionSet, obj *model.MaintenanceWindow) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, maintenanceWindowImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MaintenanceWindow")
+		case "app":
+			out.Values[i] = ec._MaintenanceWindow_app(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._MaintenanceWindow_message(ctx, field, obj)
+		case "until":
+			out.Values[i] = ec._MaintenanceWindow_until(ctx, field, obj)
+		case "startedAt":
+			out.Values[i] = ec._MaintenanceWindow_startedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Type = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-// endregion **************************** input.gotpl *****************************
+var navigationOverrideImplementors = []string{"NavigationOverride"}
 
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) _NavigationOverride(ctx context.Context, sel ast.SelectionSet, obj *model.NavigationOverride) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, navigationOverrideImplementors)
 
-// endregion ************************** interface.gotpl ***************************
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NavigationOverride")
+		case "id":
+			out.Values[i] = ec._NavigationOverride_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "title":
+			out.Values[i] = ec._NavigationOverride_title(ctx, field, obj)
+		case "subTitle":
+			out.Values[i] = ec._NavigationOverride_subTitle(ctx, field, obj)
+		case "icon":
+			out.Values[i] = ec._NavigationOverride_icon(ctx, field, obj)
+		case "category":
+			out.Values[i] = ec._NavigationOverride_category(ctx, field, obj)
+		case "hidden":
+			out.Values[i] = ec._NavigationOverride_hidden(ctx, field, obj)
+		case "order":
+			out.Values[i] = ec._NavigationOverride_order(ctx, field, obj)
+		case "updatedAt":
+			out.Values[i] = ec._NavigationOverride_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-// region    **************************** object.gotpl ****************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
 
-var keepAliveAppOutputImplementors = []string{"KeepAliveAppOutput"}
+var navigationSearchResultImplementors = []string{"NavigationSearchResult"}
 
-func (ec *executionContext) _KeepAliveAppOutput(ctx context.Context, sel ast.SelectionSet, obj *model.KeepAliveAppOutput) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, keepAliveAppOutputImplementors)
+func (ec *executionContext) _NavigationSearchResult(ctx context.Context, sel ast.SelectionSet, obj *model.NavigationSearchResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, navigationSearchResultImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("KeepAliveAppOutput")
-		case "registrationRequired":
-			out.Values[i] = ec._KeepAliveAppOutput_registrationRequired(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("NavigationSearchResult")
+		case "id":
+			out.Values[i] = ec._NavigationSearchResult_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "ok":
-			out.Values[i] = ec._KeepAliveAppOutput_ok(ctx, field, obj)
+		case "app":
+			out.Values[i] = ec._NavigationSearchResult_app(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "title":
+			out.Values[i] = ec._NavigationSearchResult_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subTitle":
+			out.Values[i] = ec._NavigationSearchResult_subTitle(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "icon":
+			out.Values[i] = ec._NavigationSearchResult_icon(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "path":
+			out.Values[i] = ec._NavigationSearchResult_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "category":
+			out.Values[i] = ec._NavigationSearchResult_category(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "breadcrumbs":
+			out.Values[i] = ec._NavigationSearchResult_breadcrumbs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "state":
+			out.Values[i] = ec._NavigationSearchResult_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "score":
+			out.Values[i] = ec._NavigationSearchResult_score(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -6566,6 +11445,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
+var recentAppImplementors = []string{"RecentApp"}
+
+func (ec *executionContext) _RecentApp(ctx context.Context, sel ast.SelectionSet, obj *model.RecentApp) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, recentAppImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RecentApp")
+		case "app":
+			out.Values[i] = ec._RecentApp_app(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "visitedAt":
+			out.Values[i] = ec._RecentApp_visitedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var registerAppOutputImplementors = []string{"RegisterAppOutput"}
 
 func (ec *executionContext) _RegisterAppOutput(ctx context.Context, sel ast.SelectionSet, obj *model.RegisterAppOutput) graphql.Marshaler {
@@ -6702,12 +11625,75 @@ func (ec *executionContext) _ShellConfiguration(ctx context.Context, sel ast.Sel
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("ShellConfiguration")
+		case "version":
+			out.Values[i] = ec._ShellConfiguration_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hash":
+			out.Values[i] = ec._ShellConfiguration_hash(ctx, field, obj)
+		case "baseVersion":
+			out.Values[i] = ec._ShellConfiguration_baseVersion(ctx, field, obj)
+		case "patch":
+			out.Values[i] = ec._ShellConfiguration_patch(ctx, field, obj)
 		case "defaultRoute":
 			out.Values[i] = ec._ShellConfiguration_defaultRoute(ctx, field, obj)
+		case "locale":
+			out.Values[i] = ec._ShellConfiguration_locale(ctx, field, obj)
+		case "locales":
+			out.Values[i] = ec._ShellConfiguration_locales(ctx, field, obj)
 		case "categories":
 			out.Values[i] = ec._ShellConfiguration_categories(ctx, field, obj)
 		case "slots":
 			out.Values[i] = ec._ShellConfiguration_slots(ctx, field, obj)
+		case "maintenance":
+			out.Values[i] = ec._ShellConfiguration_maintenance(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var shellConfigurationPatchOperationImplementors = []string{"ShellConfigurationPatchOperation"}
+
+func (ec *executionContext) _ShellConfigurationPatchOperation(ctx context.Context, sel ast.SelectionSet, obj *model.ShellConfigurationPatchOperation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shellConfigurationPatchOperationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ShellConfigurationPatchOperation")
+		case "op":
+			out.Values[i] = ec._ShellConfigurationPatchOperation_op(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "path":
+			out.Values[i] = ec._ShellConfigurationPatchOperation_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "value":
+			out.Values[i] = ec._ShellConfigurationPatchOperation_value(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6813,6 +11799,13 @@ func (ec *executionContext) _ShellNavigation(ctx context.Context, sel ast.Select
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "state":
+			out.Values[i] = ec._ShellNavigation_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maintenance":
+			out.Values[i] = ec._ShellNavigation_maintenance(ctx, field, obj)
 		case "module":
 			out.Values[i] = ec._ShellNavigation_module(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -6877,6 +11870,13 @@ func (ec *executionContext) _ShellNavigationCategory(ctx context.Context, sel as
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "icon":
+			out.Values[i] = ec._ShellNavigationCategory_icon(ctx, field, obj)
+		case "hidden":
+			out.Values[i] = ec._ShellNavigationCategory_hidden(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "entries":
 			out.Values[i] = ec._ShellNavigationCategory_entries(ctx, field, obj)
 		default:
@@ -6940,11 +11940,21 @@ func (ec *executionContext) _ShellNavigationChild(ctx context.Context, sel ast.S
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "state":
+			out.Values[i] = ec._ShellNavigationChild_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "icon":
 			out.Values[i] = ec._ShellNavigationChild_icon(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "hidden":
+			out.Values[i] = ec._ShellNavigationChild_hidden(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7004,6 +12014,11 @@ func (ec *executionContext) _ShellNavigationModule(ctx context.Context, sel ast.
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "format":
+			out.Values[i] = ec._ShellNavigationModule_format(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7045,6 +12060,8 @@ func (ec *executionContext) _ShellNavigationSlot(ctx context.Context, sel ast.Se
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "app":
+			out.Values[i] = ec._ShellNavigationSlot_app(ctx, field, obj)
 		case "description":
 			out.Values[i] = ec._ShellNavigationSlot_description(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -7111,6 +12128,60 @@ func (ec *executionContext) _ShellNavigationSlotModule(ctx context.Context, sel
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "format":
+			out.Values[i] = ec._ShellNavigationSlotModule_format(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var signOverrideOutputImplementors = []string{"SignOverrideOutput"}
+
+func (ec *executionContext) _SignOverrideOutput(ctx context.Context, sel ast.SelectionSet, obj *model.SignOverrideOutput) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, signOverrideOutputImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SignOverrideOutput")
+		case "value":
+			out.Values[i] = ec._SignOverrideOutput_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "signature":
+			out.Values[i] = ec._SignOverrideOutput_signature(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._SignOverrideOutput_expiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7214,6 +12285,57 @@ func (ec *executionContext) _TagValues(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
+var userPreferencesImplementors = []string{"UserPreferences"}
+
+func (ec *executionContext) _UserPreferences(ctx context.Context, sel ast.SelectionSet, obj *model.UserPreferences) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userPreferencesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UserPreferences")
+		case "defaultRoute":
+			out.Values[i] = ec._UserPreferences_defaultRoute(ctx, field, obj)
+		case "pinned":
+			out.Values[i] = ec._UserPreferences_pinned(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hidden":
+			out.Values[i] = ec._UserPreferences_hidden(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recents":
+			out.Values[i] = ec._UserPreferences_recents(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var __DirectiveImplementors = []string{"__Directive"}
 
 func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
@@ -7561,6 +12683,16 @@ func (ec *executionContext) marshalNAny2interface(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNAuditEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventType(ctx context.Context, v interface{}) (model.AuditEventType, error) {
+	var res model.AuditEventType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAuditEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventType(ctx context.Context, sel ast.SelectionSet, v model.AuditEventType) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -7576,6 +12708,21 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
 func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
 	res, err := graphql.UnmarshalInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -7603,7 +12750,39 @@ func (ec *executionContext) marshalNInt2int64(ctx context.Context, sel ast.Selec
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	return res
+	return res
+}
+
+func (ec *executionContext) unmarshalNModuleFormat2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx context.Context, v interface{}) (model.ModuleFormat, error) {
+	var res model.ModuleFormat
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNModuleFormat2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx context.Context, sel ast.SelectionSet, v model.ModuleFormat) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNOverrideInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐOverrideInputᚄ(ctx context.Context, v interface{}) ([]*model.OverrideInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.OverrideInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNOverrideInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐOverrideInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNOverrideInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐOverrideInput(ctx context.Context, v interface{}) (*model.OverrideInput, error) {
+	res, err := ec.unmarshalInputOverrideInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *genericdb.PageInfo) graphql.Marshaler {
@@ -7652,23 +12831,67 @@ func (ec *executionContext) marshalNQueryType2githubᚗcomᚋazarcᚑioᚋverath
 	return v
 }
 
-func (ec *executionContext) unmarshalNRefRoot2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRefRoot(ctx context.Context, v interface{}) (model.RefRoot, error) {
-	var res model.RefRoot
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNRecentApp2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRecentAppᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RecentApp) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRecentApp2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRecentApp(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNRefRoot2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRefRoot(ctx context.Context, sel ast.SelectionSet, v model.RefRoot) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNRecentApp2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRecentApp(ctx context.Context, sel ast.SelectionSet, v *model.RecentApp) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RecentApp(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNRegisterAppCategory2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppCategory(ctx context.Context, v interface{}) (model.RegisterAppCategory, error) {
-	var res model.RegisterAppCategory
+func (ec *executionContext) unmarshalNRefRoot2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRefRoot(ctx context.Context, v interface{}) (model.RefRoot, error) {
+	var res model.RefRoot
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNRegisterAppCategory2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppCategory(ctx context.Context, sel ast.SelectionSet, v model.RegisterAppCategory) graphql.Marshaler {
+func (ec *executionContext) marshalNRefRoot2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRefRoot(ctx context.Context, sel ast.SelectionSet, v model.RefRoot) graphql.Marshaler {
 	return v
 }
 
@@ -7677,11 +12900,26 @@ func (ec *executionContext) unmarshalNRegisterAppModule2ᚖgithubᚗcomᚋazarc
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNRegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx context.Context, v interface{}) (*model.RegisterAppSlot, error) {
+	res, err := ec.unmarshalInputRegisterAppSlot(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNRegisterAppSlotModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlotModule(ctx context.Context, v interface{}) (*model.RegisterAppSlotModule, error) {
 	res, err := ec.unmarshalInputRegisterAppSlotModule(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNRegisterNavigationCategoryInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterNavigationCategoryInput(ctx context.Context, v interface{}) (*model.RegisterNavigationCategoryInput, error) {
+	res, err := ec.unmarshalInputRegisterNavigationCategoryInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRegisterSharedDependencyInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterSharedDependencyInput(ctx context.Context, v interface{}) (*model.RegisterSharedDependencyInput, error) {
+	res, err := ec.unmarshalInputRegisterSharedDependencyInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNShellConfigEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigEventType(ctx context.Context, v interface{}) (model.ShellConfigEventType, error) {
 	var res model.ShellConfigEventType
 	err := res.UnmarshalGQL(v)
@@ -7702,6 +12940,16 @@ func (ec *executionContext) marshalNShellConfiguration2ᚖgithubᚗcomᚋazarc
 	return ec._ShellConfiguration(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNShellConfigurationPatchOperation2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationPatchOperation(ctx context.Context, sel ast.SelectionSet, v *model.ShellConfigurationPatchOperation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ShellConfigurationPatchOperation(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNShellNavigationModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationModule(ctx context.Context, sel ast.SelectionSet, v *model.ShellNavigationModule) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -7722,6 +12970,16 @@ func (ec *executionContext) marshalNShellNavigationSlotModule2ᚖgithubᚗcomᚋ
 	return ec._ShellNavigationSlotModule(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNShellNavigationState2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationState(ctx context.Context, v interface{}) (model.ShellNavigationState, error) {
+	var res model.ShellNavigationState
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNShellNavigationState2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationState(ctx context.Context, sel ast.SelectionSet, v model.ShellNavigationState) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNSortType2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx context.Context, v interface{}) (genericdb.SortType, error) {
 	tmp, err := graphql.UnmarshalString(v)
 	res := genericdb.SortType(tmp)
@@ -7753,6 +13011,58 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	res := graphql.MarshalTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNTranslationInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInput(ctx context.Context, v interface{}) (*model.TranslationInput, error) {
+	res, err := ec.unmarshalInputTranslationInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -7885,11 +13195,147 @@ func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋ
 	return ec.___Field(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v interface{}) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalAny(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v any) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalAny(v)
+	return res
 }
 
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) marshalOAuditChange2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditChange(ctx context.Context, sel ast.SelectionSet, v []*model.AuditChange) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7913,7 +13359,7 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+			ret[i] = ec.marshalOAuditChange2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditChange(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7924,20 +13370,20 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
-
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
+func (ec *executionContext) marshalOAuditChange2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditChange(ctx context.Context, sel ast.SelectionSet, v *model.AuditChange) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AuditChange(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOAuditEvent2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEvent(ctx context.Context, sel ast.SelectionSet, v []*model.AuditEvent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7961,7 +13407,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalOAuditEvent2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEvent(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7972,54 +13418,70 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
-
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOAuditEvent2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEvent(ctx context.Context, sel ast.SelectionSet, v *model.AuditEvent) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
 		return graphql.Null
 	}
-	return ec.___Type(ctx, sel, v)
+	return ec._AuditEvent(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalOAuditEventQueryFields2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventQueryFields(ctx context.Context, v interface{}) ([]*model.AuditEventQueryFields, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.AuditEventQueryFields, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalOAuditEventQueryFields2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventQueryFields(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) unmarshalOAuditEventQueryFields2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventQueryFields(ctx context.Context, v interface{}) (*model.AuditEventQueryFields, error) {
+	if v == nil {
+		return nil, nil
 	}
-	return res
+	res, err := ec.unmarshalInputAuditEventQueryFields(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v interface{}) (any, error) {
+func (ec *executionContext) unmarshalOAuditEventsWhereRules2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventsWhereRules(ctx context.Context, v interface{}) ([]*model.AuditEventsWhereRules, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalAny(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.AuditEventsWhereRules, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalOAuditEventsWhereRules2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventsWhereRules(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v any) graphql.Marshaler {
+func (ec *executionContext) unmarshalOAuditEventsWhereRules2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventsWhereRules(ctx context.Context, v interface{}) (*model.AuditEventsWhereRules, error) {
 	if v == nil {
-		return graphql.Null
+		return nil, nil
 	}
-	res := graphql.MarshalAny(v)
-	return res
+	res, err := ec.unmarshalInputAuditEventsWhereRules(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
@@ -8074,6 +13536,29 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return res
 }
 
+func (ec *executionContext) marshalOMaintenanceWindow2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐMaintenanceWindow(ctx context.Context, sel ast.SelectionSet, v *model.MaintenanceWindow) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MaintenanceWindow(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOModuleFormat2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx context.Context, v interface{}) (*model.ModuleFormat, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ModuleFormat)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOModuleFormat2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx context.Context, sel ast.SelectionSet, v *model.ModuleFormat) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx context.Context, v interface{}) (*model.QueryOperatorAndDate, error) {
 	if v == nil {
 		return nil, nil
@@ -8118,6 +13603,26 @@ func (ec *executionContext) unmarshalORegisterAppNavigationInput2ᚖgithubᚗcom
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalORegisterAppSlot2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlotᚄ(ctx context.Context, v interface{}) ([]*model.RegisterAppSlot, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.RegisterAppSlot, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx context.Context, v interface{}) (*model.RegisterAppSlot, error) {
 	if v == nil {
 		return nil, nil
@@ -8154,6 +13659,46 @@ func (ec *executionContext) unmarshalORegisterChildAppNavigationInput2ᚖgithub
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalORegisterNavigationCategoryInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterNavigationCategoryInputᚄ(ctx context.Context, v interface{}) ([]*model.RegisterNavigationCategoryInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.RegisterNavigationCategoryInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRegisterNavigationCategoryInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterNavigationCategoryInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalORegisterSharedDependencyInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterSharedDependencyInputᚄ(ctx context.Context, v interface{}) ([]*model.RegisterSharedDependencyInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.RegisterSharedDependencyInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRegisterSharedDependencyInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterSharedDependencyInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalORegisteredApp2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredApp(ctx context.Context, sel ast.SelectionSet, v []*model.RegisteredApp) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -8258,6 +13803,53 @@ func (ec *executionContext) unmarshalORegisteredAppsWhereRules2ᚖgithubᚗcom
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOShellConfigurationPatchOperation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationPatchOperationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ShellConfigurationPatchOperation) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNShellConfigurationPatchOperation2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationPatchOperation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOShellNavigation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigation(ctx context.Context, sel ast.SelectionSet, v []*model.ShellNavigation) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -8467,6 +14059,44 @@ func (ec *executionContext) marshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋvera
 	return res
 }
 
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚕᚖstring(ctx context.Context, v interface{}) ([]*string, error) {
 	if v == nil {
 		return nil, nil
@@ -8579,6 +14209,26 @@ func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel
 	return res
 }
 
+func (ec *executionContext) unmarshalOTranslationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInputᚄ(ctx context.Context, v interface{}) ([]*model.TranslationInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.TranslationInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNTranslationInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -11,6 +11,59 @@ import (
 	"github.com/azarc-io/verathread-next-common/common/genericdb"
 )
 
+type AppRegistrationRevision struct {
+	Revision     int                `json:"revision" bson:"-"`
+	Version      string             `json:"version" bson:"-"`
+	Package      string             `json:"package" bson:"-"`
+	RegisteredAt time.Time          `json:"registeredAt" bson:"-"`
+	RollbackOf   *int               `json:"rollbackOf,omitempty" bson:"-"`
+	Navigation   []*ShellNavigation `json:"navigation,omitempty" bson:"-"`
+}
+
+type AuditChange struct {
+	Field  string  `json:"field" bson:"field" yaml:"field"`
+	Before *string `json:"before,omitempty" bson:"before" yaml:"before"`
+	After  *string `json:"after,omitempty" bson:"after" yaml:"after"`
+}
+
+type AuditEvent struct {
+	ID        string         `json:"id" bson:"_id" yaml:"_id"`
+	Type      AuditEventType `json:"type" bson:"type" yaml:"type"`
+	App       *string        `json:"app,omitempty" bson:"app" yaml:"app"`
+	Actor     string         `json:"actor" bson:"actor" yaml:"actor"`
+	Changes   []*AuditChange `json:"changes,omitempty" bson:"changes" yaml:"changes"`
+	CreatedAt time.Time      `json:"createdAt" bson:"created_at" yaml:"created_at"`
+}
+
+type AuditEventQueryFields struct {
+	CreatedAt *QueryOperatorAndDate  `json:"createdAt,omitempty" bson:"created_at" yaml:"created_at" queryType:"Date"`
+	Type      *QueryOperatorAndValue `json:"type,omitempty" bson:"type" yaml:"type"`
+	App       *QueryOperatorAndValue `json:"app,omitempty" bson:"app" yaml:"app"`
+	Actor     *QueryOperatorAndValue `json:"actor,omitempty" bson:"actor" yaml:"actor"`
+}
+
+type AuditEventsPage struct {
+	Data []*AuditEvent       `json:"data,omitempty" bson:"-"`
+	Page *genericdb.PageInfo `json:"page" bson:"-"`
+}
+
+type AuditEventsSort struct {
+	CreatedAt *genericdb.SortType `json:"createdAt,omitempty" bson:"created_at" yaml:"created_at" queryType:"Date"`
+	Type      *genericdb.SortType `json:"type,omitempty" bson:"type" yaml:"type"`
+	App       *genericdb.SortType `json:"app,omitempty" bson:"app" yaml:"app"`
+	Actor     *genericdb.SortType `json:"actor,omitempty" bson:"actor" yaml:"actor"`
+}
+
+type AuditEventsWhereRules struct {
+	Condition genericdb.QueryCondition `json:"condition" bson:"-" query:"condition"`
+	Fields    []*AuditEventQueryFields `json:"fields,omitempty" bson:"-" query:"op"`
+	Rules     []*AuditEventsWhereRules `json:"rules,omitempty" bson:"-" query:"queryRules"`
+}
+
+type ClearMaintenanceInput struct {
+	App *string `json:"app,omitempty" bson:"-"`
+}
+
 type KeepAliveAppInput struct {
 	Pkg     string `json:"pkg" bson:"-"`
 	Version string `json:"version" bson:"-"`
@@ -22,6 +75,42 @@ type KeepAliveAppOutput struct {
 	Ok                   bool `json:"ok" bson:"-"`
 }
 
+type MaintenanceWindow struct {
+	App       *string    `json:"app,omitempty" bson:"-"`
+	Message   *string    `json:"message,omitempty" bson:"-"`
+	Until     *time.Time `json:"until,omitempty" bson:"-"`
+	StartedAt time.Time  `json:"startedAt" bson:"-"`
+}
+
+type NavigationOverride struct {
+	ID        string    `json:"id" bson:"-"`
+	Title     *string   `json:"title,omitempty" bson:"-"`
+	SubTitle  *string   `json:"subTitle,omitempty" bson:"-"`
+	Icon      *string   `json:"icon,omitempty" bson:"-"`
+	Category  *string   `json:"category,omitempty" bson:"-"`
+	Hidden    *bool     `json:"hidden,omitempty" bson:"-"`
+	Order     *int      `json:"order,omitempty" bson:"-"`
+	UpdatedAt time.Time `json:"updatedAt" bson:"-"`
+}
+
+type NavigationSearchResult struct {
+	ID          string               `json:"id" bson:"-"`
+	App         string               `json:"app" bson:"-"`
+	Title       string               `json:"title" bson:"-"`
+	SubTitle    string               `json:"subTitle" bson:"-"`
+	Icon        string               `json:"icon" bson:"-"`
+	Path        string               `json:"path" bson:"-"`
+	Category    string               `json:"category" bson:"-"`
+	Breadcrumbs []string             `json:"breadcrumbs" bson:"-"`
+	State       ShellNavigationState `json:"state" bson:"-"`
+	Score       float64              `json:"score" bson:"-"`
+}
+
+type OverrideInput struct {
+	App string `json:"app" bson:"-"`
+	URL string `json:"url" bson:"-"`
+}
+
 type Query struct {
 }
 
@@ -46,19 +135,28 @@ type QueryValue struct {
 	Value any `json:"value,omitempty" bson:"-" query:"value"`
 }
 
+type RecentApp struct {
+	App       string    `json:"app" bson:"-"`
+	VisitedAt time.Time `json:"visitedAt" bson:"-"`
+}
+
 type RegisterAppInput struct {
-	Name            string                        `json:"name" bson:"-"`
-	ID              string                        `json:"id" bson:"-"`
-	Package         string                        `json:"package" bson:"-"`
-	Version         string                        `json:"version" bson:"-"`
-	RemoteEntryFile string                        `json:"remoteEntryFile" bson:"-"`
-	Proxy           bool                          `json:"proxy" bson:"-"`
-	WebURL          string                        `json:"webUrl" bson:"-"`
-	APIURL          string                        `json:"apiUrl" bson:"-"`
-	Navigation      []*RegisterAppNavigationInput `json:"navigation,omitempty" bson:"-"`
-	Slot1           *RegisterAppSlot              `json:"slot1,omitempty" bson:"-"`
-	Slot2           *RegisterAppSlot              `json:"slot2,omitempty" bson:"-"`
-	Slot3           *RegisterAppSlot              `json:"slot3,omitempty" bson:"-"`
+	Name            string                             `json:"name" bson:"-"`
+	ID              string                             `json:"id" bson:"-"`
+	Package         string                             `json:"package" bson:"-"`
+	Version         string                             `json:"version" bson:"-"`
+	RemoteEntryFile string                             `json:"remoteEntryFile" bson:"-"`
+	Proxy           bool                               `json:"proxy" bson:"-"`
+	WebURL          string                             `json:"webUrl" bson:"-"`
+	APIURL          string                             `json:"apiUrl" bson:"-"`
+	Navigation      []*RegisterAppNavigationInput      `json:"navigation,omitempty" bson:"-"`
+	Categories      []*RegisterNavigationCategoryInput `json:"categories,omitempty" bson:"-"`
+	Slots           []*RegisterAppSlot                 `json:"slots,omitempty" bson:"-"`
+	Slot1           *RegisterAppSlot                   `json:"slot1,omitempty" bson:"-"`
+	Slot2           *RegisterAppSlot                   `json:"slot2,omitempty" bson:"-"`
+	Slot3           *RegisterAppSlot                   `json:"slot3,omitempty" bson:"-"`
+	Format          *ModuleFormat                      `json:"format,omitempty" bson:"-"`
+	Shared          []*RegisterSharedDependencyInput   `json:"shared,omitempty" bson:"-"`
 }
 
 type RegisterAppModule struct {
@@ -73,11 +171,15 @@ type RegisterAppNavigationInput struct {
 	SubTitle     string                             `json:"subTitle" bson:"-"`
 	AuthRequired bool                               `json:"authRequired" bson:"-"`
 	Hidden       bool                               `json:"hidden" bson:"-"`
-	Category     RegisterAppCategory                `json:"category" bson:"-"`
+	Category     string                             `json:"category" bson:"-"`
 	Children     []*RegisterChildAppNavigationInput `json:"children,omitempty" bson:"-"`
 	Proxy        bool                               `json:"proxy" bson:"-"`
 	Icon         string                             `json:"icon" bson:"-"`
 	Module       *RegisterAppModule                 `json:"module" bson:"-"`
+	Order        *int                               `json:"order,omitempty" bson:"-"`
+	Translations []*TranslationInput                `json:"translations,omitempty" bson:"-"`
+	Keywords     []string                           `json:"keywords,omitempty" bson:"-"`
+	Synonyms     []string                           `json:"synonyms,omitempty" bson:"-"`
 }
 
 type RegisterAppOutput struct {
@@ -85,9 +187,12 @@ type RegisterAppOutput struct {
 }
 
 type RegisterAppSlot struct {
+	Slot         *string                `json:"slot,omitempty" bson:"-"`
 	Description  string                 `json:"description" bson:"-"`
 	AuthRequired bool                   `json:"authRequired" bson:"-"`
 	Module       *RegisterAppSlotModule `json:"module" bson:"-"`
+	Order        *int                   `json:"order,omitempty" bson:"-"`
+	Translations []*TranslationInput    `json:"translations,omitempty" bson:"-"`
 }
 
 type RegisterAppSlotModule struct {
@@ -97,13 +202,37 @@ type RegisterAppSlotModule struct {
 }
 
 type RegisterChildAppNavigationInput struct {
-	Title        string                             `json:"title" bson:"-"`
-	SubTitle     string                             `json:"subTitle" bson:"-"`
-	AuthRequired bool                               `json:"authRequired" bson:"-"`
-	Path         string                             `json:"path" bson:"-"`
-	Children     []*RegisterChildAppNavigationInput `json:"children,omitempty" bson:"-"`
-	Icon         string                             `json:"icon" bson:"-"`
-	Module       *RegisterAppModule                 `json:"module" bson:"-"`
+	Title           string                             `json:"title" bson:"-"`
+	SubTitle        string                             `json:"subTitle" bson:"-"`
+	AuthRequired    bool                               `json:"authRequired" bson:"-"`
+	Path            string                             `json:"path" bson:"-"`
+	Children        []*RegisterChildAppNavigationInput `json:"children,omitempty" bson:"-"`
+	Icon            string                             `json:"icon" bson:"-"`
+	Hidden          *bool                              `json:"hidden,omitempty" bson:"-"`
+	Module          *RegisterAppModule                 `json:"module" bson:"-"`
+	Proxy           *bool                              `json:"proxy,omitempty" bson:"-"`
+	RemoteEntryFile *string                            `json:"remoteEntryFile,omitempty" bson:"-"`
+	Order           *int                               `json:"order,omitempty" bson:"-"`
+	Translations    []*TranslationInput                `json:"translations,omitempty" bson:"-"`
+	Keywords        []string                           `json:"keywords,omitempty" bson:"-"`
+	Synonyms        []string                           `json:"synonyms,omitempty" bson:"-"`
+}
+
+type RegisterNavigationCategoryInput struct {
+	Key      string  `json:"key" bson:"-"`
+	Title    string  `json:"title" bson:"-"`
+	Icon     *string `json:"icon,omitempty" bson:"-"`
+	Priority *int    `json:"priority,omitempty" bson:"-"`
+	Hidden   *bool   `json:"hidden,omitempty" bson:"-"`
+}
+
+type RegisterSharedDependencyInput struct {
+	Name            string  `json:"name" bson:"-"`
+	Version         string  `json:"version" bson:"-"`
+	RequiredVersion *string `json:"requiredVersion,omitempty" bson:"-"`
+	Singleton       *bool   `json:"singleton,omitempty" bson:"-"`
+	Eager           *bool   `json:"eager,omitempty" bson:"-"`
+	URL             *string `json:"url,omitempty" bson:"-"`
 }
 
 type RegisteredApp struct {
@@ -136,10 +265,40 @@ type RegisteredAppsWhereRules struct {
 	Rules     []*RegisteredAppsWhereRules `json:"rules,omitempty" bson:"-" query:"queryRules"`
 }
 
+type SetMaintenanceInput struct {
+	App       *string    `json:"app,omitempty" bson:"-"`
+	Message   *string    `json:"message,omitempty" bson:"-"`
+	Until     *time.Time `json:"until,omitempty" bson:"-"`
+	Whitelist []string   `json:"whitelist,omitempty" bson:"-"`
+}
+
+type SetNavigationOverrideInput struct {
+	ID       string  `json:"id" bson:"-"`
+	Title    *string `json:"title,omitempty" bson:"-"`
+	SubTitle *string `json:"subTitle,omitempty" bson:"-"`
+	Icon     *string `json:"icon,omitempty" bson:"-"`
+	Category *string `json:"category,omitempty" bson:"-"`
+	Hidden   *bool   `json:"hidden,omitempty" bson:"-"`
+	Order    *int    `json:"order,omitempty" bson:"-"`
+}
+
 type ShellConfiguration struct {
-	DefaultRoute *string                    `json:"defaultRoute,omitempty" bson:"-"`
-	Categories   []*ShellNavigationCategory `json:"categories,omitempty" bson:"-"`
-	Slots        []*ShellNavigationSlot     `json:"slots,omitempty" bson:"-"`
+	Version      int                                 `json:"version" bson:"-"`
+	Hash         *string                             `json:"hash,omitempty" bson:"-"`
+	BaseVersion  *int                                `json:"baseVersion,omitempty" bson:"-"`
+	Patch        []*ShellConfigurationPatchOperation `json:"patch,omitempty" bson:"-"`
+	DefaultRoute *string                             `json:"defaultRoute,omitempty" bson:"-"`
+	Locale       *string                             `json:"locale,omitempty" bson:"-"`
+	Locales      []string                            `json:"locales,omitempty" bson:"-"`
+	Categories   []*ShellNavigationCategory          `json:"categories,omitempty" bson:"-"`
+	Slots        []*ShellNavigationSlot              `json:"slots,omitempty" bson:"-"`
+	Maintenance  *MaintenanceWindow                  `json:"maintenance,omitempty" bson:"-"`
+}
+
+type ShellConfigurationPatchOperation struct {
+	Op    string `json:"op" bson:"-"`
+	Path  string `json:"path" bson:"-"`
+	Value any    `json:"value,omitempty" bson:"-"`
 }
 
 type ShellConfigurationSubscription struct {
@@ -154,16 +313,20 @@ type ShellNavigation struct {
 	AuthRequired bool                    `json:"authRequired" bson:"authRequired" yaml:"authRequired"`
 	Children     []*ShellNavigationChild `json:"children,omitempty" bson:"children" yaml:"children"`
 	Healthy      bool                    `json:"healthy" bson:"available" yaml:"available"`
+	State        ShellNavigationState    `json:"state" bson:"state" yaml:"state"`
+	Maintenance  *MaintenanceWindow      `json:"maintenance,omitempty" bson:"maintenance" yaml:"maintenance"`
 	Module       *ShellNavigationModule  `json:"module" bson:"module" yaml:"module"`
 	Icon         string                  `json:"icon" bson:"icon" yaml:"icon"`
 	Hidden       bool                    `json:"hidden" bson:"hidden" yaml:"hidden"`
 }
 
 type ShellNavigationCategory struct {
-	Title    string              `json:"title" bson:"-"`
-	Priority int                 `json:"priority" bson:"-"`
-	Category RegisterAppCategory `json:"category" bson:"-"`
-	Entries  []*ShellNavigation  `json:"entries,omitempty" bson:"-"`
+	Title    string             `json:"title" bson:"-"`
+	Priority int                `json:"priority" bson:"-"`
+	Category string             `json:"category" bson:"-"`
+	Icon     *string            `json:"icon,omitempty" bson:"-"`
+	Hidden   bool               `json:"hidden" bson:"-"`
+	Entries  []*ShellNavigation `json:"entries,omitempty" bson:"-"`
 }
 
 type ShellNavigationChild struct {
@@ -173,30 +336,45 @@ type ShellNavigationChild struct {
 	AuthRequired bool                    `json:"authRequired" bson:"authRequired" yaml:"authRequired"`
 	Children     []*ShellNavigationChild `json:"children,omitempty" bson:"children" yaml:"children"`
 	Healthy      bool                    `json:"healthy" bson:"available" yaml:"available"`
+	State        ShellNavigationState    `json:"state" bson:"state" yaml:"state"`
 	Icon         string                  `json:"icon" bson:"icon" yaml:"icon"`
+	Hidden       bool                    `json:"hidden" bson:"hidden" yaml:"hidden"`
 }
 
 type ShellNavigationModule struct {
-	Path          string `json:"path" bson:"path" yaml:"path"`
-	RemoteEntry   string `json:"remoteEntry" bson:"remoteEntry" yaml:"remoteEntry"`
-	ExposedModule string `json:"exposedModule" bson:"exposedModule" yaml:"exposedModule"`
-	ModuleName    string `json:"moduleName" bson:"moduleName" yaml:"moduleName"`
-	Outlet        string `json:"outlet" bson:"outlet" yaml:"outlet"`
+	Path          string       `json:"path" bson:"path" yaml:"path"`
+	RemoteEntry   string       `json:"remoteEntry" bson:"remoteEntry" yaml:"remoteEntry"`
+	ExposedModule string       `json:"exposedModule" bson:"exposedModule" yaml:"exposedModule"`
+	ModuleName    string       `json:"moduleName" bson:"moduleName" yaml:"moduleName"`
+	Outlet        string       `json:"outlet" bson:"outlet" yaml:"outlet"`
+	Format        ModuleFormat `json:"format" bson:"format" yaml:"format"`
 }
 
 type ShellNavigationSlot struct {
 	Priority     *int                       `json:"priority,omitempty" bson:"-"`
 	Slot         string                     `json:"slot" bson:"slot" yaml:"slot"`
+	App          *string                    `json:"app,omitempty" bson:"app" yaml:"app"`
 	Description  string                     `json:"description" bson:"description" yaml:"description"`
 	AuthRequired *bool                      `json:"authRequired,omitempty" bson:"authRequired" yaml:"authRequired"`
 	Module       *ShellNavigationSlotModule `json:"module" bson:"module" yaml:"module"`
 }
 
 type ShellNavigationSlotModule struct {
-	Path          string `json:"path" bson:"path" yaml:"path"`
-	RemoteEntry   string `json:"remoteEntry" bson:"remoteEntry" yaml:"remoteEntry"`
-	ExposedModule string `json:"exposedModule" bson:"exposedModule" yaml:"exposedModule"`
-	ModuleName    string `json:"moduleName" bson:"moduleName" yaml:"moduleName"`
+	Path          string       `json:"path" bson:"path" yaml:"path"`
+	RemoteEntry   string       `json:"remoteEntry" bson:"remoteEntry" yaml:"remoteEntry"`
+	ExposedModule string       `json:"exposedModule" bson:"exposedModule" yaml:"exposedModule"`
+	ModuleName    string       `json:"moduleName" bson:"moduleName" yaml:"moduleName"`
+	Format        ModuleFormat `json:"format" bson:"format" yaml:"format"`
+}
+
+type SignOverrideInput struct {
+	Overrides []*OverrideInput `json:"overrides" bson:"-"`
+}
+
+type SignOverrideOutput struct {
+	Value     string    `json:"value" bson:"-"`
+	Signature string    `json:"signature" bson:"-"`
+	ExpiresAt time.Time `json:"expiresAt" bson:"-"`
 }
 
 type TagValue struct {
@@ -208,6 +386,122 @@ type TagValues struct {
 	Values []*TagValue `json:"Values,omitempty" bson:"values" yaml:"values"`
 }
 
+type TranslationInput struct {
+	Locale      string  `json:"locale" bson:"-"`
+	Title       *string `json:"title,omitempty" bson:"-"`
+	SubTitle    *string `json:"subTitle,omitempty" bson:"-"`
+	Description *string `json:"description,omitempty" bson:"-"`
+}
+
+type UserPreferences struct {
+	DefaultRoute *string      `json:"defaultRoute,omitempty" bson:"-"`
+	Pinned       []string     `json:"pinned" bson:"-"`
+	Hidden       []string     `json:"hidden" bson:"-"`
+	Recents      []*RecentApp `json:"recents" bson:"-"`
+}
+
+type AuditEventType string
+
+const (
+	AuditEventTypeRegistered                AuditEventType = "Registered"
+	AuditEventTypeUpdated                   AuditEventType = "Updated"
+	AuditEventTypeUnregistered              AuditEventType = "Unregistered"
+	AuditEventTypeUnavailable               AuditEventType = "Unavailable"
+	AuditEventTypeAvailable                 AuditEventType = "Available"
+	AuditEventTypeRolledBack                AuditEventType = "RolledBack"
+	AuditEventTypeMaintenanceSet            AuditEventType = "MaintenanceSet"
+	AuditEventTypeMaintenanceCleared        AuditEventType = "MaintenanceCleared"
+	AuditEventTypeNavigationOverrideSet     AuditEventType = "NavigationOverrideSet"
+	AuditEventTypeNavigationOverrideCleared AuditEventType = "NavigationOverrideCleared"
+	AuditEventTypeRebuilt                   AuditEventType = "Rebuilt"
+)
+
+var AllAuditEventType = []AuditEventType{
+	AuditEventTypeRegistered,
+	AuditEventTypeUpdated,
+	AuditEventTypeUnregistered,
+	AuditEventTypeUnavailable,
+	AuditEventTypeAvailable,
+	AuditEventTypeRolledBack,
+	AuditEventTypeMaintenanceSet,
+	AuditEventTypeMaintenanceCleared,
+	AuditEventTypeNavigationOverrideSet,
+	AuditEventTypeNavigationOverrideCleared,
+	AuditEventTypeRebuilt,
+}
+
+func (e AuditEventType) IsValid() bool {
+	switch e {
+	case AuditEventTypeRegistered, AuditEventTypeUpdated, AuditEventTypeUnregistered, AuditEventTypeUnavailable, AuditEventTypeAvailable, AuditEventTypeRolledBack, AuditEventTypeMaintenanceSet, AuditEventTypeMaintenanceCleared, AuditEventTypeNavigationOverrideSet, AuditEventTypeNavigationOverrideCleared, AuditEventTypeRebuilt:
+		return true
+	}
+	return false
+}
+
+func (e AuditEventType) String() string {
+	return string(e)
+}
+
+func (e *AuditEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditEventType", str)
+	}
+	return nil
+}
+
+func (e AuditEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ModuleFormat string
+
+const (
+	ModuleFormatRemoteEntry ModuleFormat = "RemoteEntry"
+	ModuleFormatEsm         ModuleFormat = "Esm"
+	ModuleFormatSystemJs    ModuleFormat = "SystemJs"
+)
+
+var AllModuleFormat = []ModuleFormat{
+	ModuleFormatRemoteEntry,
+	ModuleFormatEsm,
+	ModuleFormatSystemJs,
+}
+
+func (e ModuleFormat) IsValid() bool {
+	switch e {
+	case ModuleFormatRemoteEntry, ModuleFormatEsm, ModuleFormatSystemJs:
+		return true
+	}
+	return false
+}
+
+func (e ModuleFormat) String() string {
+	return string(e)
+}
+
+func (e *ModuleFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModuleFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModuleFormat", str)
+	}
+	return nil
+}
+
+func (e ModuleFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type QueryOperators string
 
 const (
@@ -309,16 +603,18 @@ func (e QueryType) MarshalGQL(w io.Writer) {
 type RefRoot string
 
 const (
-	RefRootAppRef RefRoot = "AppRef"
+	RefRootAppRef   RefRoot = "AppRef"
+	RefRootAuditRef RefRoot = "AuditRef"
 )
 
 var AllRefRoot = []RefRoot{
 	RefRootAppRef,
+	RefRootAuditRef,
 }
 
 func (e RefRoot) IsValid() bool {
 	switch e {
-	case RefRootAppRef:
+	case RefRootAppRef, RefRootAuditRef:
 		return true
 	}
 	return false
@@ -345,92 +641,94 @@ func (e RefRoot) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-type RegisterAppCategory string
+type ShellConfigEventType string
 
 const (
-	RegisterAppCategoryApp       RegisterAppCategory = "App"
-	RegisterAppCategorySetting   RegisterAppCategory = "Setting"
-	RegisterAppCategoryDashboard RegisterAppCategory = "Dashboard"
+	ShellConfigEventTypeInitial     ShellConfigEventType = "Initial"
+	ShellConfigEventTypeUpdated     ShellConfigEventType = "Updated"
+	ShellConfigEventTypeRemoved     ShellConfigEventType = "Removed"
+	ShellConfigEventTypeAdded       ShellConfigEventType = "Added"
+	ShellConfigEventTypeRebuild     ShellConfigEventType = "Rebuild"
+	ShellConfigEventTypeMaintenance ShellConfigEventType = "Maintenance"
 )
 
-var AllRegisterAppCategory = []RegisterAppCategory{
-	RegisterAppCategoryApp,
-	RegisterAppCategorySetting,
-	RegisterAppCategoryDashboard,
+var AllShellConfigEventType = []ShellConfigEventType{
+	ShellConfigEventTypeInitial,
+	ShellConfigEventTypeUpdated,
+	ShellConfigEventTypeRemoved,
+	ShellConfigEventTypeAdded,
+	ShellConfigEventTypeRebuild,
+	ShellConfigEventTypeMaintenance,
 }
 
-func (e RegisterAppCategory) IsValid() bool {
+func (e ShellConfigEventType) IsValid() bool {
 	switch e {
-	case RegisterAppCategoryApp, RegisterAppCategorySetting, RegisterAppCategoryDashboard:
+	case ShellConfigEventTypeInitial, ShellConfigEventTypeUpdated, ShellConfigEventTypeRemoved, ShellConfigEventTypeAdded, ShellConfigEventTypeRebuild, ShellConfigEventTypeMaintenance:
 		return true
 	}
 	return false
 }
 
-func (e RegisterAppCategory) String() string {
+func (e ShellConfigEventType) String() string {
 	return string(e)
 }
 
-func (e *RegisterAppCategory) UnmarshalGQL(v interface{}) error {
+func (e *ShellConfigEventType) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = RegisterAppCategory(str)
+	*e = ShellConfigEventType(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid RegisterAppCategory", str)
+		return fmt.Errorf("%s is not a valid ShellConfigEventType", str)
 	}
 	return nil
 }
 
-func (e RegisterAppCategory) MarshalGQL(w io.Writer) {
+func (e ShellConfigEventType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-type ShellConfigEventType string
+type ShellNavigationState string
 
 const (
-	ShellConfigEventTypeInitial ShellConfigEventType = "Initial"
-	ShellConfigEventTypeUpdated ShellConfigEventType = "Updated"
-	ShellConfigEventTypeRemoved ShellConfigEventType = "Removed"
-	ShellConfigEventTypeAdded   ShellConfigEventType = "Added"
-	ShellConfigEventTypeRebuild ShellConfigEventType = "Rebuild"
+	ShellNavigationStateHealthy     ShellNavigationState = "Healthy"
+	ShellNavigationStateUnhealthy   ShellNavigationState = "Unhealthy"
+	ShellNavigationStateMaintenance ShellNavigationState = "Maintenance"
 )
 
-var AllShellConfigEventType = []ShellConfigEventType{
-	ShellConfigEventTypeInitial,
-	ShellConfigEventTypeUpdated,
-	ShellConfigEventTypeRemoved,
-	ShellConfigEventTypeAdded,
-	ShellConfigEventTypeRebuild,
+var AllShellNavigationState = []ShellNavigationState{
+	ShellNavigationStateHealthy,
+	ShellNavigationStateUnhealthy,
+	ShellNavigationStateMaintenance,
 }
 
-func (e ShellConfigEventType) IsValid() bool {
+func (e ShellNavigationState) IsValid() bool {
 	switch e {
-	case ShellConfigEventTypeInitial, ShellConfigEventTypeUpdated, ShellConfigEventTypeRemoved, ShellConfigEventTypeAdded, ShellConfigEventTypeRebuild:
+	case ShellNavigationStateHealthy, ShellNavigationStateUnhealthy, ShellNavigationStateMaintenance:
 		return true
 	}
 	return false
 }
 
-func (e ShellConfigEventType) String() string {
+func (e ShellNavigationState) String() string {
 	return string(e)
 }
 
-func (e *ShellConfigEventType) UnmarshalGQL(v interface{}) error {
+func (e *ShellNavigationState) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ShellConfigEventType(str)
+	*e = ShellNavigationState(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ShellConfigEventType", str)
+		return fmt.Errorf("%s is not a valid ShellNavigationState", str)
 	}
 	return nil
 }
 
-func (e ShellConfigEventType) MarshalGQL(w io.Writer) {
+func (e ShellNavigationState) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
@@ -6,10 +6,13 @@ package pvtresolvers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
 	pvtgraph "github.com/azarc-io/verathread-gateway/internal/gql/graph/private"
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/util"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
 	gqlutil "github.com/azarc-io/verathread-next-common/util/gql"
 )
 
@@ -17,7 +20,9 @@ import (
 func (r *mutationResolver) RegisterApp(ctx context.Context, input model.RegisterAppInput) (*model.RegisterAppOutput, error) {
 	rsp, err := r.InternalService.RegisterApp(ctx, &input)
 	if err != nil {
-		gqlutil.AddGeneralError(ctx, err, http.StatusInternalServerError)
+		if !util.AddValidationErrors(ctx, err) {
+			gqlutil.AddGeneralError(ctx, err, http.StatusInternalServerError)
+		}
 		return nil, nil
 	}
 
@@ -35,6 +40,165 @@ func (r *mutationResolver) KeepAlive(ctx context.Context, input *model.KeepAlive
 	return rsp, nil
 }
 
+// RollbackAppRegistration is the resolver for the rollbackAppRegistration field.
+func (r *mutationResolver) RollbackAppRegistration(ctx context.Context, id string, revision int) (*model.RegisterAppOutput, error) {
+	rsp, err := r.InternalService.RollbackAppRegistration(ctx, id, revision)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, apptypes.ErrRevisionNotFound) || errors.Is(err, apptypes.ErrAppNotFound) {
+			status = http.StatusNotFound
+		}
+		gqlutil.AddGeneralError(ctx, err, status)
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// UnregisterApp is the resolver for the unregisterApp field.
+func (r *mutationResolver) UnregisterApp(ctx context.Context, id string) (bool, error) {
+	if err := r.InternalService.UnregisterApp(ctx, id); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, apptypes.ErrAppNotFound) {
+			status = http.StatusNotFound
+		}
+		gqlutil.AddGeneralError(ctx, err, status)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// SignOverride is the resolver for the signOverride field.
+func (r *mutationResolver) SignOverride(ctx context.Context, input model.SignOverrideInput) (*model.SignOverrideOutput, error) {
+	rsp, err := r.InternalService.SignOverride(ctx, &input)
+	if err != nil {
+		status := http.StatusBadRequest
+		switch {
+		case errors.Is(err, apptypes.ErrUserRequired):
+			status = http.StatusUnauthorized
+		case errors.Is(err, apptypes.ErrOverrideForbidden):
+			status = http.StatusForbidden
+		}
+		gqlutil.AddGeneralError(ctx, err, status)
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// SetMaintenance is the resolver for the setMaintenance field.
+func (r *mutationResolver) SetMaintenance(ctx context.Context, input model.SetMaintenanceInput) (*model.MaintenanceWindow, error) {
+	rsp, err := r.InternalService.SetMaintenance(ctx, &input)
+	if err != nil {
+		gqlutil.AddGeneralError(ctx, err, http.StatusBadRequest)
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// ClearMaintenance is the resolver for the clearMaintenance field.
+func (r *mutationResolver) ClearMaintenance(ctx context.Context, input model.ClearMaintenanceInput) (bool, error) {
+	if err := r.InternalService.ClearMaintenance(ctx, &input); err != nil {
+		gqlutil.AddGeneralError(ctx, err, http.StatusInternalServerError)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// SetNavigationOverride is the resolver for the setNavigationOverride field.
+func (r *mutationResolver) SetNavigationOverride(ctx context.Context, input model.SetNavigationOverrideInput) (*model.NavigationOverride, error) {
+	rsp, err := r.InternalService.SetNavigationOverride(ctx, &input)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			gqlutil.AddGeneralError(ctx, err, http.StatusInternalServerError)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// ClearNavigationOverride is the resolver for the clearNavigationOverride field.
+func (r *mutationResolver) ClearNavigationOverride(ctx context.Context, id string) (bool, error) {
+	if err := r.InternalService.ClearNavigationOverride(ctx, id); err != nil {
+		gqlutil.AddGeneralError(ctx, err, http.StatusInternalServerError)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// SetDefaultRoute is the resolver for the setDefaultRoute field.
+func (r *mutationResolver) SetDefaultRoute(ctx context.Context, route *string) (*model.UserPreferences, error) {
+	rsp, err := r.InternalService.SetDefaultRoute(ctx, route)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			status := http.StatusInternalServerError
+			if errors.Is(err, apptypes.ErrUserRequired) {
+				status = http.StatusUnauthorized
+			}
+			gqlutil.AddGeneralError(ctx, err, status)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// SetNavigationPinned is the resolver for the setNavigationPinned field.
+func (r *mutationResolver) SetNavigationPinned(ctx context.Context, id string, pinned bool) (*model.UserPreferences, error) {
+	rsp, err := r.InternalService.SetNavigationPinned(ctx, id, pinned)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			status := http.StatusInternalServerError
+			if errors.Is(err, apptypes.ErrUserRequired) {
+				status = http.StatusUnauthorized
+			}
+			gqlutil.AddGeneralError(ctx, err, status)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// SetNavigationHidden is the resolver for the setNavigationHidden field.
+func (r *mutationResolver) SetNavigationHidden(ctx context.Context, id string, hidden bool) (*model.UserPreferences, error) {
+	rsp, err := r.InternalService.SetNavigationHidden(ctx, id, hidden)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			status := http.StatusInternalServerError
+			if errors.Is(err, apptypes.ErrUserRequired) {
+				status = http.StatusUnauthorized
+			}
+			gqlutil.AddGeneralError(ctx, err, status)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// ClearRecentApps is the resolver for the clearRecentApps field.
+func (r *mutationResolver) ClearRecentApps(ctx context.Context) (*model.UserPreferences, error) {
+	rsp, err := r.InternalService.ClearRecentApps(ctx)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			status := http.StatusInternalServerError
+			if errors.Is(err, apptypes.ErrUserRequired) {
+				status = http.StatusUnauthorized
+			}
+			gqlutil.AddGeneralError(ctx, err, status)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
 // Mutation returns pvtgraph.MutationResolver implementation.
 func (r *Resolver) Mutation() pvtgraph.MutationResolver { return &mutationResolver{r} }
 
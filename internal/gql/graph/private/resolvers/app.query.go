@@ -6,14 +6,59 @@ package pvtresolvers
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
 	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
 	pvtgraph "github.com/azarc-io/verathread-gateway/internal/gql/graph/private"
 	"github.com/azarc-io/verathread-gateway/internal/gql/graph/util"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
 	"github.com/azarc-io/verathread-next-common/common/genericdb"
 	gqlutil "github.com/azarc-io/verathread-next-common/util/gql"
 )
 
+// AppRegistrationHistory is the resolver for the appRegistrationHistory field.
+func (r *queryResolver) AppRegistrationHistory(ctx context.Context, id string) ([]*model.AppRegistrationRevision, error) {
+	rsp, err := r.InternalService.GetAppRegistrationHistory(ctx, id)
+	if err != nil {
+		gqlutil.AddGeneralError(ctx, err, http.StatusInternalServerError)
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// NavigationOverrides is the resolver for the navigationOverrides field.
+func (r *queryResolver) NavigationOverrides(ctx context.Context) ([]*model.NavigationOverride, error) {
+	rsp, err := r.InternalService.ListNavigationOverrides(ctx)
+	if err != nil {
+		gqlutil.AddGeneralError(ctx, err, http.StatusInternalServerError)
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// AuditEvents is the resolver for the auditEvents field.
+func (r *queryResolver) AuditEvents(ctx context.Context, page genericdb.Page, where *model.AuditEventsWhereRules, sort *model.AuditEventsSort) (*model.AuditEventsPage, error) {
+	query := &genericdb.GenericPagedQuery{
+		Page:    gqlutil.ToGenericPage(page),
+		Query:   gqlutil.ToGenericQuery(where, &genericdb.GenericRule{}),
+		Sort:    gqlutil.GetSortFields(sort),
+		Project: gqlutil.GetProjection(model.RefRootAuditRef, ctx),
+	}
+
+	if data, pageInfo, ok := util.DoGenericPagedQuery[[]*model.AuditEvent](ctx, r.Opts, apptypes.AuditCollection, query); !ok {
+		return nil, nil
+	} else {
+		return &model.AuditEventsPage{
+			Data: data,
+			Page: pageInfo,
+		}, nil
+	}
+}
+
 // RegisteredApps is the resolver for the registeredApps field.
 func (r *queryResolver) RegisteredApps(ctx context.Context, page genericdb.Page, where *model.RegisteredAppsWhereRules, sort *model.RegisteredAppsSort) (*model.RegisteredAppsPage, error) {
 	query := &genericdb.GenericPagedQuery{
@@ -34,8 +79,48 @@ func (r *queryResolver) RegisteredApps(ctx context.Context, page genericdb.Page,
 }
 
 // ShellConfiguration is the resolver for the shellConfiguration field.
-func (r *queryResolver) ShellConfiguration(ctx context.Context, tenantID string) (*model.ShellConfiguration, error) {
-	return r.InternalService.GetAppConfiguration(ctx, tenantID)
+func (r *queryResolver) ShellConfiguration(ctx context.Context, tenantID string, locale *string, sinceVersion *int, sinceHash *string) (*model.ShellConfiguration, error) {
+	ctx = apputil.PreferLocale(ctx, locale)
+
+	cfg, err := r.InternalService.GetAppConfiguration(ctx, tenantID)
+	if err != nil || sinceVersion == nil {
+		return cfg, err
+	}
+
+	base, err := r.InternalService.GetAppConfigurationVersion(ctx, tenantID, *sinceVersion)
+	if err != nil && !errors.Is(err, apptypes.ErrConfigurationNotFound) {
+		return nil, err
+	}
+
+	return apputil.ConfigurationSince(base, cfg, sinceHash)
+}
+
+// UserPreferences is the resolver for the userPreferences field.
+func (r *queryResolver) UserPreferences(ctx context.Context) (*model.UserPreferences, error) {
+	rsp, err := r.InternalService.GetUserPreferences(ctx)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, apptypes.ErrUserRequired) {
+			status = http.StatusUnauthorized
+		}
+		gqlutil.AddGeneralError(ctx, err, status)
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// SearchNavigation is the resolver for the searchNavigation field.
+func (r *queryResolver) SearchNavigation(ctx context.Context, tenantID string, query string, limit *int, includeUnavailable *bool) ([]*model.NavigationSearchResult, error) {
+	rsp, err := r.InternalService.SearchNavigation(ctx, tenantID, query, limit, includeUnavailable != nil && *includeUnavailable)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			gqlutil.AddGeneralError(ctx, err, http.StatusInternalServerError)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
 }
 
 // Query returns pvtgraph.QueryResolver implementation.
@@ -6,27 +6,44 @@ package pvtresolvers
 
 import (
 	"context"
+	"errors"
 	"slices"
 
 	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
 	pvtgraph "github.com/azarc-io/verathread-gateway/internal/gql/graph/private"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
 )
 
 // ShellConfiguration is the resolver for the shellConfiguration field.
-func (r *subscriptionResolver) ShellConfiguration(ctx context.Context, tenantID string, events []model.ShellConfigEventType) (<-chan *model.ShellConfigurationSubscription, error) {
+func (r *subscriptionResolver) ShellConfiguration(ctx context.Context, tenantID string, events []model.ShellConfigEventType, locale *string, sinceVersion *int, sinceHash *string) (<-chan *model.ShellConfigurationSubscription, error) {
+	ctx = apputil.PreferLocale(ctx, locale)
+
 	var (
 		ch = make(chan *model.ShellConfigurationSubscription, 1)
 	)
 
 	// load initial apps config
 	if slices.Index(events, model.ShellConfigEventTypeInitial) > -1 {
-		if cfg, err := r.InternalService.GetAppConfiguration(ctx, tenantID); err != nil {
+		cfg, err := r.InternalService.GetAppConfiguration(ctx, tenantID)
+		if err != nil {
 			return nil, err
-		} else {
-			ch <- &model.ShellConfigurationSubscription{
-				Configuration: cfg,
-				EventType:     model.ShellConfigEventTypeInitial,
+		}
+
+		if sinceVersion != nil {
+			base, err := r.InternalService.GetAppConfigurationVersion(ctx, tenantID, *sinceVersion)
+			if err != nil && !errors.Is(err, apptypes.ErrConfigurationNotFound) {
+				return nil, err
 			}
+
+			if cfg, err = apputil.ConfigurationSince(base, cfg, sinceHash); err != nil {
+				return nil, err
+			}
+		}
+
+		ch <- &model.ShellConfigurationSubscription{
+			Configuration: cfg,
+			EventType:     model.ShellConfigEventTypeInitial,
 		}
 	}
 
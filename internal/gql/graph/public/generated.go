@@ -41,6 +41,7 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Mutation() MutationResolver
 	Query() QueryResolver
 	Subscription() SubscriptionResolver
 }
@@ -51,11 +52,78 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AppRegistrationRevision struct {
+		Navigation   func(childComplexity int) int
+		Package      func(childComplexity int) int
+		RegisteredAt func(childComplexity int) int
+		Revision     func(childComplexity int) int
+		RollbackOf   func(childComplexity int) int
+		Version      func(childComplexity int) int
+	}
+
+	AuditChange struct {
+		After  func(childComplexity int) int
+		Before func(childComplexity int) int
+		Field  func(childComplexity int) int
+	}
+
+	AuditEvent struct {
+		Actor     func(childComplexity int) int
+		App       func(childComplexity int) int
+		Changes   func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Type      func(childComplexity int) int
+	}
+
+	AuditEventsPage struct {
+		Data func(childComplexity int) int
+		Page func(childComplexity int) int
+	}
+
 	KeepAliveAppOutput struct {
 		Ok                   func(childComplexity int) int
 		RegistrationRequired func(childComplexity int) int
 	}
 
+	MaintenanceWindow struct {
+		App       func(childComplexity int) int
+		Message   func(childComplexity int) int
+		StartedAt func(childComplexity int) int
+		Until     func(childComplexity int) int
+	}
+
+	Mutation struct {
+		ClearRecentApps     func(childComplexity int) int
+		SetDefaultRoute     func(childComplexity int, route *string) int
+		SetNavigationHidden func(childComplexity int, id string, hidden bool) int
+		SetNavigationPinned func(childComplexity int, id string, pinned bool) int
+	}
+
+	NavigationOverride struct {
+		Category  func(childComplexity int) int
+		Hidden    func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Icon      func(childComplexity int) int
+		Order     func(childComplexity int) int
+		SubTitle  func(childComplexity int) int
+		Title     func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+	}
+
+	NavigationSearchResult struct {
+		App         func(childComplexity int) int
+		Breadcrumbs func(childComplexity int) int
+		Category    func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Icon        func(childComplexity int) int
+		Path        func(childComplexity int) int
+		Score       func(childComplexity int) int
+		State       func(childComplexity int) int
+		SubTitle    func(childComplexity int) int
+		Title       func(childComplexity int) int
+	}
+
 	PageInfo struct {
 		Next      func(childComplexity int) int
 		Page      func(childComplexity int) int
@@ -67,7 +135,14 @@ type ComplexityRoot struct {
 
 	Query struct {
 		RegisteredApps     func(childComplexity int, page genericdb.Page, where *model.RegisteredAppsWhereRules, sort *model.RegisteredAppsSort) int
-		ShellConfiguration func(childComplexity int, tenantID string) int
+		SearchNavigation   func(childComplexity int, tenantID string, query string, limit *int, includeUnavailable *bool) int
+		ShellConfiguration func(childComplexity int, tenantID string, locale *string, sinceVersion *int, sinceHash *string) int
+		UserPreferences    func(childComplexity int) int
+	}
+
+	RecentApp struct {
+		App       func(childComplexity int) int
+		VisitedAt func(childComplexity int) int
 	}
 
 	RegisterAppOutput struct {
@@ -87,9 +162,22 @@ type ComplexityRoot struct {
 	}
 
 	ShellConfiguration struct {
+		BaseVersion  func(childComplexity int) int
 		Categories   func(childComplexity int) int
 		DefaultRoute func(childComplexity int) int
+		Hash         func(childComplexity int) int
+		Locale       func(childComplexity int) int
+		Locales      func(childComplexity int) int
+		Maintenance  func(childComplexity int) int
+		Patch        func(childComplexity int) int
 		Slots        func(childComplexity int) int
+		Version      func(childComplexity int) int
+	}
+
+	ShellConfigurationPatchOperation struct {
+		Op    func(childComplexity int) int
+		Path  func(childComplexity int) int
+		Value func(childComplexity int) int
 	}
 
 	ShellConfigurationSubscription struct {
@@ -104,7 +192,9 @@ type ComplexityRoot struct {
 		Hidden       func(childComplexity int) int
 		ID           func(childComplexity int) int
 		Icon         func(childComplexity int) int
+		Maintenance  func(childComplexity int) int
 		Module       func(childComplexity int) int
+		State        func(childComplexity int) int
 		SubTitle     func(childComplexity int) int
 		Title        func(childComplexity int) int
 	}
@@ -112,6 +202,8 @@ type ComplexityRoot struct {
 	ShellNavigationCategory struct {
 		Category func(childComplexity int) int
 		Entries  func(childComplexity int) int
+		Hidden   func(childComplexity int) int
+		Icon     func(childComplexity int) int
 		Priority func(childComplexity int) int
 		Title    func(childComplexity int) int
 	}
@@ -120,14 +212,17 @@ type ComplexityRoot struct {
 		AuthRequired func(childComplexity int) int
 		Children     func(childComplexity int) int
 		Healthy      func(childComplexity int) int
+		Hidden       func(childComplexity int) int
 		Icon         func(childComplexity int) int
 		Module       func(childComplexity int) int
+		State        func(childComplexity int) int
 		SubTitle     func(childComplexity int) int
 		Title        func(childComplexity int) int
 	}
 
 	ShellNavigationModule struct {
 		ExposedModule func(childComplexity int) int
+		Format        func(childComplexity int) int
 		ModuleName    func(childComplexity int) int
 		Outlet        func(childComplexity int) int
 		Path          func(childComplexity int) int
@@ -135,6 +230,7 @@ type ComplexityRoot struct {
 	}
 
 	ShellNavigationSlot struct {
+		App          func(childComplexity int) int
 		AuthRequired func(childComplexity int) int
 		Description  func(childComplexity int) int
 		Module       func(childComplexity int) int
@@ -144,13 +240,20 @@ type ComplexityRoot struct {
 
 	ShellNavigationSlotModule struct {
 		ExposedModule func(childComplexity int) int
+		Format        func(childComplexity int) int
 		ModuleName    func(childComplexity int) int
 		Path          func(childComplexity int) int
 		RemoteEntry   func(childComplexity int) int
 	}
 
+	SignOverrideOutput struct {
+		ExpiresAt func(childComplexity int) int
+		Signature func(childComplexity int) int
+		Value     func(childComplexity int) int
+	}
+
 	Subscription struct {
-		ShellConfiguration func(childComplexity int, tenantID string, events []model.ShellConfigEventType) int
+		ShellConfiguration func(childComplexity int, tenantID string, events []model.ShellConfigEventType, locale *string, sinceVersion *int, sinceHash *string) int
 	}
 
 	TagValue struct {
@@ -161,14 +264,29 @@ type ComplexityRoot struct {
 		Key    func(childComplexity int) int
 		Values func(childComplexity int) int
 	}
+
+	UserPreferences struct {
+		DefaultRoute func(childComplexity int) int
+		Hidden       func(childComplexity int) int
+		Pinned       func(childComplexity int) int
+		Recents      func(childComplexity int) int
+	}
 }
 
+type MutationResolver interface {
+	SetDefaultRoute(ctx context.Context, route *string) (*model.UserPreferences, error)
+	SetNavigationPinned(ctx context.Context, id string, pinned bool) (*model.UserPreferences, error)
+	SetNavigationHidden(ctx context.Context, id string, hidden bool) (*model.UserPreferences, error)
+	ClearRecentApps(ctx context.Context) (*model.UserPreferences, error)
+}
 type QueryResolver interface {
 	RegisteredApps(ctx context.Context, page genericdb.Page, where *model.RegisteredAppsWhereRules, sort *model.RegisteredAppsSort) (*model.RegisteredAppsPage, error)
-	ShellConfiguration(ctx context.Context, tenantID string) (*model.ShellConfiguration, error)
+	ShellConfiguration(ctx context.Context, tenantID string, locale *string, sinceVersion *int, sinceHash *string) (*model.ShellConfiguration, error)
+	UserPreferences(ctx context.Context) (*model.UserPreferences, error)
+	SearchNavigation(ctx context.Context, tenantID string, query string, limit *int, includeUnavailable *bool) ([]*model.NavigationSearchResult, error)
 }
 type SubscriptionResolver interface {
-	ShellConfiguration(ctx context.Context, tenantID string, events []model.ShellConfigEventType) (<-chan *model.ShellConfigurationSubscription, error)
+	ShellConfiguration(ctx context.Context, tenantID string, events []model.ShellConfigEventType, locale *string, sinceVersion *int, sinceHash *string) (<-chan *model.ShellConfigurationSubscription, error)
 }
 
 type executableSchema struct {
@@ -190,3508 +308,2105 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
-	case "KeepAliveAppOutput.ok":
-		if e.complexity.KeepAliveAppOutput.Ok == nil {
+	case "AppRegistrationRevision.navigation":
+		if e.complexity.AppRegistrationRevision.Navigation == nil {
 			break
 		}
 
-		return e.complexity.KeepAliveAppOutput.Ok(childComplexity), true
+		return e.complexity.AppRegistrationRevision.Navigation(childComplexity), true
 
-	case "KeepAliveAppOutput.registrationRequired":
-		if e.complexity.KeepAliveAppOutput.RegistrationRequired == nil {
+	case "AppRegistrationRevision.package":
+		if e.complexity.AppRegistrationRevision.Package == nil {
 			break
 		}
 
-		return e.complexity.KeepAliveAppOutput.RegistrationRequired(childComplexity), true
+		return e.complexity.AppRegistrationRevision.Package(childComplexity), true
 
-	case "PageInfo.next":
-		if e.complexity.PageInfo.Next == nil {
+	case "AppRegistrationRevision.registeredAt":
+		if e.complexity.AppRegistrationRevision.RegisteredAt == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.Next(childComplexity), true
+		return e.complexity.AppRegistrationRevision.RegisteredAt(childComplexity), true
 
-	case "PageInfo.page":
-		if e.complexity.PageInfo.Page == nil {
+	case "AppRegistrationRevision.revision":
+		if e.complexity.AppRegistrationRevision.Revision == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.Page(childComplexity), true
+		return e.complexity.AppRegistrationRevision.Revision(childComplexity), true
 
-	case "PageInfo.perPage":
-		if e.complexity.PageInfo.PerPage == nil {
+	case "AppRegistrationRevision.rollbackOf":
+		if e.complexity.AppRegistrationRevision.RollbackOf == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.PerPage(childComplexity), true
+		return e.complexity.AppRegistrationRevision.RollbackOf(childComplexity), true
 
-	case "PageInfo.prev":
-		if e.complexity.PageInfo.Prev == nil {
+	case "AppRegistrationRevision.version":
+		if e.complexity.AppRegistrationRevision.Version == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.Prev(childComplexity), true
+		return e.complexity.AppRegistrationRevision.Version(childComplexity), true
 
-	case "PageInfo.total":
-		if e.complexity.PageInfo.Total == nil {
+	case "AuditChange.after":
+		if e.complexity.AuditChange.After == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.Total(childComplexity), true
+		return e.complexity.AuditChange.After(childComplexity), true
 
-	case "PageInfo.totalPage":
-		if e.complexity.PageInfo.TotalPage == nil {
+	case "AuditChange.before":
+		if e.complexity.AuditChange.Before == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.TotalPage(childComplexity), true
+		return e.complexity.AuditChange.Before(childComplexity), true
 
-	case "Query.registeredApps":
-		if e.complexity.Query.RegisteredApps == nil {
+	case "AuditChange.field":
+		if e.complexity.AuditChange.Field == nil {
 			break
 		}
 
-		args, err := ec.field_Query_registeredApps_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Query.RegisteredApps(childComplexity, args["page"].(genericdb.Page), args["where"].(*model.RegisteredAppsWhereRules), args["sort"].(*model.RegisteredAppsSort)), true
+		return e.complexity.AuditChange.Field(childComplexity), true
 
-	case "Query.shellConfiguration":
-		if e.complexity.Query.ShellConfiguration == nil {
+	case "AuditEvent.actor":
+		if e.complexity.AuditEvent.Actor == nil {
 			break
 		}
 
-		args, err := ec.field_Query_shellConfiguration_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Query.ShellConfiguration(childComplexity, args["tenantId"].(string)), true
+		return e.complexity.AuditEvent.Actor(childComplexity), true
 
-	case "RegisterAppOutput.id":
-		if e.complexity.RegisterAppOutput.ID == nil {
+	case "AuditEvent.app":
+		if e.complexity.AuditEvent.App == nil {
 			break
 		}
 
-		return e.complexity.RegisterAppOutput.ID(childComplexity), true
+		return e.complexity.AuditEvent.App(childComplexity), true
 
-	case "RegisteredApp.createdAt":
-		if e.complexity.RegisteredApp.CreatedAt == nil {
+	case "AuditEvent.changes":
+		if e.complexity.AuditEvent.Changes == nil {
 			break
 		}
 
-		return e.complexity.RegisteredApp.CreatedAt(childComplexity), true
+		return e.complexity.AuditEvent.Changes(childComplexity), true
 
-	case "RegisteredApp.name":
-		if e.complexity.RegisteredApp.Name == nil {
+	case "AuditEvent.createdAt":
+		if e.complexity.AuditEvent.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.RegisteredApp.Name(childComplexity), true
+		return e.complexity.AuditEvent.CreatedAt(childComplexity), true
 
-	case "RegisteredApp.pkg":
-		if e.complexity.RegisteredApp.Pkg == nil {
+	case "AuditEvent.id":
+		if e.complexity.AuditEvent.ID == nil {
 			break
 		}
 
-		return e.complexity.RegisteredApp.Pkg(childComplexity), true
+		return e.complexity.AuditEvent.ID(childComplexity), true
 
-	case "RegisteredApp.updatedAt":
-		if e.complexity.RegisteredApp.UpdatedAt == nil {
+	case "AuditEvent.type":
+		if e.complexity.AuditEvent.Type == nil {
 			break
 		}
 
-		return e.complexity.RegisteredApp.UpdatedAt(childComplexity), true
+		return e.complexity.AuditEvent.Type(childComplexity), true
 
-	case "RegisteredAppsPage.data":
-		if e.complexity.RegisteredAppsPage.Data == nil {
+	case "AuditEventsPage.data":
+		if e.complexity.AuditEventsPage.Data == nil {
 			break
 		}
 
-		return e.complexity.RegisteredAppsPage.Data(childComplexity), true
+		return e.complexity.AuditEventsPage.Data(childComplexity), true
 
-	case "RegisteredAppsPage.page":
-		if e.complexity.RegisteredAppsPage.Page == nil {
+	case "AuditEventsPage.page":
+		if e.complexity.AuditEventsPage.Page == nil {
 			break
 		}
 
-		return e.complexity.RegisteredAppsPage.Page(childComplexity), true
+		return e.complexity.AuditEventsPage.Page(childComplexity), true
 
-	case "ShellConfiguration.categories":
-		if e.complexity.ShellConfiguration.Categories == nil {
+	case "KeepAliveAppOutput.ok":
+		if e.complexity.KeepAliveAppOutput.Ok == nil {
 			break
 		}
 
-		return e.complexity.ShellConfiguration.Categories(childComplexity), true
+		return e.complexity.KeepAliveAppOutput.Ok(childComplexity), true
 
-	case "ShellConfiguration.defaultRoute":
-		if e.complexity.ShellConfiguration.DefaultRoute == nil {
+	case "KeepAliveAppOutput.registrationRequired":
+		if e.complexity.KeepAliveAppOutput.RegistrationRequired == nil {
 			break
 		}
 
-		return e.complexity.ShellConfiguration.DefaultRoute(childComplexity), true
+		return e.complexity.KeepAliveAppOutput.RegistrationRequired(childComplexity), true
 
-	case "ShellConfiguration.slots":
-		if e.complexity.ShellConfiguration.Slots == nil {
+	case "MaintenanceWindow.app":
+		if e.complexity.MaintenanceWindow.App == nil {
 			break
 		}
 
-		return e.complexity.ShellConfiguration.Slots(childComplexity), true
+		return e.complexity.MaintenanceWindow.App(childComplexity), true
 
-	case "ShellConfigurationSubscription.configuration":
-		if e.complexity.ShellConfigurationSubscription.Configuration == nil {
+	case "MaintenanceWindow.message":
+		if e.complexity.MaintenanceWindow.Message == nil {
 			break
 		}
 
-		return e.complexity.ShellConfigurationSubscription.Configuration(childComplexity), true
+		return e.complexity.MaintenanceWindow.Message(childComplexity), true
 
-	case "ShellConfigurationSubscription.eventType":
-		if e.complexity.ShellConfigurationSubscription.EventType == nil {
+	case "MaintenanceWindow.startedAt":
+		if e.complexity.MaintenanceWindow.StartedAt == nil {
 			break
 		}
 
-		return e.complexity.ShellConfigurationSubscription.EventType(childComplexity), true
+		return e.complexity.MaintenanceWindow.StartedAt(childComplexity), true
 
-	case "ShellNavigation.authRequired":
-		if e.complexity.ShellNavigation.AuthRequired == nil {
+	case "MaintenanceWindow.until":
+		if e.complexity.MaintenanceWindow.Until == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigation.AuthRequired(childComplexity), true
+		return e.complexity.MaintenanceWindow.Until(childComplexity), true
 
-	case "ShellNavigation.children":
-		if e.complexity.ShellNavigation.Children == nil {
+	case "Mutation.clearRecentApps":
+		if e.complexity.Mutation.ClearRecentApps == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigation.Children(childComplexity), true
+		return e.complexity.Mutation.ClearRecentApps(childComplexity), true
 
-	case "ShellNavigation.healthy":
-		if e.complexity.ShellNavigation.Healthy == nil {
+	case "Mutation.setDefaultRoute":
+		if e.complexity.Mutation.SetDefaultRoute == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigation.Healthy(childComplexity), true
-
-	case "ShellNavigation.hidden":
-		if e.complexity.ShellNavigation.Hidden == nil {
-			break
+		args, err := ec.field_Mutation_setDefaultRoute_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.ShellNavigation.Hidden(childComplexity), true
+		return e.complexity.Mutation.SetDefaultRoute(childComplexity, args["route"].(*string)), true
 
-	case "ShellNavigation.id":
-		if e.complexity.ShellNavigation.ID == nil {
+	case "Mutation.setNavigationHidden":
+		if e.complexity.Mutation.SetNavigationHidden == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigation.ID(childComplexity), true
-
-	case "ShellNavigation.icon":
-		if e.complexity.ShellNavigation.Icon == nil {
-			break
+		args, err := ec.field_Mutation_setNavigationHidden_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.ShellNavigation.Icon(childComplexity), true
+		return e.complexity.Mutation.SetNavigationHidden(childComplexity, args["id"].(string), args["hidden"].(bool)), true
 
-	case "ShellNavigation.module":
-		if e.complexity.ShellNavigation.Module == nil {
+	case "Mutation.setNavigationPinned":
+		if e.complexity.Mutation.SetNavigationPinned == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigation.Module(childComplexity), true
-
-	case "ShellNavigation.subTitle":
-		if e.complexity.ShellNavigation.SubTitle == nil {
-			break
+		args, err := ec.field_Mutation_setNavigationPinned_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.ShellNavigation.SubTitle(childComplexity), true
+		return e.complexity.Mutation.SetNavigationPinned(childComplexity, args["id"].(string), args["pinned"].(bool)), true
 
-	case "ShellNavigation.title":
-		if e.complexity.ShellNavigation.Title == nil {
+	case "NavigationOverride.category":
+		if e.complexity.NavigationOverride.Category == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigation.Title(childComplexity), true
+		return e.complexity.NavigationOverride.Category(childComplexity), true
 
-	case "ShellNavigationCategory.category":
-		if e.complexity.ShellNavigationCategory.Category == nil {
+	case "NavigationOverride.hidden":
+		if e.complexity.NavigationOverride.Hidden == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationCategory.Category(childComplexity), true
+		return e.complexity.NavigationOverride.Hidden(childComplexity), true
 
-	case "ShellNavigationCategory.entries":
-		if e.complexity.ShellNavigationCategory.Entries == nil {
+	case "NavigationOverride.id":
+		if e.complexity.NavigationOverride.ID == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationCategory.Entries(childComplexity), true
+		return e.complexity.NavigationOverride.ID(childComplexity), true
 
-	case "ShellNavigationCategory.priority":
-		if e.complexity.ShellNavigationCategory.Priority == nil {
+	case "NavigationOverride.icon":
+		if e.complexity.NavigationOverride.Icon == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationCategory.Priority(childComplexity), true
+		return e.complexity.NavigationOverride.Icon(childComplexity), true
 
-	case "ShellNavigationCategory.title":
-		if e.complexity.ShellNavigationCategory.Title == nil {
+	case "NavigationOverride.order":
+		if e.complexity.NavigationOverride.Order == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationCategory.Title(childComplexity), true
+		return e.complexity.NavigationOverride.Order(childComplexity), true
 
-	case "ShellNavigationChild.authRequired":
-		if e.complexity.ShellNavigationChild.AuthRequired == nil {
+	case "NavigationOverride.subTitle":
+		if e.complexity.NavigationOverride.SubTitle == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationChild.AuthRequired(childComplexity), true
+		return e.complexity.NavigationOverride.SubTitle(childComplexity), true
 
-	case "ShellNavigationChild.children":
-		if e.complexity.ShellNavigationChild.Children == nil {
+	case "NavigationOverride.title":
+		if e.complexity.NavigationOverride.Title == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationChild.Children(childComplexity), true
+		return e.complexity.NavigationOverride.Title(childComplexity), true
 
-	case "ShellNavigationChild.healthy":
-		if e.complexity.ShellNavigationChild.Healthy == nil {
+	case "NavigationOverride.updatedAt":
+		if e.complexity.NavigationOverride.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationChild.Healthy(childComplexity), true
+		return e.complexity.NavigationOverride.UpdatedAt(childComplexity), true
 
-	case "ShellNavigationChild.icon":
-		if e.complexity.ShellNavigationChild.Icon == nil {
+	case "NavigationSearchResult.app":
+		if e.complexity.NavigationSearchResult.App == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationChild.Icon(childComplexity), true
+		return e.complexity.NavigationSearchResult.App(childComplexity), true
 
-	case "ShellNavigationChild.module":
-		if e.complexity.ShellNavigationChild.Module == nil {
+	case "NavigationSearchResult.breadcrumbs":
+		if e.complexity.NavigationSearchResult.Breadcrumbs == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationChild.Module(childComplexity), true
+		return e.complexity.NavigationSearchResult.Breadcrumbs(childComplexity), true
 
-	case "ShellNavigationChild.subTitle":
-		if e.complexity.ShellNavigationChild.SubTitle == nil {
+	case "NavigationSearchResult.category":
+		if e.complexity.NavigationSearchResult.Category == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationChild.SubTitle(childComplexity), true
+		return e.complexity.NavigationSearchResult.Category(childComplexity), true
 
-	case "ShellNavigationChild.title":
-		if e.complexity.ShellNavigationChild.Title == nil {
+	case "NavigationSearchResult.id":
+		if e.complexity.NavigationSearchResult.ID == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationChild.Title(childComplexity), true
+		return e.complexity.NavigationSearchResult.ID(childComplexity), true
 
-	case "ShellNavigationModule.exposedModule":
-		if e.complexity.ShellNavigationModule.ExposedModule == nil {
+	case "NavigationSearchResult.icon":
+		if e.complexity.NavigationSearchResult.Icon == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationModule.ExposedModule(childComplexity), true
+		return e.complexity.NavigationSearchResult.Icon(childComplexity), true
 
-	case "ShellNavigationModule.moduleName":
-		if e.complexity.ShellNavigationModule.ModuleName == nil {
+	case "NavigationSearchResult.path":
+		if e.complexity.NavigationSearchResult.Path == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationModule.ModuleName(childComplexity), true
+		return e.complexity.NavigationSearchResult.Path(childComplexity), true
 
-	case "ShellNavigationModule.outlet":
-		if e.complexity.ShellNavigationModule.Outlet == nil {
+	case "NavigationSearchResult.score":
+		if e.complexity.NavigationSearchResult.Score == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationModule.Outlet(childComplexity), true
+		return e.complexity.NavigationSearchResult.Score(childComplexity), true
 
-	case "ShellNavigationModule.path":
-		if e.complexity.ShellNavigationModule.Path == nil {
+	case "NavigationSearchResult.state":
+		if e.complexity.NavigationSearchResult.State == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationModule.Path(childComplexity), true
+		return e.complexity.NavigationSearchResult.State(childComplexity), true
 
-	case "ShellNavigationModule.remoteEntry":
-		if e.complexity.ShellNavigationModule.RemoteEntry == nil {
+	case "NavigationSearchResult.subTitle":
+		if e.complexity.NavigationSearchResult.SubTitle == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationModule.RemoteEntry(childComplexity), true
+		return e.complexity.NavigationSearchResult.SubTitle(childComplexity), true
 
-	case "ShellNavigationSlot.authRequired":
-		if e.complexity.ShellNavigationSlot.AuthRequired == nil {
+	case "NavigationSearchResult.title":
+		if e.complexity.NavigationSearchResult.Title == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationSlot.AuthRequired(childComplexity), true
+		return e.complexity.NavigationSearchResult.Title(childComplexity), true
 
-	case "ShellNavigationSlot.description":
-		if e.complexity.ShellNavigationSlot.Description == nil {
+	case "PageInfo.next":
+		if e.complexity.PageInfo.Next == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationSlot.Description(childComplexity), true
+		return e.complexity.PageInfo.Next(childComplexity), true
 
-	case "ShellNavigationSlot.module":
-		if e.complexity.ShellNavigationSlot.Module == nil {
+	case "PageInfo.page":
+		if e.complexity.PageInfo.Page == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationSlot.Module(childComplexity), true
+		return e.complexity.PageInfo.Page(childComplexity), true
 
-	case "ShellNavigationSlot.priority":
-		if e.complexity.ShellNavigationSlot.Priority == nil {
+	case "PageInfo.perPage":
+		if e.complexity.PageInfo.PerPage == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationSlot.Priority(childComplexity), true
+		return e.complexity.PageInfo.PerPage(childComplexity), true
 
-	case "ShellNavigationSlot.slot":
-		if e.complexity.ShellNavigationSlot.Slot == nil {
+	case "PageInfo.prev":
+		if e.complexity.PageInfo.Prev == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationSlot.Slot(childComplexity), true
+		return e.complexity.PageInfo.Prev(childComplexity), true
 
-	case "ShellNavigationSlotModule.exposedModule":
-		if e.complexity.ShellNavigationSlotModule.ExposedModule == nil {
+	case "PageInfo.total":
+		if e.complexity.PageInfo.Total == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationSlotModule.ExposedModule(childComplexity), true
+		return e.complexity.PageInfo.Total(childComplexity), true
 
-	case "ShellNavigationSlotModule.moduleName":
-		if e.complexity.ShellNavigationSlotModule.ModuleName == nil {
+	case "PageInfo.totalPage":
+		if e.complexity.PageInfo.TotalPage == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationSlotModule.ModuleName(childComplexity), true
+		return e.complexity.PageInfo.TotalPage(childComplexity), true
 
-	case "ShellNavigationSlotModule.path":
-		if e.complexity.ShellNavigationSlotModule.Path == nil {
+	case "Query.registeredApps":
+		if e.complexity.Query.RegisteredApps == nil {
 			break
 		}
 
-		return e.complexity.ShellNavigationSlotModule.Path(childComplexity), true
-
-	case "ShellNavigationSlotModule.remoteEntry":
-		if e.complexity.ShellNavigationSlotModule.RemoteEntry == nil {
-			break
+		args, err := ec.field_Query_registeredApps_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.ShellNavigationSlotModule.RemoteEntry(childComplexity), true
+		return e.complexity.Query.RegisteredApps(childComplexity, args["page"].(genericdb.Page), args["where"].(*model.RegisteredAppsWhereRules), args["sort"].(*model.RegisteredAppsSort)), true
 
-	case "Subscription.shellConfiguration":
-		if e.complexity.Subscription.ShellConfiguration == nil {
+	case "Query.searchNavigation":
+		if e.complexity.Query.SearchNavigation == nil {
 			break
 		}
 
-		args, err := ec.field_Subscription_shellConfiguration_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_searchNavigation_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Subscription.ShellConfiguration(childComplexity, args["tenantId"].(string), args["events"].([]model.ShellConfigEventType)), true
+		return e.complexity.Query.SearchNavigation(childComplexity, args["tenantId"].(string), args["query"].(string), args["limit"].(*int), args["includeUnavailable"].(*bool)), true
 
-	case "TagValue.Value":
-		if e.complexity.TagValue.Value == nil {
+	case "Query.shellConfiguration":
+		if e.complexity.Query.ShellConfiguration == nil {
 			break
 		}
 
-		return e.complexity.TagValue.Value(childComplexity), true
+		args, err := ec.field_Query_shellConfiguration_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "TagValues.Key":
-		if e.complexity.TagValues.Key == nil {
+		return e.complexity.Query.ShellConfiguration(childComplexity, args["tenantId"].(string), args["locale"].(*string), args["sinceVersion"].(*int), args["sinceHash"].(*string)), true
+
+	case "Query.userPreferences":
+		if e.complexity.Query.UserPreferences == nil {
 			break
 		}
 
-		return e.complexity.TagValues.Key(childComplexity), true
+		return e.complexity.Query.UserPreferences(childComplexity), true
 
-	case "TagValues.Values":
-		if e.complexity.TagValues.Values == nil {
+	case "RecentApp.app":
+		if e.complexity.RecentApp.App == nil {
 			break
 		}
 
-		return e.complexity.TagValues.Values(childComplexity), true
+		return e.complexity.RecentApp.App(childComplexity), true
 
-	}
-	return 0, false
-}
+	case "RecentApp.visitedAt":
+		if e.complexity.RecentApp.VisitedAt == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputKeepAliveAppInput,
-		ec.unmarshalInputPage,
-		ec.unmarshalInputQueryOperatorAndDate,
-		ec.unmarshalInputQueryOperatorAndValue,
-		ec.unmarshalInputQueryOperatorFieldAndValue,
-		ec.unmarshalInputQueryValue,
-		ec.unmarshalInputRegisterAppInput,
-		ec.unmarshalInputRegisterAppModule,
-		ec.unmarshalInputRegisterAppNavigationInput,
-		ec.unmarshalInputRegisterAppSlot,
-		ec.unmarshalInputRegisterAppSlotModule,
-		ec.unmarshalInputRegisterChildAppNavigationInput,
-		ec.unmarshalInputRegisteredAppQueryFields,
-		ec.unmarshalInputRegisteredAppsSort,
-		ec.unmarshalInputRegisteredAppsWhereRules,
-		ec.unmarshalInputSort,
-	)
-	first := true
+		return e.complexity.RecentApp.VisitedAt(childComplexity), true
 
-	switch rc.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, rc.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+	case "RegisterAppOutput.id":
+		if e.complexity.RegisterAppOutput.ID == nil {
+			break
+		}
 
-			return &response
+		return e.complexity.RegisterAppOutput.ID(childComplexity), true
+
+	case "RegisteredApp.createdAt":
+		if e.complexity.RegisteredApp.CreatedAt == nil {
+			break
 		}
-	case ast.Subscription:
-		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
 
-		var buf bytes.Buffer
-		return func(ctx context.Context) *graphql.Response {
-			buf.Reset()
-			data := next(ctx)
+		return e.complexity.RegisteredApp.CreatedAt(childComplexity), true
 
-			if data == nil {
-				return nil
-			}
-			data.MarshalGQL(&buf)
+	case "RegisteredApp.name":
+		if e.complexity.RegisteredApp.Name == nil {
+			break
+		}
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.RegisteredApp.Name(childComplexity), true
+
+	case "RegisteredApp.pkg":
+		if e.complexity.RegisteredApp.Pkg == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.RegisteredApp.Pkg(childComplexity), true
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-	deferred        int32
-	pendingDeferred int32
-	deferredResults chan graphql.DeferredResult
-}
+	case "RegisteredApp.updatedAt":
+		if e.complexity.RegisteredApp.UpdatedAt == nil {
+			break
+		}
 
-func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
-	atomic.AddInt32(&ec.pendingDeferred, 1)
-	go func() {
-		ctx := graphql.WithFreshResponseContext(dg.Context)
-		dg.FieldSet.Dispatch(ctx)
-		ds := graphql.DeferredResult{
-			Path:   dg.Path,
-			Label:  dg.Label,
-			Result: dg.FieldSet,
-			Errors: graphql.GetErrors(ctx),
+		return e.complexity.RegisteredApp.UpdatedAt(childComplexity), true
+
+	case "RegisteredAppsPage.data":
+		if e.complexity.RegisteredAppsPage.Data == nil {
+			break
 		}
-		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
-			ds.Result = graphql.Null
+
+		return e.complexity.RegisteredAppsPage.Data(childComplexity), true
+
+	case "RegisteredAppsPage.page":
+		if e.complexity.RegisteredAppsPage.Page == nil {
+			break
 		}
-		ec.deferredResults <- ds
-	}()
-}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(ec.Schema()), nil
-}
+		return e.complexity.RegisteredAppsPage.Page(childComplexity), true
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
-}
+	case "ShellConfiguration.baseVersion":
+		if e.complexity.ShellConfiguration.BaseVersion == nil {
+			break
+		}
 
-var sources = []*ast.Source{
-	{Name: "../../schema/types/app.types.graphqls", Input: `#********************************************************************************************
-# ENUMS
-#********************************************************************************************
+		return e.complexity.ShellConfiguration.BaseVersion(childComplexity), true
 
-enum RefRoot {
-    AppRef
-}
+	case "ShellConfiguration.categories":
+		if e.complexity.ShellConfiguration.Categories == nil {
+			break
+		}
 
-enum ShellConfigEventType {
-    Initial
-    Updated
-    Removed
-    Added
-    Rebuild
-}
+		return e.complexity.ShellConfiguration.Categories(childComplexity), true
 
-#********************************************************************************************
-# APP REGISTRATION
-#********************************************************************************************
+	case "ShellConfiguration.defaultRoute":
+		if e.complexity.ShellConfiguration.DefaultRoute == nil {
+			break
+		}
 
-enum RegisterAppCategory {
-    App
-    Setting
-    Dashboard
-}
+		return e.complexity.ShellConfiguration.DefaultRoute(childComplexity), true
 
-input RegisterAppInput {
-    name: String!
-    id: String!
-    package: String!
-    version: String!
-    remoteEntryFile: String!
-    proxy: Boolean!
-    webUrl: String!
-    apiUrl: String!
-    navigation: [RegisterAppNavigationInput]
-    slot1: RegisterAppSlot
-    slot2: RegisterAppSlot
-    slot3: RegisterAppSlot
-}
+	case "ShellConfiguration.hash":
+		if e.complexity.ShellConfiguration.Hash == nil {
+			break
+		}
 
-input KeepAliveAppInput {
-    pkg: String!,
-    version: String!
-    name: String!
-}
+		return e.complexity.ShellConfiguration.Hash(childComplexity), true
 
-input RegisterAppNavigationInput {
-    title: String!
-    subTitle: String!
-    authRequired: Boolean!
-    hidden: Boolean!
-    category: RegisterAppCategory!
-    children: [RegisterChildAppNavigationInput]
-    proxy: Boolean!
-    icon: String!
-    module: RegisterAppModule!
-}
+	case "ShellConfiguration.locale":
+		if e.complexity.ShellConfiguration.Locale == nil {
+			break
+		}
 
-input RegisterAppModule {
-    path: String!
-    exposedModule: String!
-    moduleName: String!
-    outlet: String!
-}
+		return e.complexity.ShellConfiguration.Locale(childComplexity), true
 
-input RegisterAppSlotModule {
-    path: String!
-    exposedModule: String!
-    moduleName: String!
-}
+	case "ShellConfiguration.locales":
+		if e.complexity.ShellConfiguration.Locales == nil {
+			break
+		}
 
-input RegisterAppSlot {
-    description: String!
-    authRequired: Boolean!
-    module: RegisterAppSlotModule!
-}
+		return e.complexity.ShellConfiguration.Locales(childComplexity), true
 
-input RegisterChildAppNavigationInput {
-    title: String!
-    subTitle: String!
-    authRequired: Boolean!
-    path: String!
-    children: [RegisterChildAppNavigationInput]
-    icon: String!
-    module: RegisterAppModule!
-}
+	case "ShellConfiguration.maintenance":
+		if e.complexity.ShellConfiguration.Maintenance == nil {
+			break
+		}
 
-type RegisterAppOutput {
-    id: String!
-}
+		return e.complexity.ShellConfiguration.Maintenance(childComplexity), true
 
-type KeepAliveAppOutput {
-    registrationRequired: Boolean!
-    ok: Boolean!
-}
+	case "ShellConfiguration.patch":
+		if e.complexity.ShellConfiguration.Patch == nil {
+			break
+		}
 
-#********************************************************************************************
-# SHELL NAVIGATION
-#********************************************************************************************
+		return e.complexity.ShellConfiguration.Patch(childComplexity), true
 
-type RegisteredApp {
-    pkg: String! @ref(field: "package")
-    name: String @ref(field: "name")
-    createdAt: Time @ref(field: "created_at")
-    updatedAt: Time @ref(field: "updated_at")
-}
+	case "ShellConfiguration.slots":
+		if e.complexity.ShellConfiguration.Slots == nil {
+			break
+		}
 
-type RegisteredAppsPage {
-    data: [RegisteredApp] @refRoot(id: AppRef)
-    page: PageInfo!
-}
+		return e.complexity.ShellConfiguration.Slots(childComplexity), true
 
-type ShellNavigation {
-    id: String! @ref(field: "_id")
-    title: String! @ref(field: "title")
-    subTitle: String! @ref(field: "subTitle")
-    authRequired: Boolean! @ref(field: "authRequired")
-    children: [ShellNavigationChild] @ref(field: "children")
-    healthy: Boolean! @ref(field: "available")
-    module: ShellNavigationModule! @ref(field: "module")
-    icon: String! @ref(field: "icon")
-    hidden: Boolean! @ref(field: "hidden")
-}
+	case "ShellConfiguration.version":
+		if e.complexity.ShellConfiguration.Version == nil {
+			break
+		}
 
-type ShellNavigationChild {
-    title: String! @ref(field: "title")
-    subTitle: String! @ref(field: "subTitle")
-    module: ShellNavigationModule! @ref(field: "module")
-    authRequired: Boolean! @ref(field: "authRequired")
-    children: [ShellNavigationChild] @ref(field: "children")
-    healthy: Boolean! @ref(field: "available")
-    icon: String! @ref(field: "icon")
-}
+		return e.complexity.ShellConfiguration.Version(childComplexity), true
 
-type ShellNavigationSlot {
-    priority: Int
-    slot: String! @ref(field: "slot")
-    description: String! @ref(field: "description")
-    authRequired: Boolean @ref(field: "authRequired")
-    module: ShellNavigationSlotModule! @ref(field: "module")
-}
+	case "ShellConfigurationPatchOperation.op":
+		if e.complexity.ShellConfigurationPatchOperation.Op == nil {
+			break
+		}
 
-type ShellNavigationModule {
-    path: String! @ref(field: "path")
-    remoteEntry: String! @ref(field: "remoteEntry")
-    exposedModule: String! @ref(field: "exposedModule")
-    moduleName: String! @ref(field: "moduleName")
-    outlet: String! @ref(field: "outlet")
-}
+		return e.complexity.ShellConfigurationPatchOperation.Op(childComplexity), true
 
-type ShellNavigationSlotModule {
-    path: String! @ref(field: "path")
-    remoteEntry: String! @ref(field: "remoteEntry")
-    exposedModule: String! @ref(field: "exposedModule")
-    moduleName: String! @ref(field: "moduleName")
-}
+	case "ShellConfigurationPatchOperation.path":
+		if e.complexity.ShellConfigurationPatchOperation.Path == nil {
+			break
+		}
 
-type ShellNavigationCategory {
-    title: String!
-    priority: Int!
-    category: RegisterAppCategory!
-    entries: [ShellNavigation]
-}
+		return e.complexity.ShellConfigurationPatchOperation.Path(childComplexity), true
 
-type ShellConfiguration {
-    defaultRoute: String
-    categories: [ShellNavigationCategory]
-    slots: [ShellNavigationSlot]
-}
+	case "ShellConfigurationPatchOperation.value":
+		if e.complexity.ShellConfigurationPatchOperation.Value == nil {
+			break
+		}
 
-type ShellConfigurationSubscription {
-    configuration: ShellConfiguration!
-    eventType: ShellConfigEventType!
-}
+		return e.complexity.ShellConfigurationPatchOperation.Value(childComplexity), true
 
-input RegisteredAppsWhereRules {
-    condition: QueryCondition! @queryCondition
-    fields: [RegisteredAppQueryFields] @queryOp
-    rules: [RegisteredAppsWhereRules] @queryRules
-}
+	case "ShellConfigurationSubscription.configuration":
+		if e.complexity.ShellConfigurationSubscription.Configuration == nil {
+			break
+		}
 
-input RegisteredAppQueryFields {
-    createdAt: QueryOperatorAndDate @ref(field: "created_at") @queryType(type: Date)
-    updatedAt: QueryOperatorAndDate @ref(field: "updated_at") @queryType(type: Date)
-    name: QueryOperatorAndValue @ref(field: "name")
-}
+		return e.complexity.ShellConfigurationSubscription.Configuration(childComplexity), true
 
-input RegisteredAppsSort {
-    createdAt: SortType @ref(field: "created_at") @queryType(type: Date)
-    updatedAt: SortType @ref(field: "updated_at") @queryType(type: Date)
-    name: SortType @ref(field: "name")
-}
-`, BuiltIn: false},
-	{Name: "../../schema/types/common.graphqls", Input: `#********************************************************************************************
-# DIRECTIVES
-#********************************************************************************************
+	case "ShellConfigurationSubscription.eventType":
+		if e.complexity.ShellConfigurationSubscription.EventType == nil {
+			break
+		}
 
-enum QueryType {
-    Date
-    Deleted
-}
+		return e.complexity.ShellConfigurationSubscription.EventType(childComplexity), true
 
-directive @ref(
-    field: String,
-    dependencies: [String]
-) on INPUT_FIELD_DEFINITION | FIELD_DEFINITION
+	case "ShellNavigation.authRequired":
+		if e.complexity.ShellNavigation.AuthRequired == nil {
+			break
+		}
 
-directive @warden(
-    resource: String!
-    action: String!
-    resourceKey: String
-    filterField: String
-) on FIELD_DEFINITION
+		return e.complexity.ShellNavigation.AuthRequired(childComplexity), true
 
-directive @refRoot(
-    id: RefRoot!
-) on FIELD_DEFINITION
+	case "ShellNavigation.children":
+		if e.complexity.ShellNavigation.Children == nil {
+			break
+		}
 
-directive @queryFields on INPUT_FIELD_DEFINITION
-directive @queryCondition on INPUT_FIELD_DEFINITION
-directive @nestedQueries on INPUT_FIELD_DEFINITION
-directive @queryOp on INPUT_FIELD_DEFINITION
-directive @queryValue on INPUT_FIELD_DEFINITION
-directive @queryField on INPUT_FIELD_DEFINITION
-directive @queryFieldExists on INPUT_FIELD_DEFINITION
-directive @queryRules on INPUT_FIELD_DEFINITION
-directive @query on INPUT_FIELD_DEFINITION
-directive @queryType(type: QueryType!) on INPUT_FIELD_DEFINITION
-directive @goField(forceResolver: String, name: String) on FIELD_DEFINITION | INPUT_FIELD_DEFINITION
-directive @validation(constraint: String!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+		return e.complexity.ShellNavigation.Children(childComplexity), true
 
-#********************************************************************************************
-# CUSTOM SCALARS
-#********************************************************************************************
+	case "ShellNavigation.healthy":
+		if e.complexity.ShellNavigation.Healthy == nil {
+			break
+		}
 
-scalar Time
-scalar Duration
-scalar Any
+		return e.complexity.ShellNavigation.Healthy(childComplexity), true
 
-#********************************************************************************************
-# TAGS
-#********************************************************************************************
+	case "ShellNavigation.hidden":
+		if e.complexity.ShellNavigation.Hidden == nil {
+			break
+		}
 
-type TagValue {
-    Value: Any! @ref(field: "value")
-}
+		return e.complexity.ShellNavigation.Hidden(childComplexity), true
 
-type TagValues {
-    Key: String! @ref(field: "key")
-    Values: [TagValue] @ref(field: "values")
-}
+	case "ShellNavigation.id":
+		if e.complexity.ShellNavigation.ID == nil {
+			break
+		}
 
-#********************************************************************************************
-# PAGINATION
-#********************************************************************************************
+		return e.complexity.ShellNavigation.ID(childComplexity), true
 
-input Page {
-    limit: Int @ref(field: "limit")
-    page: Int @ref(field: "page")
-}
+	case "ShellNavigation.icon":
+		if e.complexity.ShellNavigation.Icon == nil {
+			break
+		}
 
-type PageInfo {
-    total: Int!
-    next: Int!
-    prev: Int!
-    page: Int!
-    perPage: Int!
-    totalPage: Int!
-}
+		return e.complexity.ShellNavigation.Icon(childComplexity), true
 
+	case "ShellNavigation.maintenance":
+		if e.complexity.ShellNavigation.Maintenance == nil {
+			break
+		}
 
-#********************************************************************************************
-# SORTING
-#********************************************************************************************
+		return e.complexity.ShellNavigation.Maintenance(childComplexity), true
 
-input Sort {
-    key: String!
-    type: SortType!
-}
+	case "ShellNavigation.module":
+		if e.complexity.ShellNavigation.Module == nil {
+			break
+		}
 
-enum SortType {
-    ASC,
-    DES
-}
+		return e.complexity.ShellNavigation.Module(childComplexity), true
 
-#********************************************************************************************
-# QUERY CONDITIONAL TYPES
-#********************************************************************************************
+	case "ShellNavigation.state":
+		if e.complexity.ShellNavigation.State == nil {
+			break
+		}
 
-enum QueryCondition {
-    And
-    Or
-}
+		return e.complexity.ShellNavigation.State(childComplexity), true
 
-input QueryValue {
-    value: Any @queryValue
-}
+	case "ShellNavigation.subTitle":
+		if e.complexity.ShellNavigation.SubTitle == nil {
+			break
+		}
 
-input QueryOperatorAndValue {
-    op: QueryOperators! @queryOp
-    value: Any @queryValue # @validation(constraint: "required,max=5")
-}
+		return e.complexity.ShellNavigation.SubTitle(childComplexity), true
 
-input QueryOperatorAndDate {
-    op: QueryOperators! @queryOp
-    value: Time @queryValue # @validation(constraint: "required,max=5")
-}
+	case "ShellNavigation.title":
+		if e.complexity.ShellNavigation.Title == nil {
+			break
+		}
 
-input QueryOperatorFieldAndValue {
-    field: String! @queryField
-    op: QueryOperators! @queryOp
-    value: Any @queryValue
-    exists: Boolean @queryFieldExists
-}
+		return e.complexity.ShellNavigation.Title(childComplexity), true
 
-enum QueryOperators {
-    Equal
-    NotEqual
-    Contains
-    In
-    NotIn
-    GreaterThan
-    LessThan
-    LessThanOrEqual
-    GreaterThanOrEqual
-    Regex
-}
-`, BuiltIn: false},
-	{Name: "../../schema/public/app.query.graphqls", Input: `extend type Query {
-    registeredApps(page: Page!, where: RegisteredAppsWhereRules, sort: RegisteredAppsSort): RegisteredAppsPage
-    shellConfiguration(tenantId: String!): ShellConfiguration!
-}
-`, BuiltIn: false},
-	{Name: "../../schema/public/app.subscription.graphqls", Input: `extend type Subscription {
-    shellConfiguration(tenantId: String!, events: [ShellConfigEventType!]!): ShellConfigurationSubscription!
-}
-`, BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
-
-// endregion ************************** generated!.gotpl **************************
+	case "ShellNavigationCategory.category":
+		if e.complexity.ShellNavigationCategory.Category == nil {
+			break
+		}
 
-// region    ***************************** args.gotpl *****************************
+		return e.complexity.ShellNavigationCategory.Category(childComplexity), true
 
-func (ec *executionContext) dir_validation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["constraint"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraint"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+	case "ShellNavigationCategory.entries":
+		if e.complexity.ShellNavigationCategory.Entries == nil {
+			break
 		}
-	}
-	args["constraint"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) dir_warden_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["resource"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("resource"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ShellNavigationCategory.Entries(childComplexity), true
+
+	case "ShellNavigationCategory.hidden":
+		if e.complexity.ShellNavigationCategory.Hidden == nil {
+			break
 		}
-	}
-	args["resource"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["action"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("action"))
-		arg1, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.ShellNavigationCategory.Hidden(childComplexity), true
+
+	case "ShellNavigationCategory.icon":
+		if e.complexity.ShellNavigationCategory.Icon == nil {
+			break
 		}
-	}
-	args["action"] = arg1
-	var arg2 *string
-	if tmp, ok := rawArgs["resourceKey"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("resourceKey"))
-		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.ShellNavigationCategory.Icon(childComplexity), true
+
+	case "ShellNavigationCategory.priority":
+		if e.complexity.ShellNavigationCategory.Priority == nil {
+			break
 		}
-	}
-	args["resourceKey"] = arg2
-	var arg3 *string
-	if tmp, ok := rawArgs["filterField"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filterField"))
-		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.ShellNavigationCategory.Priority(childComplexity), true
+
+	case "ShellNavigationCategory.title":
+		if e.complexity.ShellNavigationCategory.Title == nil {
+			break
 		}
-	}
-	args["filterField"] = arg3
-	return args, nil
-}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ShellNavigationCategory.Title(childComplexity), true
+
+	case "ShellNavigationChild.authRequired":
+		if e.complexity.ShellNavigationChild.AuthRequired == nil {
+			break
 		}
-	}
-	args["name"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_registeredApps_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 genericdb.Page
-	if tmp, ok := rawArgs["page"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
-		arg0, err = ec.unmarshalNPage2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPage(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ShellNavigationChild.AuthRequired(childComplexity), true
+
+	case "ShellNavigationChild.children":
+		if e.complexity.ShellNavigationChild.Children == nil {
+			break
 		}
-	}
-	args["page"] = arg0
-	var arg1 *model.RegisteredAppsWhereRules
-	if tmp, ok := rawArgs["where"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
-		arg1, err = ec.unmarshalORegisteredAppsWhereRules2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppsWhereRules(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.ShellNavigationChild.Children(childComplexity), true
+
+	case "ShellNavigationChild.healthy":
+		if e.complexity.ShellNavigationChild.Healthy == nil {
+			break
 		}
-	}
-	args["where"] = arg1
-	var arg2 *model.RegisteredAppsSort
-	if tmp, ok := rawArgs["sort"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
-		arg2, err = ec.unmarshalORegisteredAppsSort2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppsSort(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.ShellNavigationChild.Healthy(childComplexity), true
+
+	case "ShellNavigationChild.hidden":
+		if e.complexity.ShellNavigationChild.Hidden == nil {
+			break
 		}
-	}
-	args["sort"] = arg2
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_shellConfiguration_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["tenantId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tenantId"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ShellNavigationChild.Hidden(childComplexity), true
+
+	case "ShellNavigationChild.icon":
+		if e.complexity.ShellNavigationChild.Icon == nil {
+			break
 		}
-	}
-	args["tenantId"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Subscription_shellConfiguration_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["tenantId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tenantId"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ShellNavigationChild.Icon(childComplexity), true
+
+	case "ShellNavigationChild.module":
+		if e.complexity.ShellNavigationChild.Module == nil {
+			break
 		}
-	}
-	args["tenantId"] = arg0
-	var arg1 []model.ShellConfigEventType
-	if tmp, ok := rawArgs["events"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("events"))
-		arg1, err = ec.unmarshalNShellConfigEventType2ᚕgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigEventTypeᚄ(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.ShellNavigationChild.Module(childComplexity), true
+
+	case "ShellNavigationChild.state":
+		if e.complexity.ShellNavigationChild.State == nil {
+			break
 		}
-	}
-	args["events"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ShellNavigationChild.State(childComplexity), true
+
+	case "ShellNavigationChild.subTitle":
+		if e.complexity.ShellNavigationChild.SubTitle == nil {
+			break
 		}
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ShellNavigationChild.SubTitle(childComplexity), true
+
+	case "ShellNavigationChild.title":
+		if e.complexity.ShellNavigationChild.Title == nil {
+			break
 		}
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.complexity.ShellNavigationChild.Title(childComplexity), true
 
-// region    ************************** directives.gotpl **************************
+	case "ShellNavigationModule.exposedModule":
+		if e.complexity.ShellNavigationModule.ExposedModule == nil {
+			break
+		}
 
-// endregion ************************** directives.gotpl **************************
+		return e.complexity.ShellNavigationModule.ExposedModule(childComplexity), true
 
-// region    **************************** field.gotpl *****************************
+	case "ShellNavigationModule.format":
+		if e.complexity.ShellNavigationModule.Format == nil {
+			break
+		}
 
-func (ec *executionContext) _KeepAliveAppOutput_registrationRequired(ctx context.Context, field graphql.CollectedField, obj *model.KeepAliveAppOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_KeepAliveAppOutput_registrationRequired(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.RegistrationRequired, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		return e.complexity.ShellNavigationModule.Format(childComplexity), true
+
+	case "ShellNavigationModule.moduleName":
+		if e.complexity.ShellNavigationModule.ModuleName == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_KeepAliveAppOutput_registrationRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "KeepAliveAppOutput",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShellNavigationModule.ModuleName(childComplexity), true
 
-func (ec *executionContext) _KeepAliveAppOutput_ok(ctx context.Context, field graphql.CollectedField, obj *model.KeepAliveAppOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_KeepAliveAppOutput_ok(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShellNavigationModule.outlet":
+		if e.complexity.ShellNavigationModule.Outlet == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Ok, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ShellNavigationModule.Outlet(childComplexity), true
+
+	case "ShellNavigationModule.path":
+		if e.complexity.ShellNavigationModule.Path == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_KeepAliveAppOutput_ok(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "KeepAliveAppOutput",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShellNavigationModule.Path(childComplexity), true
 
-func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_total(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShellNavigationModule.remoteEntry":
+		if e.complexity.ShellNavigationModule.RemoteEntry == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ShellNavigationModule.RemoteEntry(childComplexity), true
+
+	case "ShellNavigationSlot.app":
+		if e.complexity.ShellNavigationSlot.App == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_PageInfo_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShellNavigationSlot.App(childComplexity), true
 
-func (ec *executionContext) _PageInfo_next(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_next(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShellNavigationSlot.authRequired":
+		if e.complexity.ShellNavigationSlot.AuthRequired == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Next, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ShellNavigationSlot.AuthRequired(childComplexity), true
+
+	case "ShellNavigationSlot.description":
+		if e.complexity.ShellNavigationSlot.Description == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_PageInfo_next(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShellNavigationSlot.Description(childComplexity), true
 
-func (ec *executionContext) _PageInfo_prev(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_prev(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShellNavigationSlot.module":
+		if e.complexity.ShellNavigationSlot.Module == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Prev, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ShellNavigationSlot.Module(childComplexity), true
+
+	case "ShellNavigationSlot.priority":
+		if e.complexity.ShellNavigationSlot.Priority == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_PageInfo_prev(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShellNavigationSlot.Priority(childComplexity), true
 
-func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_page(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShellNavigationSlot.slot":
+		if e.complexity.ShellNavigationSlot.Slot == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_PageInfo_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShellNavigationSlot.Slot(childComplexity), true
 
-func (ec *executionContext) _PageInfo_perPage(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_perPage(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShellNavigationSlotModule.exposedModule":
+		if e.complexity.ShellNavigationSlotModule.ExposedModule == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PerPage, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ShellNavigationSlotModule.ExposedModule(childComplexity), true
+
+	case "ShellNavigationSlotModule.format":
+		if e.complexity.ShellNavigationSlotModule.Format == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_PageInfo_perPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShellNavigationSlotModule.Format(childComplexity), true
 
-func (ec *executionContext) _PageInfo_totalPage(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_totalPage(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShellNavigationSlotModule.moduleName":
+		if e.complexity.ShellNavigationSlotModule.ModuleName == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.TotalPage, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.ShellNavigationSlotModule.ModuleName(childComplexity), true
+
+	case "ShellNavigationSlotModule.path":
+		if e.complexity.ShellNavigationSlotModule.Path == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_PageInfo_totalPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.ShellNavigationSlotModule.Path(childComplexity), true
 
-func (ec *executionContext) _Query_registeredApps(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_registeredApps(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ShellNavigationSlotModule.remoteEntry":
+		if e.complexity.ShellNavigationSlotModule.RemoteEntry == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().RegisteredApps(rctx, fc.Args["page"].(genericdb.Page), fc.Args["where"].(*model.RegisteredAppsWhereRules), fc.Args["sort"].(*model.RegisteredAppsSort))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.RegisteredAppsPage)
-	fc.Result = res
-	return ec.marshalORegisteredAppsPage2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppsPage(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Query_registeredApps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "data":
-				return ec.fieldContext_RegisteredAppsPage_data(ctx, field)
-			case "page":
-				return ec.fieldContext_RegisteredAppsPage_page(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RegisteredAppsPage", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.ShellNavigationSlotModule.RemoteEntry(childComplexity), true
+
+	case "SignOverrideOutput.expiresAt":
+		if e.complexity.SignOverrideOutput.ExpiresAt == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_registeredApps_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_shellConfiguration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_shellConfiguration(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.SignOverrideOutput.ExpiresAt(childComplexity), true
+
+	case "SignOverrideOutput.signature":
+		if e.complexity.SignOverrideOutput.Signature == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ShellConfiguration(rctx, fc.Args["tenantId"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.SignOverrideOutput.Signature(childComplexity), true
+
+	case "SignOverrideOutput.value":
+		if e.complexity.SignOverrideOutput.Value == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(*model.ShellConfiguration)
-	fc.Result = res
-	return ec.marshalNShellConfiguration2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfiguration(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Query_shellConfiguration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "defaultRoute":
-				return ec.fieldContext_ShellConfiguration_defaultRoute(ctx, field)
-			case "categories":
-				return ec.fieldContext_ShellConfiguration_categories(ctx, field)
-			case "slots":
-				return ec.fieldContext_ShellConfiguration_slots(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellConfiguration", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.SignOverrideOutput.Value(childComplexity), true
+
+	case "Subscription.shellConfiguration":
+		if e.complexity.Subscription.ShellConfiguration == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_shellConfiguration_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Subscription_shellConfiguration_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Subscription.ShellConfiguration(childComplexity, args["tenantId"].(string), args["events"].([]model.ShellConfigEventType), args["locale"].(*string), args["sinceVersion"].(*int), args["sinceHash"].(*string)), true
+
+	case "TagValue.Value":
+		if e.complexity.TagValue.Value == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.TagValue.Value(childComplexity), true
+
+	case "TagValues.Key":
+		if e.complexity.TagValues.Key == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Schema)
-	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.TagValues.Key(childComplexity), true
 
-func (ec *executionContext) _RegisterAppOutput_id(ctx context.Context, field graphql.CollectedField, obj *model.RegisterAppOutput) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisterAppOutput_id(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "TagValues.Values":
+		if e.complexity.TagValues.Values == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.TagValues.Values(childComplexity), true
+
+	case "UserPreferences.defaultRoute":
+		if e.complexity.UserPreferences.DefaultRoute == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RegisterAppOutput_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RegisterAppOutput",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.UserPreferences.DefaultRoute(childComplexity), true
 
-func (ec *executionContext) _RegisteredApp_pkg(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredApp_pkg(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "UserPreferences.hidden":
+		if e.complexity.UserPreferences.Hidden == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Pkg, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.UserPreferences.Hidden(childComplexity), true
+
+	case "UserPreferences.pinned":
+		if e.complexity.UserPreferences.Pinned == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_RegisteredApp_pkg(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RegisteredApp",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.UserPreferences.Pinned(childComplexity), true
 
-func (ec *executionContext) _RegisteredApp_name(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredApp_name(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "UserPreferences.recents":
+		if e.complexity.UserPreferences.Recents == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+
+		return e.complexity.UserPreferences.Recents(childComplexity), true
+
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return 0, false
 }
 
-func (ec *executionContext) fieldContext_RegisteredApp_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RegisteredApp",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAuditEventQueryFields,
+		ec.unmarshalInputAuditEventsSort,
+		ec.unmarshalInputAuditEventsWhereRules,
+		ec.unmarshalInputClearMaintenanceInput,
+		ec.unmarshalInputKeepAliveAppInput,
+		ec.unmarshalInputOverrideInput,
+		ec.unmarshalInputPage,
+		ec.unmarshalInputQueryOperatorAndDate,
+		ec.unmarshalInputQueryOperatorAndValue,
+		ec.unmarshalInputQueryOperatorFieldAndValue,
+		ec.unmarshalInputQueryValue,
+		ec.unmarshalInputRegisterAppInput,
+		ec.unmarshalInputRegisterAppModule,
+		ec.unmarshalInputRegisterAppNavigationInput,
+		ec.unmarshalInputRegisterAppSlot,
+		ec.unmarshalInputRegisterAppSlotModule,
+		ec.unmarshalInputRegisterChildAppNavigationInput,
+		ec.unmarshalInputRegisterNavigationCategoryInput,
+		ec.unmarshalInputRegisterSharedDependencyInput,
+		ec.unmarshalInputRegisteredAppQueryFields,
+		ec.unmarshalInputRegisteredAppsSort,
+		ec.unmarshalInputRegisteredAppsWhereRules,
+		ec.unmarshalInputSetMaintenanceInput,
+		ec.unmarshalInputSetNavigationOverrideInput,
+		ec.unmarshalInputSignOverrideInput,
+		ec.unmarshalInputSort,
+		ec.unmarshalInputTranslationInput,
+	)
+	first := true
 
-func (ec *executionContext) _RegisteredApp_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredApp_createdAt(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	switch rc.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, rc.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
 	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RegisteredApp_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RegisteredApp",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
 }
 
-func (ec *executionContext) _RegisteredApp_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredApp_updatedAt(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
 		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RegisteredApp_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RegisteredApp",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
 	}
-	return fc, nil
+	return introspection.WrapSchema(ec.Schema()), nil
 }
 
-func (ec *executionContext) _RegisteredAppsPage_data(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredAppsPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredAppsPage_data(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
 	}
-	res := resTmp.([]*model.RegisteredApp)
-	fc.Result = res
-	return ec.marshalORegisteredApp2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredApp(ctx, field.Selections, res)
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
 }
 
-func (ec *executionContext) fieldContext_RegisteredAppsPage_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RegisteredAppsPage",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "pkg":
-				return ec.fieldContext_RegisteredApp_pkg(ctx, field)
-			case "name":
-				return ec.fieldContext_RegisteredApp_name(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_RegisteredApp_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_RegisteredApp_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RegisteredApp", field.Name)
-		},
-	}
-	return fc, nil
+var sources = []*ast.Source{
+	{Name: "../../schema/types/app.types.graphqls", Input: `#********************************************************************************************
+# ENUMS
+#********************************************************************************************
+
+enum RefRoot {
+    AppRef
+    AuditRef
 }
 
-func (ec *executionContext) _RegisteredAppsPage_page(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredAppsPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RegisteredAppsPage_page(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(*genericdb.PageInfo)
-	fc.Result = res
-	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPageInfo(ctx, field.Selections, res)
+# how the remote entry of an app is loaded, RemoteEntry is a webpack module federation container, Esm a native
+# es module and SystemJs a SystemJS module
+enum ModuleFormat {
+    RemoteEntry
+    Esm
+    SystemJs
 }
 
-func (ec *executionContext) fieldContext_RegisteredAppsPage_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "RegisteredAppsPage",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "total":
-				return ec.fieldContext_PageInfo_total(ctx, field)
-			case "next":
-				return ec.fieldContext_PageInfo_next(ctx, field)
-			case "prev":
-				return ec.fieldContext_PageInfo_prev(ctx, field)
-			case "page":
-				return ec.fieldContext_PageInfo_page(ctx, field)
-			case "perPage":
-				return ec.fieldContext_PageInfo_perPage(ctx, field)
-			case "totalPage":
-				return ec.fieldContext_PageInfo_totalPage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
-		},
-	}
-	return fc, nil
+enum ShellConfigEventType {
+    Initial
+    Updated
+    Removed
+    Added
+    Rebuild
+    Maintenance
 }
 
-func (ec *executionContext) _ShellConfiguration_defaultRoute(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellConfiguration_defaultRoute(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultRoute, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+#********************************************************************************************
+# APP REGISTRATION
+#********************************************************************************************
+
+input RegisterAppInput {
+    name: String!
+    id: String!
+    package: String!
+    version: String!
+    remoteEntryFile: String!
+    proxy: Boolean!
+    webUrl: String!
+    apiUrl: String!
+    navigation: [RegisterAppNavigationInput]
+    # navigation categories owned by the app, only apps allowed by the gateway config can register categories
+    categories: [RegisterNavigationCategoryInput!]
+    # contributions to named shell slots such as header-right or user-menu
+    slots: [RegisterAppSlot!]
+    slot1: RegisterAppSlot @deprecated(reason: "use slots, contributes to the slot-1 slot")
+    slot2: RegisterAppSlot @deprecated(reason: "use slots, contributes to the slot-2 slot")
+    slot3: RegisterAppSlot @deprecated(reason: "use slots, contributes to the slot-3 slot")
+    # the format of the remote entry, defaults to RemoteEntry
+    format: ModuleFormat
+    # dependencies the app shares with the shell and other apps
+    shared: [RegisterSharedDependencyInput!]
+}
+
+input RegisterSharedDependencyInput {
+    name: String!
+    version: String!
+    # the semver range the app accepts, defaults to the version
+    requiredVersion: String
+    singleton: Boolean
+    eager: Boolean
+    # an es module build of the dependency, shared dependencies with a url are added to the import map
+    url: String
 }
 
-func (ec *executionContext) fieldContext_ShellConfiguration_defaultRoute(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellConfiguration",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+input KeepAliveAppInput {
+    pkg: String!,
+    version: String!
+    name: String!
 }
 
-func (ec *executionContext) _ShellConfiguration_categories(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellConfiguration_categories(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Categories, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*model.ShellNavigationCategory)
-	fc.Result = res
-	return ec.marshalOShellNavigationCategory2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationCategory(ctx, field.Selections, res)
+input RegisterAppNavigationInput {
+    title: String!
+    subTitle: String!
+    authRequired: Boolean!
+    hidden: Boolean!
+    # the key of a category defined in the gateway config or registered by an app e.g. App, Setting or Dashboard
+    category: String!
+    children: [RegisterChildAppNavigationInput]
+    proxy: Boolean!
+    icon: String!
+    module: RegisterAppModule!
+    # entries with a lower order are placed first within the priority of the app, defaults to 0
+    order: Int
+    translations: [TranslationInput!]
+    # extra terms the entry can be found by in the navigation search, synonyms rank above keywords
+    keywords: [String!]
+    synonyms: [String!]
 }
 
-func (ec *executionContext) fieldContext_ShellConfiguration_categories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellConfiguration",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "title":
-				return ec.fieldContext_ShellNavigationCategory_title(ctx, field)
-			case "priority":
-				return ec.fieldContext_ShellNavigationCategory_priority(ctx, field)
-			case "category":
-				return ec.fieldContext_ShellNavigationCategory_category(ctx, field)
-			case "entries":
-				return ec.fieldContext_ShellNavigationCategory_entries(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationCategory", field.Name)
-		},
-	}
-	return fc, nil
+# a localized variant of the texts of a navigation entry or slot, the locale is a BCP-47 tag e.g. de or de-CH and
+# fields that are not set fall back along the locale chain
+input TranslationInput {
+    locale: String!
+    title: String
+    subTitle: String
+    description: String
 }
 
-func (ec *executionContext) _ShellConfiguration_slots(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellConfiguration_slots(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Slots, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*model.ShellNavigationSlot)
-	fc.Result = res
-	return ec.marshalOShellNavigationSlot2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationSlot(ctx, field.Selections, res)
+input RegisterNavigationCategoryInput {
+    key: String!
+    title: String!
+    icon: String
+    # categories with a lower priority are placed first, defaults to 0
+    priority: Int
+    hidden: Boolean
 }
 
-func (ec *executionContext) fieldContext_ShellConfiguration_slots(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellConfiguration",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "priority":
-				return ec.fieldContext_ShellNavigationSlot_priority(ctx, field)
-			case "slot":
-				return ec.fieldContext_ShellNavigationSlot_slot(ctx, field)
-			case "description":
-				return ec.fieldContext_ShellNavigationSlot_description(ctx, field)
-			case "authRequired":
-				return ec.fieldContext_ShellNavigationSlot_authRequired(ctx, field)
-			case "module":
-				return ec.fieldContext_ShellNavigationSlot_module(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationSlot", field.Name)
-		},
-	}
-	return fc, nil
+input RegisterAppModule {
+    path: String!
+    exposedModule: String!
+    moduleName: String!
+    outlet: String!
 }
 
-func (ec *executionContext) _ShellConfigurationSubscription_configuration(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationSubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellConfigurationSubscription_configuration(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Configuration, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(*model.ShellConfiguration)
-	fc.Result = res
-	return ec.marshalNShellConfiguration2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfiguration(ctx, field.Selections, res)
+input RegisterAppSlotModule {
+    path: String!
+    exposedModule: String!
+    moduleName: String!
 }
 
-func (ec *executionContext) fieldContext_ShellConfigurationSubscription_configuration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellConfigurationSubscription",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "defaultRoute":
-				return ec.fieldContext_ShellConfiguration_defaultRoute(ctx, field)
-			case "categories":
-				return ec.fieldContext_ShellConfiguration_categories(ctx, field)
-			case "slots":
-				return ec.fieldContext_ShellConfiguration_slots(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellConfiguration", field.Name)
-		},
-	}
-	return fc, nil
+input RegisterAppSlot {
+    # the name of the shell slot to contribute to, required for contributions in slots
+    slot: String
+    description: String!
+    authRequired: Boolean!
+    module: RegisterAppSlotModule!
+    # contributions with a lower order are placed first within the slot, defaults to 0
+    order: Int
+    translations: [TranslationInput!]
 }
 
-func (ec *executionContext) _ShellConfigurationSubscription_eventType(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationSubscription) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellConfigurationSubscription_eventType(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EventType, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(model.ShellConfigEventType)
-	fc.Result = res
-	return ec.marshalNShellConfigEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigEventType(ctx, field.Selections, res)
+input RegisterChildAppNavigationInput {
+    title: String!
+    subTitle: String!
+    authRequired: Boolean!
+    # used as the module path when the module does not set one
+    path: String!
+    children: [RegisterChildAppNavigationInput]
+    icon: String!
+    hidden: Boolean
+    module: RegisterAppModule!
+    # serve the module through the gateway proxy, inherited from the parent when not set
+    proxy: Boolean
+    # the remote entry file of the module relative to the web url, inherited from the parent when not set
+    remoteEntryFile: String
+    # children with a lower order are placed first, defaults to 0
+    order: Int
+    translations: [TranslationInput!]
+    keywords: [String!]
+    synonyms: [String!]
 }
 
-func (ec *executionContext) fieldContext_ShellConfigurationSubscription_eventType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellConfigurationSubscription",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ShellConfigEventType does not have child fields")
-		},
-	}
-	return fc, nil
+type RegisterAppOutput {
+    id: String!
 }
 
-func (ec *executionContext) _ShellNavigation_id(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_id(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+type KeepAliveAppOutput {
+    registrationRequired: Boolean!
+    ok: Boolean!
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+#********************************************************************************************
+# REGISTRATION HISTORY
+#********************************************************************************************
+
+type AppRegistrationRevision {
+    revision: Int!
+    version: String!
+    package: String!
+    registeredAt: Time!
+    # the revision that was restored when this revision was created by a rollback
+    rollbackOf: Int
+    navigation: [ShellNavigation]
 }
 
-func (ec *executionContext) _ShellNavigation_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_title(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+#********************************************************************************************
+# DEVELOPER OVERRIDES
+#********************************************************************************************
+
+input OverrideInput {
+    app: String!
+    url: String!
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+# overrides are signed for the user making the request who must have the override role
+input SignOverrideInput {
+    overrides: [OverrideInput!]!
 }
 
-func (ec *executionContext) _ShellNavigation_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_subTitle(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SubTitle, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+type SignOverrideOutput {
+    value: String!
+    signature: String!
+    # the signature is rejected after this time and has to be requested again
+    expiresAt: Time!
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+#********************************************************************************************
+# MAINTENANCE
+#********************************************************************************************
+
+input SetMaintenanceInput {
+    # the app to put into maintenance, the whole gateway is put into maintenance when omitted
+    app: String
+    message: String
+    until: Time
+    # user ids or ip addresses that can still use the app
+    whitelist: [String!]
 }
 
-func (ec *executionContext) _ShellNavigation_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_authRequired(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.AuthRequired, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+input ClearMaintenanceInput {
+    # the app to take out of maintenance, the whole gateway is taken out of maintenance when omitted
+    app: String
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+type MaintenanceWindow {
+    app: String
+    message: String
+    until: Time
+    startedAt: Time!
 }
 
-func (ec *executionContext) _ShellNavigation_children(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_children(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Children, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*model.ShellNavigationChild)
-	fc.Result = res
-	return ec.marshalOShellNavigationChild2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationChild(ctx, field.Selections, res)
+#********************************************************************************************
+# NAVIGATION OVERRIDES
+#********************************************************************************************
+
+# replaces fields of a navigation entry registered by an app, omitted fields keep the registered value
+input SetNavigationOverrideInput {
+    # the id of the navigation entry as returned in the shell configuration
+    id: String!
+    title: String
+    subTitle: String
+    icon: String
+    category: String
+    hidden: Boolean
+    order: Int
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_children(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "title":
-				return ec.fieldContext_ShellNavigationChild_title(ctx, field)
-			case "subTitle":
-				return ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
-			case "module":
-				return ec.fieldContext_ShellNavigationChild_module(ctx, field)
-			case "authRequired":
-				return ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
-			case "children":
-				return ec.fieldContext_ShellNavigationChild_children(ctx, field)
-			case "healthy":
-				return ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
-			case "icon":
-				return ec.fieldContext_ShellNavigationChild_icon(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationChild", field.Name)
-		},
-	}
-	return fc, nil
+type NavigationOverride {
+    id: String!
+    title: String
+    subTitle: String
+    icon: String
+    category: String
+    hidden: Boolean
+    order: Int
+    updatedAt: Time!
 }
 
-func (ec *executionContext) _ShellNavigation_healthy(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_healthy(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Healthy, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+#********************************************************************************************
+# USER PREFERENCES
+#********************************************************************************************
+
+type UserPreferences {
+    # the route the shell opens when the user lands on it, only used while the route leads to a healthy entry
+    defaultRoute: String
+    # ids of the navigation entries pinned to the favourites category, in the order they were pinned
+    pinned: [String!]!
+    # ids of the navigation entries the user has hidden from their navigation
+    hidden: [String!]!
+    # the apps the user loaded most recently, most recent first
+    recents: [RecentApp!]!
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+type RecentApp {
+    app: String!
+    visitedAt: Time!
 }
 
-func (ec *executionContext) _ShellNavigation_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_module(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Module, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(*model.ShellNavigationModule)
-	fc.Result = res
-	return ec.marshalNShellNavigationModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationModule(ctx, field.Selections, res)
+#********************************************************************************************
+# AUDIT TRAIL
+#********************************************************************************************
+
+enum AuditEventType {
+    Registered
+    Updated
+    Unregistered
+    Unavailable
+    Available
+    RolledBack
+    MaintenanceSet
+    MaintenanceCleared
+    NavigationOverrideSet
+    NavigationOverrideCleared
+    Rebuilt
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "path":
-				return ec.fieldContext_ShellNavigationModule_path(ctx, field)
-			case "remoteEntry":
-				return ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
-			case "exposedModule":
-				return ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
-			case "moduleName":
-				return ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
-			case "outlet":
-				return ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationModule", field.Name)
-		},
-	}
-	return fc, nil
+# a single field that changed, values are json encoded
+type AuditChange {
+    field: String! @ref(field: "field")
+    before: String @ref(field: "before")
+    after: String @ref(field: "after")
 }
 
-func (ec *executionContext) _ShellNavigation_icon(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_icon(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Icon, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+type AuditEvent {
+    id: String! @ref(field: "_id")
+    type: AuditEventType! @ref(field: "type")
+    app: String @ref(field: "app")
+    actor: String! @ref(field: "actor")
+    changes: [AuditChange] @ref(field: "changes")
+    createdAt: Time! @ref(field: "created_at")
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type AuditEventsPage {
+    data: [AuditEvent] @refRoot(id: AuditRef)
+    page: PageInfo!
 }
 
-func (ec *executionContext) _ShellNavigation_hidden(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigation_hidden(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Hidden, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+input AuditEventsWhereRules {
+    condition: QueryCondition! @queryCondition
+    fields: [AuditEventQueryFields] @queryOp
+    rules: [AuditEventsWhereRules] @queryRules
 }
 
-func (ec *executionContext) fieldContext_ShellNavigation_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigation",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+input AuditEventQueryFields {
+    createdAt: QueryOperatorAndDate @ref(field: "created_at") @queryType(type: Date)
+    type: QueryOperatorAndValue @ref(field: "type")
+    app: QueryOperatorAndValue @ref(field: "app")
+    actor: QueryOperatorAndValue @ref(field: "actor")
 }
 
-func (ec *executionContext) _ShellNavigationCategory_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationCategory_title(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+input AuditEventsSort {
+    createdAt: SortType @ref(field: "created_at") @queryType(type: Date)
+    type: SortType @ref(field: "type")
+    app: SortType @ref(field: "app")
+    actor: SortType @ref(field: "actor")
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationCategory_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationCategory",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+#********************************************************************************************
+# SHELL NAVIGATION
+#********************************************************************************************
+
+enum ShellNavigationState {
+    Healthy
+    Unhealthy
+    Maintenance
 }
 
-func (ec *executionContext) _ShellNavigationCategory_priority(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationCategory_priority(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Priority, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(int)
-	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+type RegisteredApp {
+    pkg: String! @ref(field: "package")
+    name: String @ref(field: "name")
+    createdAt: Time @ref(field: "created_at")
+    updatedAt: Time @ref(field: "updated_at")
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationCategory_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationCategory",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
+type RegisteredAppsPage {
+    data: [RegisteredApp] @refRoot(id: AppRef)
+    page: PageInfo!
 }
 
-func (ec *executionContext) _ShellNavigationCategory_category(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationCategory_category(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Category, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(model.RegisterAppCategory)
-	fc.Result = res
-	return ec.marshalNRegisterAppCategory2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppCategory(ctx, field.Selections, res)
+type ShellNavigation {
+    id: String! @ref(field: "_id")
+    title: String! @ref(field: "title")
+    subTitle: String! @ref(field: "subTitle")
+    authRequired: Boolean! @ref(field: "authRequired")
+    children: [ShellNavigationChild] @ref(field: "children")
+    healthy: Boolean! @ref(field: "available")
+    state: ShellNavigationState! @ref(field: "state")
+    maintenance: MaintenanceWindow @ref(field: "maintenance")
+    module: ShellNavigationModule! @ref(field: "module")
+    icon: String! @ref(field: "icon")
+    hidden: Boolean! @ref(field: "hidden")
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationCategory_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationCategory",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RegisterAppCategory does not have child fields")
-		},
-	}
-	return fc, nil
+type ShellNavigationChild {
+    title: String! @ref(field: "title")
+    subTitle: String! @ref(field: "subTitle")
+    module: ShellNavigationModule! @ref(field: "module")
+    authRequired: Boolean! @ref(field: "authRequired")
+    children: [ShellNavigationChild] @ref(field: "children")
+    healthy: Boolean! @ref(field: "available")
+    state: ShellNavigationState! @ref(field: "state")
+    icon: String! @ref(field: "icon")
+    hidden: Boolean! @ref(field: "hidden")
 }
 
-func (ec *executionContext) _ShellNavigationCategory_entries(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationCategory_entries(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Entries, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*model.ShellNavigation)
-	fc.Result = res
-	return ec.marshalOShellNavigation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigation(ctx, field.Selections, res)
+type ShellNavigationSlot {
+    # the position of the contribution within its slot
+    priority: Int
+    slot: String! @ref(field: "slot")
+    app: String @ref(field: "app")
+    description: String! @ref(field: "description")
+    authRequired: Boolean @ref(field: "authRequired")
+    module: ShellNavigationSlotModule! @ref(field: "module")
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationCategory_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationCategory",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ShellNavigation_id(ctx, field)
-			case "title":
-				return ec.fieldContext_ShellNavigation_title(ctx, field)
-			case "subTitle":
-				return ec.fieldContext_ShellNavigation_subTitle(ctx, field)
-			case "authRequired":
-				return ec.fieldContext_ShellNavigation_authRequired(ctx, field)
-			case "children":
-				return ec.fieldContext_ShellNavigation_children(ctx, field)
-			case "healthy":
-				return ec.fieldContext_ShellNavigation_healthy(ctx, field)
-			case "module":
-				return ec.fieldContext_ShellNavigation_module(ctx, field)
-			case "icon":
-				return ec.fieldContext_ShellNavigation_icon(ctx, field)
-			case "hidden":
-				return ec.fieldContext_ShellNavigation_hidden(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigation", field.Name)
-		},
-	}
-	return fc, nil
+type ShellNavigationModule {
+    path: String! @ref(field: "path")
+    remoteEntry: String! @ref(field: "remoteEntry")
+    exposedModule: String! @ref(field: "exposedModule")
+    moduleName: String! @ref(field: "moduleName")
+    outlet: String! @ref(field: "outlet")
+    format: ModuleFormat! @ref(field: "format")
 }
 
-func (ec *executionContext) _ShellNavigationChild_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_title(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+type ShellNavigationSlotModule {
+    path: String! @ref(field: "path")
+    remoteEntry: String! @ref(field: "remoteEntry")
+    exposedModule: String! @ref(field: "exposedModule")
+    moduleName: String! @ref(field: "moduleName")
+    format: ModuleFormat! @ref(field: "format")
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type ShellNavigationCategory {
+    title: String!
+    priority: Int!
+    category: String!
+    icon: String
+    hidden: Boolean!
+    entries: [ShellNavigation]
 }
 
-func (ec *executionContext) _ShellNavigationChild_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SubTitle, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+type ShellConfiguration {
+    # increases every time the configuration is rebuilt
+    version: Int!
+    # hash of the content of the configuration as returned to the caller
+    hash: String
+    # set when the configuration is returned as a patch against the configuration of this version, the other fields
+    # are then left empty and the patch has to be applied to the configuration the caller already holds
+    baseVersion: Int
+    patch: [ShellConfigurationPatchOperation!]
+    defaultRoute: String
+    # the locale the texts of the configuration are resolved for
+    locale: String
+    # the locales apps have provided translations for, including the default locale
+    locales: [String!]
+    categories: [ShellNavigationCategory]
+    slots: [ShellNavigationSlot]
+    maintenance: MaintenanceWindow
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+# a navigation entry or child matching a navigation search, the id is the id of the top level entry and the path
+# identifies the matching entry within it
+type NavigationSearchResult {
+    id: String!
+    app: String!
+    title: String!
+    subTitle: String!
+    icon: String!
+    path: String!
+    category: String!
+    # the titles of the category and parents leading to the entry
+    breadcrumbs: [String!]!
+    state: ShellNavigationState!
+    score: Float!
 }
 
-func (ec *executionContext) _ShellNavigationChild_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_module(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Module, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(*model.ShellNavigationModule)
-	fc.Result = res
-	return ec.marshalNShellNavigationModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationModule(ctx, field.Selections, res)
+# a JSON-Patch style operation, op is one of add, remove or replace and path is a JSON pointer
+type ShellConfigurationPatchOperation {
+    op: String!
+    path: String!
+    value: Any
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "path":
-				return ec.fieldContext_ShellNavigationModule_path(ctx, field)
-			case "remoteEntry":
-				return ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
-			case "exposedModule":
-				return ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
-			case "moduleName":
-				return ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
-			case "outlet":
-				return ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationModule", field.Name)
-		},
-	}
-	return fc, nil
+type ShellConfigurationSubscription {
+    configuration: ShellConfiguration!
+    eventType: ShellConfigEventType!
 }
 
-func (ec *executionContext) _ShellNavigationChild_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.AuthRequired, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+input RegisteredAppsWhereRules {
+    condition: QueryCondition! @queryCondition
+    fields: [RegisteredAppQueryFields] @queryOp
+    rules: [RegisteredAppsWhereRules] @queryRules
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+input RegisteredAppQueryFields {
+    createdAt: QueryOperatorAndDate @ref(field: "created_at") @queryType(type: Date)
+    updatedAt: QueryOperatorAndDate @ref(field: "updated_at") @queryType(type: Date)
+    name: QueryOperatorAndValue @ref(field: "name")
 }
 
-func (ec *executionContext) _ShellNavigationChild_children(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_children(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Children, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*model.ShellNavigationChild)
-	fc.Result = res
-	return ec.marshalOShellNavigationChild2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationChild(ctx, field.Selections, res)
+input RegisteredAppsSort {
+    createdAt: SortType @ref(field: "created_at") @queryType(type: Date)
+    updatedAt: SortType @ref(field: "updated_at") @queryType(type: Date)
+    name: SortType @ref(field: "name")
 }
+`, BuiltIn: false},
+	{Name: "../../schema/types/common.graphqls", Input: `#********************************************************************************************
+# DIRECTIVES
+#********************************************************************************************
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_children(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "title":
-				return ec.fieldContext_ShellNavigationChild_title(ctx, field)
-			case "subTitle":
-				return ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
-			case "module":
-				return ec.fieldContext_ShellNavigationChild_module(ctx, field)
-			case "authRequired":
-				return ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
-			case "children":
-				return ec.fieldContext_ShellNavigationChild_children(ctx, field)
-			case "healthy":
-				return ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
-			case "icon":
-				return ec.fieldContext_ShellNavigationChild_icon(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationChild", field.Name)
-		},
-	}
-	return fc, nil
+enum QueryType {
+    Date
+    Deleted
 }
 
-func (ec *executionContext) _ShellNavigationChild_healthy(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Healthy, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
+directive @ref(
+    field: String,
+    dependencies: [String]
+) on INPUT_FIELD_DEFINITION | FIELD_DEFINITION
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+directive @warden(
+    resource: String!
+    action: String!
+    resourceKey: String
+    filterField: String
+) on FIELD_DEFINITION
 
-func (ec *executionContext) _ShellNavigationChild_icon(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationChild_icon(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Icon, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+directive @refRoot(
+    id: RefRoot!
+) on FIELD_DEFINITION
+
+directive @queryFields on INPUT_FIELD_DEFINITION
+directive @queryCondition on INPUT_FIELD_DEFINITION
+directive @nestedQueries on INPUT_FIELD_DEFINITION
+directive @queryOp on INPUT_FIELD_DEFINITION
+directive @queryValue on INPUT_FIELD_DEFINITION
+directive @queryField on INPUT_FIELD_DEFINITION
+directive @queryFieldExists on INPUT_FIELD_DEFINITION
+directive @queryRules on INPUT_FIELD_DEFINITION
+directive @query on INPUT_FIELD_DEFINITION
+directive @queryType(type: QueryType!) on INPUT_FIELD_DEFINITION
+directive @goField(forceResolver: String, name: String) on FIELD_DEFINITION | INPUT_FIELD_DEFINITION
+directive @validation(constraint: String!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+
+#********************************************************************************************
+# CUSTOM SCALARS
+#********************************************************************************************
+
+scalar Time
+scalar Duration
+scalar Any
+
+#********************************************************************************************
+# TAGS
+#********************************************************************************************
+
+type TagValue {
+    Value: Any! @ref(field: "value")
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationChild_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationChild",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type TagValues {
+    Key: String! @ref(field: "key")
+    Values: [TagValue] @ref(field: "values")
 }
 
-func (ec *executionContext) _ShellNavigationModule_path(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationModule_path(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+#********************************************************************************************
+# PAGINATION
+#********************************************************************************************
+
+input Page {
+    limit: Int @ref(field: "limit")
+    page: Int @ref(field: "page")
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationModule_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationModule",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type PageInfo {
+    total: Int!
+    next: Int!
+    prev: Int!
+    page: Int!
+    perPage: Int!
+    totalPage: Int!
 }
 
-func (ec *executionContext) _ShellNavigationModule_remoteEntry(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.RemoteEntry, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+
+#********************************************************************************************
+# SORTING
+#********************************************************************************************
+
+input Sort {
+    key: String!
+    type: SortType!
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationModule_remoteEntry(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationModule",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+enum SortType {
+    ASC,
+    DES
 }
 
-func (ec *executionContext) _ShellNavigationModule_exposedModule(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ExposedModule, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+#********************************************************************************************
+# QUERY CONDITIONAL TYPES
+#********************************************************************************************
+
+enum QueryCondition {
+    And
+    Or
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationModule_exposedModule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationModule",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+input QueryValue {
+    value: Any @queryValue
 }
 
-func (ec *executionContext) _ShellNavigationModule_moduleName(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ModuleName, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+input QueryOperatorAndValue {
+    op: QueryOperators! @queryOp
+    value: Any @queryValue # @validation(constraint: "required,max=5")
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationModule_moduleName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationModule",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+input QueryOperatorAndDate {
+    op: QueryOperators! @queryOp
+    value: Time @queryValue # @validation(constraint: "required,max=5")
+}
+
+input QueryOperatorFieldAndValue {
+    field: String! @queryField
+    op: QueryOperators! @queryOp
+    value: Any @queryValue
+    exists: Boolean @queryFieldExists
+}
+
+enum QueryOperators {
+    Equal
+    NotEqual
+    Contains
+    In
+    NotIn
+    GreaterThan
+    LessThan
+    LessThanOrEqual
+    GreaterThanOrEqual
+    Regex
+}
+`, BuiltIn: false},
+	{Name: "../../schema/public/app.mutation.graphqls", Input: `extend type Mutation {
+    setDefaultRoute(route: String): UserPreferences!
+    setNavigationPinned(id: String!, pinned: Boolean!): UserPreferences!
+    setNavigationHidden(id: String!, hidden: Boolean!): UserPreferences!
+    clearRecentApps: UserPreferences!
+}
+`, BuiltIn: false},
+	{Name: "../../schema/public/app.query.graphqls", Input: `extend type Query {
+    registeredApps(page: Page!, where: RegisteredAppsWhereRules, sort: RegisteredAppsSort): RegisteredAppsPage
+    # texts are resolved for the locale argument or the Accept-Language header of the request, a caller holding the
+    # configuration of sinceVersion with the hash sinceHash receives a patch when the version is still known
+    shellConfiguration(tenantId: String!, locale: String, sinceVersion: Int, sinceHash: String): ShellConfiguration!
+    userPreferences: UserPreferences!
+    # fuzzy search across the navigation of the apps available to the tenant, entries the caller cannot open are left out unless
+    # includeUnavailable is set in which case only entries that require a user are left out for anonymous callers
+    searchNavigation(tenantId: String!, query: String!, limit: Int, includeUnavailable: Boolean): [NavigationSearchResult!]!
+}
+`, BuiltIn: false},
+	{Name: "../../schema/public/app.subscription.graphqls", Input: `extend type Subscription {
+    # when sinceVersion is set every configuration is sent as a patch against the previous one where possible
+    shellConfiguration(tenantId: String!, events: [ShellConfigEventType!]!, locale: String, sinceVersion: Int, sinceHash: String): ShellConfigurationSubscription!
+}
+`, BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) dir_validation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["constraint"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraint"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["constraint"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _ShellNavigationModule_outlet(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) dir_warden_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["resource"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("resource"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["resource"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["action"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("action"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Outlet, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["action"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["resourceKey"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("resourceKey"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	args["resourceKey"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["filterField"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filterField"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filterField"] = arg3
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationModule_outlet(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationModule",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_setDefaultRoute_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["route"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("route"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["route"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlot_priority(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlot_priority(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) field_Mutation_setNavigationHidden_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["id"] = arg0
+	var arg1 bool
+	if tmp, ok := rawArgs["hidden"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
+		arg1, err = ec.unmarshalNBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Priority, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["hidden"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setNavigationPinned_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*int)
-	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	args["id"] = arg0
+	var arg1 bool
+	if tmp, ok := rawArgs["pinned"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pinned"))
+		arg1, err = ec.unmarshalNBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["pinned"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlot_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["name"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlot_slot(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlot_slot(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) field_Query_registeredApps_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 genericdb.Page
+	if tmp, ok := rawArgs["page"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+		arg0, err = ec.unmarshalNPage2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPage(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["page"] = arg0
+	var arg1 *model.RegisteredAppsWhereRules
+	if tmp, ok := rawArgs["where"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
+		arg1, err = ec.unmarshalORegisteredAppsWhereRules2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppsWhereRules(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Slot, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["where"] = arg1
+	var arg2 *model.RegisteredAppsSort
+	if tmp, ok := rawArgs["sort"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+		arg2, err = ec.unmarshalORegisteredAppsSort2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppsSort(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	args["sort"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlot_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_searchNavigation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["tenantId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tenantId"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	return fc, nil
+	args["tenantId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["query"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["includeUnavailable"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeUnavailable"))
+		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeUnavailable"] = arg3
+	return args, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlot_description(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlot_description(ctx, field)
+func (ec *executionContext) field_Query_shellConfiguration_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["tenantId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tenantId"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tenantId"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["sinceVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sinceVersion"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sinceVersion"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["sinceHash"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sinceHash"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sinceHash"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_shellConfiguration_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["tenantId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tenantId"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["tenantId"] = arg0
+	var arg1 []model.ShellConfigEventType
+	if tmp, ok := rawArgs["events"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("events"))
+		arg1, err = ec.unmarshalNShellConfigEventType2ᚕgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigEventTypeᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["events"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["locale"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["locale"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["sinceVersion"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sinceVersion"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sinceVersion"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["sinceHash"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sinceHash"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sinceHash"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AppRegistrationRevision_revision(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_revision(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3704,7 +2419,7 @@ func (ec *executionContext) _ShellNavigationSlot_description(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Revision, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3716,26 +2431,26 @@ func (ec *executionContext) _ShellNavigationSlot_description(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlot_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_revision(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlot",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlot_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlot_authRequired(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_version(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3748,35 +2463,38 @@ func (ec *executionContext) _ShellNavigationSlot_authRequired(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AuthRequired, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlot_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlot",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlot_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlot_module(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_package(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_package(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3789,7 +2507,7 @@ func (ec *executionContext) _ShellNavigationSlot_module(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Module, nil
+		return obj.Package, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3801,36 +2519,26 @@ func (ec *executionContext) _ShellNavigationSlot_module(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ShellNavigationSlotModule)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNShellNavigationSlotModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationSlotModule(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlot_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_package(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlot",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "path":
-				return ec.fieldContext_ShellNavigationSlotModule_path(ctx, field)
-			case "remoteEntry":
-				return ec.fieldContext_ShellNavigationSlotModule_remoteEntry(ctx, field)
-			case "exposedModule":
-				return ec.fieldContext_ShellNavigationSlotModule_exposedModule(ctx, field)
-			case "moduleName":
-				return ec.fieldContext_ShellNavigationSlotModule_moduleName(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationSlotModule", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlotModule_path(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlotModule_path(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_registeredAt(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_registeredAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3843,7 +2551,7 @@ func (ec *executionContext) _ShellNavigationSlotModule_path(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return obj.RegisteredAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3855,26 +2563,26 @@ func (ec *executionContext) _ShellNavigationSlotModule_path(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlotModule_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_registeredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlotModule",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlotModule_remoteEntry(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlotModule_remoteEntry(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_rollbackOf(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_rollbackOf(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3887,38 +2595,35 @@ func (ec *executionContext) _ShellNavigationSlotModule_remoteEntry(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RemoteEntry, nil
+		return obj.RollbackOf, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlotModule_remoteEntry(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_rollbackOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlotModule",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlotModule_exposedModule(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlotModule_exposedModule(ctx, field)
+func (ec *executionContext) _AppRegistrationRevision_navigation(ctx context.Context, field graphql.CollectedField, obj *model.AppRegistrationRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AppRegistrationRevision_navigation(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3931,38 +2636,59 @@ func (ec *executionContext) _ShellNavigationSlotModule_exposedModule(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExposedModule, nil
+		return obj.Navigation, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.ShellNavigation)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOShellNavigation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlotModule_exposedModule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AppRegistrationRevision_navigation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlotModule",
+		Object:     "AppRegistrationRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ShellNavigation_id(ctx, field)
+			case "title":
+				return ec.fieldContext_ShellNavigation_title(ctx, field)
+			case "subTitle":
+				return ec.fieldContext_ShellNavigation_subTitle(ctx, field)
+			case "authRequired":
+				return ec.fieldContext_ShellNavigation_authRequired(ctx, field)
+			case "children":
+				return ec.fieldContext_ShellNavigation_children(ctx, field)
+			case "healthy":
+				return ec.fieldContext_ShellNavigation_healthy(ctx, field)
+			case "state":
+				return ec.fieldContext_ShellNavigation_state(ctx, field)
+			case "maintenance":
+				return ec.fieldContext_ShellNavigation_maintenance(ctx, field)
+			case "module":
+				return ec.fieldContext_ShellNavigation_module(ctx, field)
+			case "icon":
+				return ec.fieldContext_ShellNavigation_icon(ctx, field)
+			case "hidden":
+				return ec.fieldContext_ShellNavigation_hidden(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShellNavigationSlotModule_moduleName(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ShellNavigationSlotModule_moduleName(ctx, field)
+func (ec *executionContext) _AuditChange_field(ctx context.Context, field graphql.CollectedField, obj *model.AuditChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditChange_field(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3975,7 +2701,7 @@ func (ec *executionContext) _ShellNavigationSlotModule_moduleName(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ModuleName, nil
+		return obj.Field, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3992,9 +2718,9 @@ func (ec *executionContext) _ShellNavigationSlotModule_moduleName(ctx context.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ShellNavigationSlotModule_moduleName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditChange_field(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShellNavigationSlotModule",
+		Object:     "AuditChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4005,83 +2731,49 @@ func (ec *executionContext) fieldContext_ShellNavigationSlotModule_moduleName(_
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_shellConfiguration(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_shellConfiguration(ctx, field)
+func (ec *executionContext) _AuditChange_before(ctx context.Context, field graphql.CollectedField, obj *model.AuditChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditChange_before(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().ShellConfiguration(rctx, fc.Args["tenantId"].(string), fc.Args["events"].([]model.ShellConfigEventType))
+		return obj.Before, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.ShellConfigurationSubscription):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNShellConfigurationSubscription2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationSubscription(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_shellConfiguration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditChange_before(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "AuditChange",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "configuration":
-				return ec.fieldContext_ShellConfigurationSubscription_configuration(ctx, field)
-			case "eventType":
-				return ec.fieldContext_ShellConfigurationSubscription_eventType(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShellConfigurationSubscription", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_shellConfiguration_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TagValue_Value(ctx context.Context, field graphql.CollectedField, obj *model.TagValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TagValue_Value(ctx, field)
+func (ec *executionContext) _AuditChange_after(ctx context.Context, field graphql.CollectedField, obj *model.AuditChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditChange_after(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4094,38 +2786,35 @@ func (ec *executionContext) _TagValue_Value(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.After, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(any)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNAny2interface(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TagValue_Value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditChange_after(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TagValue",
+		Object:     "AuditChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TagValues_Key(ctx context.Context, field graphql.CollectedField, obj *model.TagValues) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TagValues_Key(ctx, field)
+func (ec *executionContext) _AuditEvent_id(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4138,7 +2827,7 @@ func (ec *executionContext) _TagValues_Key(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4155,9 +2844,9 @@ func (ec *executionContext) _TagValues_Key(ctx context.Context, field graphql.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TagValues_Key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TagValues",
+		Object:     "AuditEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4168,8 +2857,8 @@ func (ec *executionContext) fieldContext_TagValues_Key(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _TagValues_Values(ctx context.Context, field graphql.CollectedField, obj *model.TagValues) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TagValues_Values(ctx, field)
+func (ec *executionContext) _AuditEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4182,39 +2871,38 @@ func (ec *executionContext) _TagValues_Values(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Values, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TagValue)
+	res := resTmp.(model.AuditEventType)
 	fc.Result = res
-	return ec.marshalOTagValue2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTagValue(ctx, field.Selections, res)
+	return ec.marshalNAuditEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TagValues_Values(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TagValues",
+		Object:     "AuditEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "Value":
-				return ec.fieldContext_TagValue_Value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TagValue", field.Name)
+			return nil, errors.New("field of type AuditEventType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
+func (ec *executionContext) _AuditEvent_app(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_app(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4227,26 +2915,23 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.App, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_app(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "AuditEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4257,8 +2942,8 @@ func (ec *executionContext) fieldContext___Directive_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
+func (ec *executionContext) _AuditEvent_actor(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_actor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4271,25 +2956,28 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Actor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_actor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "AuditEvent",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -4298,8 +2986,8 @@ func (ec *executionContext) fieldContext___Directive_description(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+func (ec *executionContext) _AuditEvent_changes(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_changes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4312,38 +3000,43 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.Changes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.([]*model.AuditChange)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOAuditChange2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditChange(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "AuditEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			switch field.Name {
+			case "field":
+				return ec.fieldContext_AuditChange_field(ctx, field)
+			case "before":
+				return ec.fieldContext_AuditChange_before(ctx, field)
+			case "after":
+				return ec.fieldContext_AuditChange_after(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditChange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
+func (ec *executionContext) _AuditEvent_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.AuditEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEvent_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4356,7 +3049,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4368,36 +3061,26 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEvent_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "AuditEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+func (ec *executionContext) _AuditEventsPage_data(ctx context.Context, field graphql.CollectedField, obj *model.AuditEventsPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEventsPage_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4410,38 +3093,49 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*model.AuditEvent)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOAuditEvent2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEvent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEventsPage_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "AuditEventsPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AuditEvent_id(ctx, field)
+			case "type":
+				return ec.fieldContext_AuditEvent_type(ctx, field)
+			case "app":
+				return ec.fieldContext_AuditEvent_app(ctx, field)
+			case "actor":
+				return ec.fieldContext_AuditEvent_actor(ctx, field)
+			case "changes":
+				return ec.fieldContext_AuditEvent_changes(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_AuditEvent_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuditEvent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+func (ec *executionContext) _AuditEventsPage_page(ctx context.Context, field graphql.CollectedField, obj *model.AuditEventsPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AuditEventsPage_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4454,7 +3148,7 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4466,26 +3160,40 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*genericdb.PageInfo)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuditEventsPage_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "AuditEventsPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "next":
+				return ec.fieldContext_PageInfo_next(ctx, field)
+			case "prev":
+				return ec.fieldContext_PageInfo_prev(ctx, field)
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "perPage":
+				return ec.fieldContext_PageInfo_perPage(ctx, field)
+			case "totalPage":
+				return ec.fieldContext_PageInfo_totalPage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+func (ec *executionContext) _KeepAliveAppOutput_registrationRequired(ctx context.Context, field graphql.CollectedField, obj *model.KeepAliveAppOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_KeepAliveAppOutput_registrationRequired(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4498,35 +3206,38 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.RegistrationRequired, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_KeepAliveAppOutput_registrationRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "KeepAliveAppOutput",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+func (ec *executionContext) _KeepAliveAppOutput_ok(ctx context.Context, field graphql.CollectedField, obj *model.KeepAliveAppOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_KeepAliveAppOutput_ok(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4539,7 +3250,7 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.Ok, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4556,11 +3267,11 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_KeepAliveAppOutput_ok(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "KeepAliveAppOutput",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Boolean does not have child fields")
@@ -4569,8 +3280,8 @@ func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+func (ec *executionContext) _MaintenanceWindow_app(ctx context.Context, field graphql.CollectedField, obj *model.MaintenanceWindow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MaintenanceWindow_app(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4583,7 +3294,7 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.App, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4597,11 +3308,11 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MaintenanceWindow_app(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "MaintenanceWindow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -4610,8 +3321,8 @@ func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
+func (ec *executionContext) _MaintenanceWindow_message(ctx context.Context, field graphql.CollectedField, obj *model.MaintenanceWindow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MaintenanceWindow_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4624,26 +3335,23 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MaintenanceWindow_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "MaintenanceWindow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4654,8 +3362,8 @@ func (ec *executionContext) fieldContext___Field_name(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
+func (ec *executionContext) _MaintenanceWindow_until(ctx context.Context, field graphql.CollectedField, obj *model.MaintenanceWindow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MaintenanceWindow_until(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4668,7 +3376,7 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Until, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4677,26 +3385,26 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MaintenanceWindow_until(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "MaintenanceWindow",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
+func (ec *executionContext) _MaintenanceWindow_startedAt(ctx context.Context, field graphql.CollectedField, obj *model.MaintenanceWindow) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MaintenanceWindow_startedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4709,7 +3417,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.StartedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4721,36 +3429,91 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MaintenanceWindow_startedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "MaintenanceWindow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setDefaultRoute(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setDefaultRoute(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetDefaultRoute(rctx, fc.Args["route"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.UserPreferences)
+	fc.Result = res
+	return ec.marshalNUserPreferences2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐUserPreferences(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setDefaultRoute(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "defaultRoute":
+				return ec.fieldContext_UserPreferences_defaultRoute(ctx, field)
+			case "pinned":
+				return ec.fieldContext_UserPreferences_pinned(ctx, field)
+			case "hidden":
+				return ec.fieldContext_UserPreferences_hidden(ctx, field)
+			case "recents":
+				return ec.fieldContext_UserPreferences_recents(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type UserPreferences", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setDefaultRoute_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
+func (ec *executionContext) _Mutation_setNavigationPinned(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setNavigationPinned(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4763,7 +3526,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.Mutation().SetNavigationPinned(rctx, fc.Args["id"].(string), fc.Args["pinned"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4775,48 +3538,47 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.UserPreferences)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNUserPreferences2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐUserPreferences(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setNavigationPinned(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "defaultRoute":
+				return ec.fieldContext_UserPreferences_defaultRoute(ctx, field)
+			case "pinned":
+				return ec.fieldContext_UserPreferences_pinned(ctx, field)
+			case "hidden":
+				return ec.fieldContext_UserPreferences_hidden(ctx, field)
+			case "recents":
+				return ec.fieldContext_UserPreferences_recents(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type UserPreferences", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setNavigationPinned_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+func (ec *executionContext) _Mutation_setNavigationHidden(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setNavigationHidden(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4829,7 +3591,7 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return ec.resolvers.Mutation().SetNavigationHidden(rctx, fc.Args["id"].(string), fc.Args["hidden"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4841,26 +3603,47 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.UserPreferences)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNUserPreferences2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐUserPreferences(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setNavigationHidden(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "defaultRoute":
+				return ec.fieldContext_UserPreferences_defaultRoute(ctx, field)
+			case "pinned":
+				return ec.fieldContext_UserPreferences_pinned(ctx, field)
+			case "hidden":
+				return ec.fieldContext_UserPreferences_hidden(ctx, field)
+			case "recents":
+				return ec.fieldContext_UserPreferences_recents(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserPreferences", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setNavigationHidden_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+func (ec *executionContext) _Mutation_clearRecentApps(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_clearRecentApps(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4873,35 +3656,48 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return ec.resolvers.Mutation().ClearRecentApps(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.UserPreferences)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNUserPreferences2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐUserPreferences(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_clearRecentApps(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "defaultRoute":
+				return ec.fieldContext_UserPreferences_defaultRoute(ctx, field)
+			case "pinned":
+				return ec.fieldContext_UserPreferences_pinned(ctx, field)
+			case "hidden":
+				return ec.fieldContext_UserPreferences_hidden(ctx, field)
+			case "recents":
+				return ec.fieldContext_UserPreferences_recents(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserPreferences", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+func (ec *executionContext) _NavigationOverride_id(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4914,7 +3710,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4931,9 +3727,9 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4944,8 +3740,8 @@ func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+func (ec *executionContext) _NavigationOverride_title(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4958,7 +3754,7 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4972,11 +3768,11 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "NavigationOverride",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -4985,8 +3781,8 @@ func (ec *executionContext) fieldContext___InputValue_description(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+func (ec *executionContext) _NavigationOverride_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_subTitle(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4999,60 +3795,35 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.SubTitle, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+func (ec *executionContext) _NavigationOverride_icon(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_icon(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5065,7 +3836,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.Icon, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5079,9 +3850,9 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "NavigationOverride",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5092,8 +3863,8 @@ func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_description(ctx, field)
+func (ec *executionContext) _NavigationOverride_category(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_category(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5106,7 +3877,7 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Category, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5120,11 +3891,11 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "NavigationOverride",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5133,8 +3904,8 @@ func (ec *executionContext) fieldContext___Schema_description(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_types(ctx, field)
+func (ec *executionContext) _NavigationOverride_hidden(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_hidden(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5147,60 +3918,35 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.Hidden, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*bool)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "NavigationOverride",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+func (ec *executionContext) _NavigationOverride_order(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_order(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5213,60 +3959,35 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.Order, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_order(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "NavigationOverride",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+func (ec *executionContext) _NavigationOverride_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.NavigationOverride) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationOverride_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5279,57 +4000,38 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationOverride_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "NavigationOverride",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_id(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5342,57 +4044,38 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "NavigationSearchResult",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_app(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_app(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5405,7 +4088,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.App, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5417,38 +4100,26 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_app(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "NavigationSearchResult",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_kind(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_title(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5461,7 +4132,7 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5475,24 +4146,24 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "NavigationSearchResult",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_name(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_subTitle(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5505,25 +4176,28 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return obj.SubTitle, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "NavigationSearchResult",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5532,8 +4206,8 @@ func (ec *executionContext) fieldContext___Type_name(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_description(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_icon(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_icon(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5546,25 +4220,28 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Icon, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "NavigationSearchResult",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5573,8 +4250,8 @@ func (ec *executionContext) fieldContext___Type_description(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_fields(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_path(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5587,60 +4264,38 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "NavigationSearchResult",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_category(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_category(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5653,57 +4308,38 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return obj.Category, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "NavigationSearchResult",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_breadcrumbs(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_breadcrumbs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5716,57 +4352,38 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return obj.Breadcrumbs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_breadcrumbs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "NavigationSearchResult",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+func (ec *executionContext) _NavigationSearchResult_state(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_state(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5779,56 +4396,126 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.State, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(model.ShellNavigationState)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNShellNavigationState2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NavigationSearchResult_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "NavigationSearchResult",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, errors.New("field of type ShellNavigationState does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NavigationSearchResult_score(ctx context.Context, field graphql.CollectedField, obj *model.NavigationSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NavigationSearchResult_score(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Score, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_NavigationSearchResult_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NavigationSearchResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_total(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_total(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+func (ec *executionContext) _PageInfo_next(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_next(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5841,45 +4528,38 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return obj.Next, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_next(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+func (ec *executionContext) _PageInfo_prev(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_prev(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5892,887 +4572,7818 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return obj.Prev, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_prev(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_page(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_page(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Page, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_perPage(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_perPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PerPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_perPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_totalPage(ctx context.Context, field graphql.CollectedField, obj *genericdb.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_totalPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_totalPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_registeredApps(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_registeredApps(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().RegisteredApps(rctx, fc.Args["page"].(genericdb.Page), fc.Args["where"].(*model.RegisteredAppsWhereRules), fc.Args["sort"].(*model.RegisteredAppsSort))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.RegisteredAppsPage)
+	fc.Result = res
+	return ec.marshalORegisteredAppsPage2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppsPage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_registeredApps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "data":
+				return ec.fieldContext_RegisteredAppsPage_data(ctx, field)
+			case "page":
+				return ec.fieldContext_RegisteredAppsPage_page(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RegisteredAppsPage", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_registeredApps_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_shellConfiguration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_shellConfiguration(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ShellConfiguration(rctx, fc.Args["tenantId"].(string), fc.Args["locale"].(*string), fc.Args["sinceVersion"].(*int), fc.Args["sinceHash"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ShellConfiguration)
+	fc.Result = res
+	return ec.marshalNShellConfiguration2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfiguration(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_shellConfiguration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "version":
+				return ec.fieldContext_ShellConfiguration_version(ctx, field)
+			case "hash":
+				return ec.fieldContext_ShellConfiguration_hash(ctx, field)
+			case "baseVersion":
+				return ec.fieldContext_ShellConfiguration_baseVersion(ctx, field)
+			case "patch":
+				return ec.fieldContext_ShellConfiguration_patch(ctx, field)
+			case "defaultRoute":
+				return ec.fieldContext_ShellConfiguration_defaultRoute(ctx, field)
+			case "locale":
+				return ec.fieldContext_ShellConfiguration_locale(ctx, field)
+			case "locales":
+				return ec.fieldContext_ShellConfiguration_locales(ctx, field)
+			case "categories":
+				return ec.fieldContext_ShellConfiguration_categories(ctx, field)
+			case "slots":
+				return ec.fieldContext_ShellConfiguration_slots(ctx, field)
+			case "maintenance":
+				return ec.fieldContext_ShellConfiguration_maintenance(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellConfiguration", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_shellConfiguration_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_userPreferences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_userPreferences(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().UserPreferences(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.UserPreferences)
+	fc.Result = res
+	return ec.marshalNUserPreferences2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐUserPreferences(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_userPreferences(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "defaultRoute":
+				return ec.fieldContext_UserPreferences_defaultRoute(ctx, field)
+			case "pinned":
+				return ec.fieldContext_UserPreferences_pinned(ctx, field)
+			case "hidden":
+				return ec.fieldContext_UserPreferences_hidden(ctx, field)
+			case "recents":
+				return ec.fieldContext_UserPreferences_recents(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserPreferences", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_searchNavigation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_searchNavigation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SearchNavigation(rctx, fc.Args["tenantId"].(string), fc.Args["query"].(string), fc.Args["limit"].(*int), fc.Args["includeUnavailable"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.NavigationSearchResult)
+	fc.Result = res
+	return ec.marshalNNavigationSearchResult2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐNavigationSearchResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_searchNavigation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_NavigationSearchResult_id(ctx, field)
+			case "app":
+				return ec.fieldContext_NavigationSearchResult_app(ctx, field)
+			case "title":
+				return ec.fieldContext_NavigationSearchResult_title(ctx, field)
+			case "subTitle":
+				return ec.fieldContext_NavigationSearchResult_subTitle(ctx, field)
+			case "icon":
+				return ec.fieldContext_NavigationSearchResult_icon(ctx, field)
+			case "path":
+				return ec.fieldContext_NavigationSearchResult_path(ctx, field)
+			case "category":
+				return ec.fieldContext_NavigationSearchResult_category(ctx, field)
+			case "breadcrumbs":
+				return ec.fieldContext_NavigationSearchResult_breadcrumbs(ctx, field)
+			case "state":
+				return ec.fieldContext_NavigationSearchResult_state(ctx, field)
+			case "score":
+				return ec.fieldContext_NavigationSearchResult_score(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NavigationSearchResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_searchNavigation_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecentApp_app(ctx context.Context, field graphql.CollectedField, obj *model.RecentApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RecentApp_app(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.App, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RecentApp_app(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecentApp",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecentApp_visitedAt(ctx context.Context, field graphql.CollectedField, obj *model.RecentApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RecentApp_visitedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.VisitedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RecentApp_visitedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecentApp",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisterAppOutput_id(ctx context.Context, field graphql.CollectedField, obj *model.RegisterAppOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisterAppOutput_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RegisterAppOutput_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisterAppOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisteredApp_pkg(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredApp_pkg(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pkg, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RegisteredApp_pkg(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisteredApp",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisteredApp_name(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredApp_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RegisteredApp_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisteredApp",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisteredApp_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredApp_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RegisteredApp_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisteredApp",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisteredApp_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredApp) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredApp_updatedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RegisteredApp_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisteredApp",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisteredAppsPage_data(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredAppsPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredAppsPage_data(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Data, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RegisteredApp)
+	fc.Result = res
+	return ec.marshalORegisteredApp2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredApp(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RegisteredAppsPage_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisteredAppsPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "pkg":
+				return ec.fieldContext_RegisteredApp_pkg(ctx, field)
+			case "name":
+				return ec.fieldContext_RegisteredApp_name(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_RegisteredApp_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_RegisteredApp_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RegisteredApp", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegisteredAppsPage_page(ctx context.Context, field graphql.CollectedField, obj *model.RegisteredAppsPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegisteredAppsPage_page(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Page, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*genericdb.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RegisteredAppsPage_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegisteredAppsPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total":
+				return ec.fieldContext_PageInfo_total(ctx, field)
+			case "next":
+				return ec.fieldContext_PageInfo_next(ctx, field)
+			case "prev":
+				return ec.fieldContext_PageInfo_prev(ctx, field)
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "perPage":
+				return ec.fieldContext_PageInfo_perPage(ctx, field)
+			case "totalPage":
+				return ec.fieldContext_PageInfo_totalPage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_version(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_version(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfiguration_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_hash(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_hash(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfiguration_hash(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_baseVersion(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_baseVersion(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BaseVersion, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfiguration_baseVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_patch(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_patch(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Patch, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShellConfigurationPatchOperation)
+	fc.Result = res
+	return ec.marshalOShellConfigurationPatchOperation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationPatchOperationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfiguration_patch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "op":
+				return ec.fieldContext_ShellConfigurationPatchOperation_op(ctx, field)
+			case "path":
+				return ec.fieldContext_ShellConfigurationPatchOperation_path(ctx, field)
+			case "value":
+				return ec.fieldContext_ShellConfigurationPatchOperation_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellConfigurationPatchOperation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_defaultRoute(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_defaultRoute(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultRoute, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfiguration_defaultRoute(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_locale(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_locale(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locale, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfiguration_locale(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_locales(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_locales(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locales, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfiguration_locales(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_categories(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_categories(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Categories, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShellNavigationCategory)
+	fc.Result = res
+	return ec.marshalOShellNavigationCategory2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationCategory(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfiguration_categories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "title":
+				return ec.fieldContext_ShellNavigationCategory_title(ctx, field)
+			case "priority":
+				return ec.fieldContext_ShellNavigationCategory_priority(ctx, field)
+			case "category":
+				return ec.fieldContext_ShellNavigationCategory_category(ctx, field)
+			case "icon":
+				return ec.fieldContext_ShellNavigationCategory_icon(ctx, field)
+			case "hidden":
+				return ec.fieldContext_ShellNavigationCategory_hidden(ctx, field)
+			case "entries":
+				return ec.fieldContext_ShellNavigationCategory_entries(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationCategory", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_slots(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_slots(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Slots, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShellNavigationSlot)
+	fc.Result = res
+	return ec.marshalOShellNavigationSlot2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationSlot(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfiguration_slots(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "priority":
+				return ec.fieldContext_ShellNavigationSlot_priority(ctx, field)
+			case "slot":
+				return ec.fieldContext_ShellNavigationSlot_slot(ctx, field)
+			case "app":
+				return ec.fieldContext_ShellNavigationSlot_app(ctx, field)
+			case "description":
+				return ec.fieldContext_ShellNavigationSlot_description(ctx, field)
+			case "authRequired":
+				return ec.fieldContext_ShellNavigationSlot_authRequired(ctx, field)
+			case "module":
+				return ec.fieldContext_ShellNavigationSlot_module(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationSlot", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfiguration_maintenance(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfiguration_maintenance(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Maintenance, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.MaintenanceWindow)
+	fc.Result = res
+	return ec.marshalOMaintenanceWindow2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐMaintenanceWindow(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfiguration_maintenance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "app":
+				return ec.fieldContext_MaintenanceWindow_app(ctx, field)
+			case "message":
+				return ec.fieldContext_MaintenanceWindow_message(ctx, field)
+			case "until":
+				return ec.fieldContext_MaintenanceWindow_until(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_MaintenanceWindow_startedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MaintenanceWindow", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfigurationPatchOperation_op(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationPatchOperation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfigurationPatchOperation_op(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Op, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfigurationPatchOperation_op(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfigurationPatchOperation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfigurationPatchOperation_path(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationPatchOperation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfigurationPatchOperation_path(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfigurationPatchOperation_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfigurationPatchOperation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfigurationPatchOperation_value(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationPatchOperation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfigurationPatchOperation_value(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(any)
+	fc.Result = res
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfigurationPatchOperation_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfigurationPatchOperation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Any does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfigurationSubscription_configuration(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationSubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfigurationSubscription_configuration(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Configuration, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ShellConfiguration)
+	fc.Result = res
+	return ec.marshalNShellConfiguration2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfiguration(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfigurationSubscription_configuration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfigurationSubscription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "version":
+				return ec.fieldContext_ShellConfiguration_version(ctx, field)
+			case "hash":
+				return ec.fieldContext_ShellConfiguration_hash(ctx, field)
+			case "baseVersion":
+				return ec.fieldContext_ShellConfiguration_baseVersion(ctx, field)
+			case "patch":
+				return ec.fieldContext_ShellConfiguration_patch(ctx, field)
+			case "defaultRoute":
+				return ec.fieldContext_ShellConfiguration_defaultRoute(ctx, field)
+			case "locale":
+				return ec.fieldContext_ShellConfiguration_locale(ctx, field)
+			case "locales":
+				return ec.fieldContext_ShellConfiguration_locales(ctx, field)
+			case "categories":
+				return ec.fieldContext_ShellConfiguration_categories(ctx, field)
+			case "slots":
+				return ec.fieldContext_ShellConfiguration_slots(ctx, field)
+			case "maintenance":
+				return ec.fieldContext_ShellConfiguration_maintenance(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellConfiguration", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellConfigurationSubscription_eventType(ctx context.Context, field graphql.CollectedField, obj *model.ShellConfigurationSubscription) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellConfigurationSubscription_eventType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EventType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ShellConfigEventType)
+	fc.Result = res
+	return ec.marshalNShellConfigEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigEventType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellConfigurationSubscription_eventType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellConfigurationSubscription",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShellConfigEventType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_id(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_title(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_subTitle(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubTitle, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_authRequired(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuthRequired, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_children(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_children(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Children, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShellNavigationChild)
+	fc.Result = res
+	return ec.marshalOShellNavigationChild2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationChild(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_children(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "title":
+				return ec.fieldContext_ShellNavigationChild_title(ctx, field)
+			case "subTitle":
+				return ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
+			case "module":
+				return ec.fieldContext_ShellNavigationChild_module(ctx, field)
+			case "authRequired":
+				return ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
+			case "children":
+				return ec.fieldContext_ShellNavigationChild_children(ctx, field)
+			case "healthy":
+				return ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
+			case "state":
+				return ec.fieldContext_ShellNavigationChild_state(ctx, field)
+			case "icon":
+				return ec.fieldContext_ShellNavigationChild_icon(ctx, field)
+			case "hidden":
+				return ec.fieldContext_ShellNavigationChild_hidden(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationChild", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_healthy(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_healthy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Healthy, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_state(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_state(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.State, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ShellNavigationState)
+	fc.Result = res
+	return ec.marshalNShellNavigationState2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationState(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShellNavigationState does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_maintenance(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_maintenance(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Maintenance, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.MaintenanceWindow)
+	fc.Result = res
+	return ec.marshalOMaintenanceWindow2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐMaintenanceWindow(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_maintenance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "app":
+				return ec.fieldContext_MaintenanceWindow_app(ctx, field)
+			case "message":
+				return ec.fieldContext_MaintenanceWindow_message(ctx, field)
+			case "until":
+				return ec.fieldContext_MaintenanceWindow_until(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_MaintenanceWindow_startedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MaintenanceWindow", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_module(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Module, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ShellNavigationModule)
+	fc.Result = res
+	return ec.marshalNShellNavigationModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationModule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_ShellNavigationModule_path(ctx, field)
+			case "remoteEntry":
+				return ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
+			case "exposedModule":
+				return ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
+			case "moduleName":
+				return ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
+			case "outlet":
+				return ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
+			case "format":
+				return ec.fieldContext_ShellNavigationModule_format(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationModule", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_icon(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_icon(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Icon, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigation_hidden(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigation_hidden(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hidden, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigation_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationCategory_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_title(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationCategory_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationCategory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationCategory_priority(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_priority(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Priority, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationCategory_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationCategory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationCategory_category(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_category(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Category, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationCategory_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationCategory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationCategory_icon(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_icon(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Icon, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationCategory_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationCategory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationCategory_hidden(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_hidden(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hidden, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationCategory_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationCategory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationCategory_entries(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationCategory) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationCategory_entries(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Entries, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShellNavigation)
+	fc.Result = res
+	return ec.marshalOShellNavigation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationCategory_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationCategory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ShellNavigation_id(ctx, field)
+			case "title":
+				return ec.fieldContext_ShellNavigation_title(ctx, field)
+			case "subTitle":
+				return ec.fieldContext_ShellNavigation_subTitle(ctx, field)
+			case "authRequired":
+				return ec.fieldContext_ShellNavigation_authRequired(ctx, field)
+			case "children":
+				return ec.fieldContext_ShellNavigation_children(ctx, field)
+			case "healthy":
+				return ec.fieldContext_ShellNavigation_healthy(ctx, field)
+			case "state":
+				return ec.fieldContext_ShellNavigation_state(ctx, field)
+			case "maintenance":
+				return ec.fieldContext_ShellNavigation_maintenance(ctx, field)
+			case "module":
+				return ec.fieldContext_ShellNavigation_module(ctx, field)
+			case "icon":
+				return ec.fieldContext_ShellNavigation_icon(ctx, field)
+			case "hidden":
+				return ec.fieldContext_ShellNavigation_hidden(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_title(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_title(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_subTitle(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubTitle, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_subTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_module(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Module, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ShellNavigationModule)
+	fc.Result = res
+	return ec.marshalNShellNavigationModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationModule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_ShellNavigationModule_path(ctx, field)
+			case "remoteEntry":
+				return ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
+			case "exposedModule":
+				return ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
+			case "moduleName":
+				return ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
+			case "outlet":
+				return ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
+			case "format":
+				return ec.fieldContext_ShellNavigationModule_format(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationModule", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuthRequired, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_children(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_children(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Children, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ShellNavigationChild)
+	fc.Result = res
+	return ec.marshalOShellNavigationChild2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationChild(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_children(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "title":
+				return ec.fieldContext_ShellNavigationChild_title(ctx, field)
+			case "subTitle":
+				return ec.fieldContext_ShellNavigationChild_subTitle(ctx, field)
+			case "module":
+				return ec.fieldContext_ShellNavigationChild_module(ctx, field)
+			case "authRequired":
+				return ec.fieldContext_ShellNavigationChild_authRequired(ctx, field)
+			case "children":
+				return ec.fieldContext_ShellNavigationChild_children(ctx, field)
+			case "healthy":
+				return ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
+			case "state":
+				return ec.fieldContext_ShellNavigationChild_state(ctx, field)
+			case "icon":
+				return ec.fieldContext_ShellNavigationChild_icon(ctx, field)
+			case "hidden":
+				return ec.fieldContext_ShellNavigationChild_hidden(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationChild", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_healthy(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_healthy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Healthy, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_healthy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_state(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_state(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.State, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ShellNavigationState)
+	fc.Result = res
+	return ec.marshalNShellNavigationState2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationState(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShellNavigationState does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_icon(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_icon(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Icon, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_icon(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationChild_hidden(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationChild) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationChild_hidden(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hidden, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationChild_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationChild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_path(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_path(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_remoteEntry(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_remoteEntry(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RemoteEntry, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_remoteEntry(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_exposedModule(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_exposedModule(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExposedModule, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_exposedModule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_moduleName(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_moduleName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ModuleName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_moduleName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_outlet(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_outlet(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Outlet, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_outlet(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationModule_format(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationModule_format(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Format, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ModuleFormat)
+	fc.Result = res
+	return ec.marshalNModuleFormat2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationModule_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ModuleFormat does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_priority(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_priority(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Priority, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_slot(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_slot(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Slot, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_app(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_app(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.App, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_app(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_description(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_authRequired(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuthRequired, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_authRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlot_module(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlot_module(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Module, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ShellNavigationSlotModule)
+	fc.Result = res
+	return ec.marshalNShellNavigationSlotModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationSlotModule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlot_module(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_ShellNavigationSlotModule_path(ctx, field)
+			case "remoteEntry":
+				return ec.fieldContext_ShellNavigationSlotModule_remoteEntry(ctx, field)
+			case "exposedModule":
+				return ec.fieldContext_ShellNavigationSlotModule_exposedModule(ctx, field)
+			case "moduleName":
+				return ec.fieldContext_ShellNavigationSlotModule_moduleName(ctx, field)
+			case "format":
+				return ec.fieldContext_ShellNavigationSlotModule_format(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellNavigationSlotModule", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlotModule_path(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlotModule_path(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlotModule_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlotModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlotModule_remoteEntry(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlotModule_remoteEntry(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RemoteEntry, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlotModule_remoteEntry(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlotModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlotModule_exposedModule(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlotModule_exposedModule(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExposedModule, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlotModule_exposedModule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlotModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlotModule_moduleName(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlotModule_moduleName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ModuleName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlotModule_moduleName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlotModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShellNavigationSlotModule_format(ctx context.Context, field graphql.CollectedField, obj *model.ShellNavigationSlotModule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ShellNavigationSlotModule_format(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Format, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ModuleFormat)
+	fc.Result = res
+	return ec.marshalNModuleFormat2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ShellNavigationSlotModule_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShellNavigationSlotModule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ModuleFormat does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignOverrideOutput_value(ctx context.Context, field graphql.CollectedField, obj *model.SignOverrideOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SignOverrideOutput_value(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SignOverrideOutput_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignOverrideOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignOverrideOutput_signature(ctx context.Context, field graphql.CollectedField, obj *model.SignOverrideOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SignOverrideOutput_signature(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Signature, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SignOverrideOutput_signature(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignOverrideOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignOverrideOutput_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.SignOverrideOutput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SignOverrideOutput_expiresAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExpiresAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SignOverrideOutput_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignOverrideOutput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_shellConfiguration(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_shellConfiguration(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ShellConfiguration(rctx, fc.Args["tenantId"].(string), fc.Args["events"].([]model.ShellConfigEventType), fc.Args["locale"].(*string), fc.Args["sinceVersion"].(*int), fc.Args["sinceHash"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.ShellConfigurationSubscription):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNShellConfigurationSubscription2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationSubscription(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_shellConfiguration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "configuration":
+				return ec.fieldContext_ShellConfigurationSubscription_configuration(ctx, field)
+			case "eventType":
+				return ec.fieldContext_ShellConfigurationSubscription_eventType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShellConfigurationSubscription", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_shellConfiguration_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TagValue_Value(ctx context.Context, field graphql.CollectedField, obj *model.TagValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TagValue_Value(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(any)
+	fc.Result = res
+	return ec.marshalNAny2interface(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TagValue_Value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TagValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Any does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TagValues_Key(ctx context.Context, field graphql.CollectedField, obj *model.TagValues) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TagValues_Key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TagValues_Key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TagValues",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TagValues_Values(ctx context.Context, field graphql.CollectedField, obj *model.TagValues) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TagValues_Values(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Values, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TagValue)
+	fc.Result = res
+	return ec.marshalOTagValue2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTagValue(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TagValues_Values(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TagValues",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "Value":
+				return ec.fieldContext_TagValue_Value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TagValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPreferences_defaultRoute(ctx context.Context, field graphql.CollectedField, obj *model.UserPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserPreferences_defaultRoute(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultRoute, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserPreferences_defaultRoute(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPreferences",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPreferences_pinned(ctx context.Context, field graphql.CollectedField, obj *model.UserPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserPreferences_pinned(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pinned, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserPreferences_pinned(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPreferences",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPreferences_hidden(ctx context.Context, field graphql.CollectedField, obj *model.UserPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserPreferences_hidden(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hidden, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserPreferences_hidden(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPreferences",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPreferences_recents(ctx context.Context, field graphql.CollectedField, obj *model.UserPreferences) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UserPreferences_recents(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Recents, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RecentApp)
+	fc.Result = res
+	return ec.marshalNRecentApp2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRecentAppᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UserPreferences_recents(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPreferences",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "app":
+				return ec.fieldContext_RecentApp_app(ctx, field)
+			case "visitedAt":
+				return ec.fieldContext_RecentApp_visitedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecentApp", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_types(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpecifiedByURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAuditEventQueryFields(ctx context.Context, obj interface{}) (model.AuditEventQueryFields, error) {
+	var it model.AuditEventQueryFields
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"createdAt", "type", "app", "actor"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "createdAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
+			data, err := ec.unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedAt = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalOQueryOperatorAndValue2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndValue(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "app":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("app"))
+			data, err := ec.unmarshalOQueryOperatorAndValue2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndValue(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.App = data
+		case "actor":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actor"))
+			data, err := ec.unmarshalOQueryOperatorAndValue2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndValue(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Actor = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAuditEventsSort(ctx context.Context, obj interface{}) (model.AuditEventsSort, error) {
+	var it model.AuditEventsSort
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"createdAt", "type", "app", "actor"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "createdAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedAt = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "app":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("app"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.App = data
+		case "actor":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actor"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Actor = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAuditEventsWhereRules(ctx context.Context, obj interface{}) (model.AuditEventsWhereRules, error) {
+	var it model.AuditEventsWhereRules
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"condition", "fields", "rules"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "condition":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
+			data, err := ec.unmarshalNQueryCondition2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐQueryCondition(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Condition = data
+		case "fields":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fields"))
+			data, err := ec.unmarshalOAuditEventQueryFields2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventQueryFields(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Fields = data
+		case "rules":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rules"))
+			data, err := ec.unmarshalOAuditEventsWhereRules2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventsWhereRules(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Rules = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputClearMaintenanceInput(ctx context.Context, obj interface{}) (model.ClearMaintenanceInput, error) {
+	var it model.ClearMaintenanceInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"app"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "app":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("app"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.App = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputKeepAliveAppInput(ctx context.Context, obj interface{}) (model.KeepAliveAppInput, error) {
+	var it model.KeepAliveAppInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"pkg", "version", "name"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "pkg":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pkg"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pkg = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOverrideInput(ctx context.Context, obj interface{}) (model.OverrideInput, error) {
+	var it model.OverrideInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"app", "url"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "app":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("app"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.App = data
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URL = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPage(ctx context.Context, obj interface{}) (genericdb.Page, error) {
+	var it genericdb.Page
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"limit", "page"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2int64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "page":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+			data, err := ec.unmarshalOInt2int64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Page = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputQueryOperatorAndDate(ctx context.Context, obj interface{}) (model.QueryOperatorAndDate, error) {
+	var it model.QueryOperatorAndDate
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"op", "value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "op":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
+			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Op = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputQueryOperatorAndValue(ctx context.Context, obj interface{}) (model.QueryOperatorAndValue, error) {
+	var it model.QueryOperatorAndValue
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"op", "value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "op":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
+			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Op = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputQueryOperatorFieldAndValue(ctx context.Context, obj interface{}) (model.QueryOperatorFieldAndValue, error) {
+	var it model.QueryOperatorFieldAndValue
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"field", "op", "value", "exists"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "op":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
+			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Op = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		case "exists":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exists"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Exists = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputQueryValue(ctx context.Context, obj interface{}) (model.QueryValue, error) {
+	var it model.QueryValue
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterAppInput(ctx context.Context, obj interface{}) (model.RegisterAppInput, error) {
+	var it model.RegisterAppInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "id", "package", "version", "remoteEntryFile", "proxy", "webUrl", "apiUrl", "navigation", "categories", "slots", "slot1", "slot2", "slot3", "format", "shared"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "package":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("package"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Package = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		case "remoteEntryFile":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("remoteEntryFile"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RemoteEntryFile = data
+		case "proxy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proxy"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Proxy = data
+		case "webUrl":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("webUrl"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WebURL = data
+		case "apiUrl":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("apiUrl"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.APIURL = data
+		case "navigation":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("navigation"))
+			data, err := ec.unmarshalORegisterAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppNavigationInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Navigation = data
+		case "categories":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("categories"))
+			data, err := ec.unmarshalORegisterNavigationCategoryInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterNavigationCategoryInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Categories = data
+		case "slots":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slots"))
+			data, err := ec.unmarshalORegisterAppSlot2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlotᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slots = data
+		case "slot1":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot1"))
+			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slot1 = data
+		case "slot2":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot2"))
+			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slot2 = data
+		case "slot3":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot3"))
+			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slot3 = data
+		case "format":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("format"))
+			data, err := ec.unmarshalOModuleFormat2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Format = data
+		case "shared":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shared"))
+			data, err := ec.unmarshalORegisterSharedDependencyInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterSharedDependencyInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Shared = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterAppModule(ctx context.Context, obj interface{}) (model.RegisterAppModule, error) {
+	var it model.RegisterAppModule
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"path", "exposedModule", "moduleName", "outlet"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "path":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Path = data
+		case "exposedModule":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exposedModule"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExposedModule = data
+		case "moduleName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("moduleName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ModuleName = data
+		case "outlet":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("outlet"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Outlet = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegisterAppNavigationInput(ctx context.Context, obj interface{}) (model.RegisterAppNavigationInput, error) {
+	var it model.RegisterAppNavigationInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"title", "subTitle", "authRequired", "hidden", "category", "children", "proxy", "icon", "module", "order", "translations", "keywords", "synonyms"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "subTitle":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subTitle"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SubTitle = data
+		case "authRequired":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AuthRequired = data
+		case "hidden":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hidden = data
+		case "category":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("category"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Category = data
+		case "children":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("children"))
+			data, err := ec.unmarshalORegisterChildAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterChildAppNavigationInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Children = data
+		case "proxy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proxy"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Proxy = data
+		case "icon":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Icon = data
+		case "module":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
+			data, err := ec.unmarshalNRegisterAppModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppModule(ctx, v)
+			if err != nil {
+				return it, err
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+			it.Module = data
+		case "order":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Order = data
+		case "translations":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("translations"))
+			data, err := ec.unmarshalOTranslationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Translations = data
+		case "keywords":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keywords"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Keywords = data
+		case "synonyms":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("synonyms"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Synonyms = data
+		}
 	}
-	return fc, nil
+
+	return it, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) unmarshalInputRegisterAppSlot(ctx context.Context, obj interface{}) (model.RegisterAppSlot, error) {
+	var it model.RegisterAppSlot
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+
+	fieldsInOrder := [...]string{"slot", "description", "authRequired", "module", "order", "translations"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "slot":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Slot = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "authRequired":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AuthRequired = data
+		case "module":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
+			data, err := ec.unmarshalNRegisterAppSlotModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlotModule(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Module = data
+		case "order":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Order = data
+		case "translations":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("translations"))
+			data, err := ec.unmarshalOTranslationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Translations = data
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SpecifiedByURL(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+	return it, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputKeepAliveAppInput(ctx context.Context, obj interface{}) (model.KeepAliveAppInput, error) {
-	var it model.KeepAliveAppInput
+func (ec *executionContext) unmarshalInputRegisterAppSlotModule(ctx context.Context, obj interface{}) (model.RegisterAppSlotModule, error) {
+	var it model.RegisterAppSlotModule
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"pkg", "version", "name"}
+	fieldsInOrder := [...]string{"path", "exposedModule", "moduleName"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "pkg":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pkg"))
+		case "path":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Pkg = data
-		case "version":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			it.Path = data
+		case "exposedModule":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exposedModule"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Version = data
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.ExposedModule = data
+		case "moduleName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("moduleName"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Name = data
+			it.ModuleName = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputPage(ctx context.Context, obj interface{}) (genericdb.Page, error) {
-	var it genericdb.Page
+func (ec *executionContext) unmarshalInputRegisterChildAppNavigationInput(ctx context.Context, obj interface{}) (model.RegisterChildAppNavigationInput, error) {
+	var it model.RegisterChildAppNavigationInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"limit", "page"}
+	fieldsInOrder := [...]string{"title", "subTitle", "authRequired", "path", "children", "icon", "hidden", "module", "proxy", "remoteEntryFile", "order", "translations", "keywords", "synonyms"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "limit":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-			data, err := ec.unmarshalOInt2int64(ctx, v)
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Limit = data
-		case "page":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
-			data, err := ec.unmarshalOInt2int64(ctx, v)
+			it.Title = data
+		case "subTitle":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subTitle"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Page = data
+			it.SubTitle = data
+		case "authRequired":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AuthRequired = data
+		case "path":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Path = data
+		case "children":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("children"))
+			data, err := ec.unmarshalORegisterChildAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterChildAppNavigationInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Children = data
+		case "icon":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Icon = data
+		case "hidden":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hidden = data
+		case "module":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
+			data, err := ec.unmarshalNRegisterAppModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppModule(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Module = data
+		case "proxy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proxy"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Proxy = data
+		case "remoteEntryFile":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("remoteEntryFile"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RemoteEntryFile = data
+		case "order":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Order = data
+		case "translations":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("translations"))
+			data, err := ec.unmarshalOTranslationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Translations = data
+		case "keywords":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keywords"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Keywords = data
+		case "synonyms":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("synonyms"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Synonyms = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputQueryOperatorAndDate(ctx context.Context, obj interface{}) (model.QueryOperatorAndDate, error) {
-	var it model.QueryOperatorAndDate
+func (ec *executionContext) unmarshalInputRegisterNavigationCategoryInput(ctx context.Context, obj interface{}) (model.RegisterNavigationCategoryInput, error) {
+	var it model.RegisterNavigationCategoryInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"op", "value"}
+	fieldsInOrder := [...]string{"key", "title", "icon", "priority", "hidden"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "op":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
-			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+		case "key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Op = data
-		case "value":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			it.Key = data
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Value = data
+			it.Title = data
+		case "icon":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Icon = data
+		case "priority":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Priority = data
+		case "hidden":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hidden = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputQueryOperatorAndValue(ctx context.Context, obj interface{}) (model.QueryOperatorAndValue, error) {
-	var it model.QueryOperatorAndValue
+func (ec *executionContext) unmarshalInputRegisterSharedDependencyInput(ctx context.Context, obj interface{}) (model.RegisterSharedDependencyInput, error) {
+	var it model.RegisterSharedDependencyInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"op", "value"}
+	fieldsInOrder := [...]string{"name", "version", "requiredVersion", "singleton", "eager", "url"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "op":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
-			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Op = data
-		case "value":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalOAny2interface(ctx, v)
+			it.Name = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Value = data
+			it.Version = data
+		case "requiredVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requiredVersion"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RequiredVersion = data
+		case "singleton":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("singleton"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Singleton = data
+		case "eager":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eager"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Eager = data
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URL = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputQueryOperatorFieldAndValue(ctx context.Context, obj interface{}) (model.QueryOperatorFieldAndValue, error) {
-	var it model.QueryOperatorFieldAndValue
+func (ec *executionContext) unmarshalInputRegisteredAppQueryFields(ctx context.Context, obj interface{}) (model.RegisteredAppQueryFields, error) {
+	var it model.RegisteredAppQueryFields
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"field", "op", "value", "exists"}
+	fieldsInOrder := [...]string{"createdAt", "updatedAt", "name"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "field":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Field = data
-		case "op":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
-			data, err := ec.unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx, v)
+		case "createdAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
+			data, err := ec.unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Op = data
-		case "value":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalOAny2interface(ctx, v)
+			it.CreatedAt = data
+		case "updatedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("updatedAt"))
+			data, err := ec.unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Value = data
-		case "exists":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exists"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			it.UpdatedAt = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOQueryOperatorAndValue2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndValue(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Exists = data
+			it.Name = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputQueryValue(ctx context.Context, obj interface{}) (model.QueryValue, error) {
-	var it model.QueryValue
+func (ec *executionContext) unmarshalInputRegisteredAppsSort(ctx context.Context, obj interface{}) (model.RegisteredAppsSort, error) {
+	var it model.RegisteredAppsSort
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"value"}
+	fieldsInOrder := [...]string{"createdAt", "updatedAt", "name"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "value":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalOAny2interface(ctx, v)
+		case "createdAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Value = data
+			it.CreatedAt = data
+		case "updatedAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("updatedAt"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UpdatedAt = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterAppInput(ctx context.Context, obj interface{}) (model.RegisterAppInput, error) {
-	var it model.RegisterAppInput
+func (ec *executionContext) unmarshalInputRegisteredAppsWhereRules(ctx context.Context, obj interface{}) (model.RegisteredAppsWhereRules, error) {
+	var it model.RegisteredAppsWhereRules
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "id", "package", "version", "remoteEntryFile", "proxy", "webUrl", "apiUrl", "navigation", "slot1", "slot2", "slot3"}
+	fieldsInOrder := [...]string{"condition", "fields", "rules"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
-		case "id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ID = data
-		case "package":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("package"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Package = data
-		case "version":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Version = data
-		case "remoteEntryFile":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("remoteEntryFile"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.RemoteEntryFile = data
-		case "proxy":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proxy"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Proxy = data
-		case "webUrl":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("webUrl"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.WebURL = data
-		case "apiUrl":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("apiUrl"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.APIURL = data
-		case "navigation":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("navigation"))
-			data, err := ec.unmarshalORegisterAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppNavigationInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Navigation = data
-		case "slot1":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot1"))
-			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+		case "condition":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
+			data, err := ec.unmarshalNQueryCondition2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐQueryCondition(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Slot1 = data
-		case "slot2":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot2"))
-			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			it.Condition = data
+		case "fields":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fields"))
+			data, err := ec.unmarshalORegisteredAppQueryFields2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppQueryFields(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Slot2 = data
-		case "slot3":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slot3"))
-			data, err := ec.unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, v)
+			it.Fields = data
+		case "rules":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rules"))
+			data, err := ec.unmarshalORegisteredAppsWhereRules2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppsWhereRules(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Slot3 = data
+			it.Rules = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterAppModule(ctx context.Context, obj interface{}) (model.RegisterAppModule, error) {
-	var it model.RegisterAppModule
+func (ec *executionContext) unmarshalInputSetMaintenanceInput(ctx context.Context, obj interface{}) (model.SetMaintenanceInput, error) {
+	var it model.SetMaintenanceInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"path", "exposedModule", "moduleName", "outlet"}
+	fieldsInOrder := [...]string{"app", "message", "until", "whitelist"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "path":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+		case "app":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("app"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Path = data
-		case "exposedModule":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exposedModule"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			it.App = data
+		case "message":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("message"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ExposedModule = data
-		case "moduleName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("moduleName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			it.Message = data
+		case "until":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("until"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ModuleName = data
-		case "outlet":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("outlet"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			it.Until = data
+		case "whitelist":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("whitelist"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Outlet = data
+			it.Whitelist = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterAppNavigationInput(ctx context.Context, obj interface{}) (model.RegisterAppNavigationInput, error) {
-	var it model.RegisterAppNavigationInput
+func (ec *executionContext) unmarshalInputSetNavigationOverrideInput(ctx context.Context, obj interface{}) (model.SetNavigationOverrideInput, error) {
+	var it model.SetNavigationOverrideInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"title", "subTitle", "authRequired", "hidden", "category", "children", "proxy", "icon", "module"}
+	fieldsInOrder := [...]string{"id", "title", "subTitle", "icon", "category", "hidden", "order"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
 		case "title":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Title = data
 		case "subTitle":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subTitle"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.SubTitle = data
-		case "authRequired":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.AuthRequired = data
-		case "hidden":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+		case "icon":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Hidden = data
+			it.Icon = data
 		case "category":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("category"))
-			data, err := ec.unmarshalNRegisterAppCategory2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppCategory(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Category = data
-		case "children":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("children"))
-			data, err := ec.unmarshalORegisterChildAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterChildAppNavigationInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Children = data
-		case "proxy":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proxy"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Proxy = data
-		case "icon":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Icon = data
-		case "module":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
-			data, err := ec.unmarshalNRegisterAppModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppModule(ctx, v)
+			it.Category = data
+		case "hidden":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hidden"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Module = data
+			it.Hidden = data
+		case "order":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Order = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterAppSlot(ctx context.Context, obj interface{}) (model.RegisterAppSlot, error) {
-	var it model.RegisterAppSlot
+func (ec *executionContext) unmarshalInputSignOverrideInput(ctx context.Context, obj interface{}) (model.SignOverrideInput, error) {
+	var it model.SignOverrideInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"description", "authRequired", "module"}
+	fieldsInOrder := [...]string{"overrides"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "description":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Description = data
-		case "authRequired":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.AuthRequired = data
-		case "module":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
-			data, err := ec.unmarshalNRegisterAppSlotModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlotModule(ctx, v)
+		case "overrides":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("overrides"))
+			data, err := ec.unmarshalNOverrideInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐOverrideInputᚄ(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Module = data
+			it.Overrides = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterAppSlotModule(ctx context.Context, obj interface{}) (model.RegisterAppSlotModule, error) {
-	var it model.RegisterAppSlotModule
+func (ec *executionContext) unmarshalInputSort(ctx context.Context, obj interface{}) (genericdb.Sort, error) {
+	var it genericdb.Sort
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"path", "exposedModule", "moduleName"}
+	fieldsInOrder := [...]string{"key", "type"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "path":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Path = data
-		case "exposedModule":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exposedModule"))
+		case "key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ExposedModule = data
-		case "moduleName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("moduleName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			it.Key = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNSortType2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ModuleName = data
+			it.Type = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputRegisterChildAppNavigationInput(ctx context.Context, obj interface{}) (model.RegisterChildAppNavigationInput, error) {
-	var it model.RegisterChildAppNavigationInput
+func (ec *executionContext) unmarshalInputTranslationInput(ctx context.Context, obj interface{}) (model.TranslationInput, error) {
+	var it model.TranslationInput
 	asMap := map[string]interface{}{}
 	for k, v := range obj.(map[string]interface{}) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"title", "subTitle", "authRequired", "path", "children", "icon", "module"}
+	fieldsInOrder := [...]string{"locale", "title", "subTitle", "description"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
+		case "locale":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Locale = data
 		case "title":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Title = data
 		case "subTitle":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subTitle"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.SubTitle = data
-		case "authRequired":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authRequired"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.AuthRequired = data
-		case "path":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			it.Description = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var appRegistrationRevisionImplementors = []string{"AppRegistrationRevision"}
+
+func (ec *executionContext) _AppRegistrationRevision(ctx context.Context, sel ast.SelectionSet, obj *model.AppRegistrationRevision) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, appRegistrationRevisionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AppRegistrationRevision")
+		case "revision":
+			out.Values[i] = ec._AppRegistrationRevision_revision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Path = data
-		case "children":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("children"))
-			data, err := ec.unmarshalORegisterChildAppNavigationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterChildAppNavigationInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "version":
+			out.Values[i] = ec._AppRegistrationRevision_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Children = data
-		case "icon":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("icon"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "package":
+			out.Values[i] = ec._AppRegistrationRevision_package(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Icon = data
-		case "module":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("module"))
-			data, err := ec.unmarshalNRegisterAppModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppModule(ctx, v)
-			if err != nil {
-				return it, err
+		case "registeredAt":
+			out.Values[i] = ec._AppRegistrationRevision_registeredAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Module = data
+		case "rollbackOf":
+			out.Values[i] = ec._AppRegistrationRevision_rollbackOf(ctx, field, obj)
+		case "navigation":
+			out.Values[i] = ec._AppRegistrationRevision_navigation(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditChangeImplementors = []string{"AuditChange"}
+
+func (ec *executionContext) _AuditChange(ctx context.Context, sel ast.SelectionSet, obj *model.AuditChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditChange")
+		case "field":
+			out.Values[i] = ec._AuditChange_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "before":
+			out.Values[i] = ec._AuditChange_before(ctx, field, obj)
+		case "after":
+			out.Values[i] = ec._AuditChange_after(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditEventImplementors = []string{"AuditEvent"}
+
+func (ec *executionContext) _AuditEvent(ctx context.Context, sel ast.SelectionSet, obj *model.AuditEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditEvent")
+		case "id":
+			out.Values[i] = ec._AuditEvent_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._AuditEvent_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "app":
+			out.Values[i] = ec._AuditEvent_app(ctx, field, obj)
+		case "actor":
+			out.Values[i] = ec._AuditEvent_actor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changes":
+			out.Values[i] = ec._AuditEvent_changes(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._AuditEvent_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var auditEventsPageImplementors = []string{"AuditEventsPage"}
+
+func (ec *executionContext) _AuditEventsPage(ctx context.Context, sel ast.SelectionSet, obj *model.AuditEventsPage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditEventsPageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditEventsPage")
+		case "data":
+			out.Values[i] = ec._AuditEventsPage_data(ctx, field, obj)
+		case "page":
+			out.Values[i] = ec._AuditEventsPage_page(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputRegisteredAppQueryFields(ctx context.Context, obj interface{}) (model.RegisteredAppQueryFields, error) {
-	var it model.RegisteredAppQueryFields
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
+var keepAliveAppOutputImplementors = []string{"KeepAliveAppOutput"}
 
-	fieldsInOrder := [...]string{"createdAt", "updatedAt", "name"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "createdAt":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
-			data, err := ec.unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CreatedAt = data
-		case "updatedAt":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("updatedAt"))
-			data, err := ec.unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _KeepAliveAppOutput(ctx context.Context, sel ast.SelectionSet, obj *model.KeepAliveAppOutput) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, keepAliveAppOutputImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("KeepAliveAppOutput")
+		case "registrationRequired":
+			out.Values[i] = ec._KeepAliveAppOutput_registrationRequired(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.UpdatedAt = data
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalOQueryOperatorAndValue2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndValue(ctx, v)
-			if err != nil {
-				return it, err
+		case "ok":
+			out.Values[i] = ec._KeepAliveAppOutput_ok(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Name = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputRegisteredAppsSort(ctx context.Context, obj interface{}) (model.RegisteredAppsSort, error) {
-	var it model.RegisteredAppsSort
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"createdAt", "updatedAt", "name"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "createdAt":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("createdAt"))
-			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CreatedAt = data
-		case "updatedAt":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("updatedAt"))
-			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.UpdatedAt = data
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var maintenanceWindowImplementors = []string{"MaintenanceWindow"}
+
+func (ec *executionContext) _MaintenanceWindow(ctx context.Context, sel ast.SelectionSet, obj *model.MaintenanceWindow) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, maintenanceWindowImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MaintenanceWindow")
+		case "app":
+			out.Values[i] = ec._MaintenanceWindow_app(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._MaintenanceWindow_message(ctx, field, obj)
+		case "until":
+			out.Values[i] = ec._MaintenanceWindow_until(ctx, field, obj)
+		case "startedAt":
+			out.Values[i] = ec._MaintenanceWindow_startedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Name = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputRegisteredAppsWhereRules(ctx context.Context, obj interface{}) (model.RegisteredAppsWhereRules, error) {
-	var it model.RegisteredAppsWhereRules
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"condition", "fields", "rules"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "condition":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
-			data, err := ec.unmarshalNQueryCondition2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐQueryCondition(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "setDefaultRoute":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setDefaultRoute(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Condition = data
-		case "fields":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fields"))
-			data, err := ec.unmarshalORegisteredAppQueryFields2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppQueryFields(ctx, v)
-			if err != nil {
-				return it, err
+		case "setNavigationPinned":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setNavigationPinned(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Fields = data
-		case "rules":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rules"))
-			data, err := ec.unmarshalORegisteredAppsWhereRules2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredAppsWhereRules(ctx, v)
-			if err != nil {
-				return it, err
+		case "setNavigationHidden":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setNavigationHidden(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Rules = data
+		case "clearRecentApps":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_clearRecentApps(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputSort(ctx context.Context, obj interface{}) (genericdb.Sort, error) {
-	var it genericdb.Sort
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"key", "type"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "key":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var navigationOverrideImplementors = []string{"NavigationOverride"}
+
+func (ec *executionContext) _NavigationOverride(ctx context.Context, sel ast.SelectionSet, obj *model.NavigationOverride) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, navigationOverrideImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NavigationOverride")
+		case "id":
+			out.Values[i] = ec._NavigationOverride_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Key = data
-		case "type":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
-			data, err := ec.unmarshalNSortType2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx, v)
-			if err != nil {
-				return it, err
+		case "title":
+			out.Values[i] = ec._NavigationOverride_title(ctx, field, obj)
+		case "subTitle":
+			out.Values[i] = ec._NavigationOverride_subTitle(ctx, field, obj)
+		case "icon":
+			out.Values[i] = ec._NavigationOverride_icon(ctx, field, obj)
+		case "category":
+			out.Values[i] = ec._NavigationOverride_category(ctx, field, obj)
+		case "hidden":
+			out.Values[i] = ec._NavigationOverride_hidden(ctx, field, obj)
+		case "order":
+			out.Values[i] = ec._NavigationOverride_order(ctx, field, obj)
+		case "updatedAt":
+			out.Values[i] = ec._NavigationOverride_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Type = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var keepAliveAppOutputImplementors = []string{"KeepAliveAppOutput"}
+var navigationSearchResultImplementors = []string{"NavigationSearchResult"}
 
-func (ec *executionContext) _KeepAliveAppOutput(ctx context.Context, sel ast.SelectionSet, obj *model.KeepAliveAppOutput) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, keepAliveAppOutputImplementors)
+func (ec *executionContext) _NavigationSearchResult(ctx context.Context, sel ast.SelectionSet, obj *model.NavigationSearchResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, navigationSearchResultImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("KeepAliveAppOutput")
-		case "registrationRequired":
-			out.Values[i] = ec._KeepAliveAppOutput_registrationRequired(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("NavigationSearchResult")
+		case "id":
+			out.Values[i] = ec._NavigationSearchResult_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "ok":
-			out.Values[i] = ec._KeepAliveAppOutput_ok(ctx, field, obj)
+		case "app":
+			out.Values[i] = ec._NavigationSearchResult_app(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "title":
+			out.Values[i] = ec._NavigationSearchResult_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subTitle":
+			out.Values[i] = ec._NavigationSearchResult_subTitle(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "icon":
+			out.Values[i] = ec._NavigationSearchResult_icon(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "path":
+			out.Values[i] = ec._NavigationSearchResult_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "category":
+			out.Values[i] = ec._NavigationSearchResult_category(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "breadcrumbs":
+			out.Values[i] = ec._NavigationSearchResult_breadcrumbs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "state":
+			out.Values[i] = ec._NavigationSearchResult_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "score":
+			out.Values[i] = ec._NavigationSearchResult_score(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -6922,6 +12533,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "userPreferences":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_userPreferences(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "searchNavigation":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_searchNavigation(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
@@ -6954,6 +12609,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
+var recentAppImplementors = []string{"RecentApp"}
+
+func (ec *executionContext) _RecentApp(ctx context.Context, sel ast.SelectionSet, obj *model.RecentApp) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, recentAppImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RecentApp")
+		case "app":
+			out.Values[i] = ec._RecentApp_app(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "visitedAt":
+			out.Values[i] = ec._RecentApp_visitedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var registerAppOutputImplementors = []string{"RegisterAppOutput"}
 
 func (ec *executionContext) _RegisterAppOutput(ctx context.Context, sel ast.SelectionSet, obj *model.RegisterAppOutput) graphql.Marshaler {
@@ -7090,12 +12789,75 @@ func (ec *executionContext) _ShellConfiguration(ctx context.Context, sel ast.Sel
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("ShellConfiguration")
+		case "version":
+			out.Values[i] = ec._ShellConfiguration_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hash":
+			out.Values[i] = ec._ShellConfiguration_hash(ctx, field, obj)
+		case "baseVersion":
+			out.Values[i] = ec._ShellConfiguration_baseVersion(ctx, field, obj)
+		case "patch":
+			out.Values[i] = ec._ShellConfiguration_patch(ctx, field, obj)
 		case "defaultRoute":
 			out.Values[i] = ec._ShellConfiguration_defaultRoute(ctx, field, obj)
+		case "locale":
+			out.Values[i] = ec._ShellConfiguration_locale(ctx, field, obj)
+		case "locales":
+			out.Values[i] = ec._ShellConfiguration_locales(ctx, field, obj)
 		case "categories":
 			out.Values[i] = ec._ShellConfiguration_categories(ctx, field, obj)
 		case "slots":
 			out.Values[i] = ec._ShellConfiguration_slots(ctx, field, obj)
+		case "maintenance":
+			out.Values[i] = ec._ShellConfiguration_maintenance(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var shellConfigurationPatchOperationImplementors = []string{"ShellConfigurationPatchOperation"}
+
+func (ec *executionContext) _ShellConfigurationPatchOperation(ctx context.Context, sel ast.SelectionSet, obj *model.ShellConfigurationPatchOperation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shellConfigurationPatchOperationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ShellConfigurationPatchOperation")
+		case "op":
+			out.Values[i] = ec._ShellConfigurationPatchOperation_op(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "path":
+			out.Values[i] = ec._ShellConfigurationPatchOperation_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "value":
+			out.Values[i] = ec._ShellConfigurationPatchOperation_value(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7201,6 +12963,13 @@ func (ec *executionContext) _ShellNavigation(ctx context.Context, sel ast.Select
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "state":
+			out.Values[i] = ec._ShellNavigation_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maintenance":
+			out.Values[i] = ec._ShellNavigation_maintenance(ctx, field, obj)
 		case "module":
 			out.Values[i] = ec._ShellNavigation_module(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -7265,6 +13034,13 @@ func (ec *executionContext) _ShellNavigationCategory(ctx context.Context, sel as
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "icon":
+			out.Values[i] = ec._ShellNavigationCategory_icon(ctx, field, obj)
+		case "hidden":
+			out.Values[i] = ec._ShellNavigationCategory_hidden(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "entries":
 			out.Values[i] = ec._ShellNavigationCategory_entries(ctx, field, obj)
 		default:
@@ -7328,11 +13104,21 @@ func (ec *executionContext) _ShellNavigationChild(ctx context.Context, sel ast.S
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "state":
+			out.Values[i] = ec._ShellNavigationChild_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "icon":
 			out.Values[i] = ec._ShellNavigationChild_icon(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "hidden":
+			out.Values[i] = ec._ShellNavigationChild_hidden(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7392,6 +13178,11 @@ func (ec *executionContext) _ShellNavigationModule(ctx context.Context, sel ast.
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "format":
+			out.Values[i] = ec._ShellNavigationModule_format(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7433,15 +13224,76 @@ func (ec *executionContext) _ShellNavigationSlot(ctx context.Context, sel ast.Se
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "description":
-			out.Values[i] = ec._ShellNavigationSlot_description(ctx, field, obj)
+		case "app":
+			out.Values[i] = ec._ShellNavigationSlot_app(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._ShellNavigationSlot_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "authRequired":
+			out.Values[i] = ec._ShellNavigationSlot_authRequired(ctx, field, obj)
+		case "module":
+			out.Values[i] = ec._ShellNavigationSlot_module(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var shellNavigationSlotModuleImplementors = []string{"ShellNavigationSlotModule"}
+
+func (ec *executionContext) _ShellNavigationSlotModule(ctx context.Context, sel ast.SelectionSet, obj *model.ShellNavigationSlotModule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shellNavigationSlotModuleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ShellNavigationSlotModule")
+		case "path":
+			out.Values[i] = ec._ShellNavigationSlotModule_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "remoteEntry":
+			out.Values[i] = ec._ShellNavigationSlotModule_remoteEntry(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "exposedModule":
+			out.Values[i] = ec._ShellNavigationSlotModule_exposedModule(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "authRequired":
-			out.Values[i] = ec._ShellNavigationSlot_authRequired(ctx, field, obj)
-		case "module":
-			out.Values[i] = ec._ShellNavigationSlot_module(ctx, field, obj)
+		case "moduleName":
+			out.Values[i] = ec._ShellNavigationSlotModule_moduleName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "format":
+			out.Values[i] = ec._ShellNavigationSlotModule_format(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -7468,34 +13320,29 @@ func (ec *executionContext) _ShellNavigationSlot(ctx context.Context, sel ast.Se
 	return out
 }
 
-var shellNavigationSlotModuleImplementors = []string{"ShellNavigationSlotModule"}
+var signOverrideOutputImplementors = []string{"SignOverrideOutput"}
 
-func (ec *executionContext) _ShellNavigationSlotModule(ctx context.Context, sel ast.SelectionSet, obj *model.ShellNavigationSlotModule) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, shellNavigationSlotModuleImplementors)
+func (ec *executionContext) _SignOverrideOutput(ctx context.Context, sel ast.SelectionSet, obj *model.SignOverrideOutput) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, signOverrideOutputImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ShellNavigationSlotModule")
-		case "path":
-			out.Values[i] = ec._ShellNavigationSlotModule_path(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "remoteEntry":
-			out.Values[i] = ec._ShellNavigationSlotModule_remoteEntry(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SignOverrideOutput")
+		case "value":
+			out.Values[i] = ec._SignOverrideOutput_value(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "exposedModule":
-			out.Values[i] = ec._ShellNavigationSlotModule_exposedModule(ctx, field, obj)
+		case "signature":
+			out.Values[i] = ec._SignOverrideOutput_signature(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "moduleName":
-			out.Values[i] = ec._ShellNavigationSlotModule_moduleName(ctx, field, obj)
+		case "expiresAt":
+			out.Values[i] = ec._SignOverrideOutput_expiresAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -7622,6 +13469,57 @@ func (ec *executionContext) _TagValues(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
+var userPreferencesImplementors = []string{"UserPreferences"}
+
+func (ec *executionContext) _UserPreferences(ctx context.Context, sel ast.SelectionSet, obj *model.UserPreferences) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userPreferencesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UserPreferences")
+		case "defaultRoute":
+			out.Values[i] = ec._UserPreferences_defaultRoute(ctx, field, obj)
+		case "pinned":
+			out.Values[i] = ec._UserPreferences_pinned(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hidden":
+			out.Values[i] = ec._UserPreferences_hidden(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recents":
+			out.Values[i] = ec._UserPreferences_recents(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var __DirectiveImplementors = []string{"__Directive"}
 
 func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
@@ -7969,6 +13867,16 @@ func (ec *executionContext) marshalNAny2interface(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNAuditEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventType(ctx context.Context, v interface{}) (model.AuditEventType, error) {
+	var res model.AuditEventType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAuditEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventType(ctx context.Context, sel ast.SelectionSet, v model.AuditEventType) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	res, err := graphql.UnmarshalBoolean(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -7984,6 +13892,21 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
 func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
 	res, err := graphql.UnmarshalInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -8011,58 +13934,198 @@ func (ec *executionContext) marshalNInt2int64(ctx context.Context, sel ast.Selec
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	return res
-}
+	return res
+}
+
+func (ec *executionContext) unmarshalNModuleFormat2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx context.Context, v interface{}) (model.ModuleFormat, error) {
+	var res model.ModuleFormat
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNModuleFormat2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx context.Context, sel ast.SelectionSet, v model.ModuleFormat) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNNavigationSearchResult2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐNavigationSearchResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.NavigationSearchResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNNavigationSearchResult2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐNavigationSearchResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNNavigationSearchResult2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐNavigationSearchResult(ctx context.Context, sel ast.SelectionSet, v *model.NavigationSearchResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._NavigationSearchResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNOverrideInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐOverrideInputᚄ(ctx context.Context, v interface{}) ([]*model.OverrideInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.OverrideInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNOverrideInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐOverrideInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNOverrideInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐOverrideInput(ctx context.Context, v interface{}) (*model.OverrideInput, error) {
+	res, err := ec.unmarshalInputOverrideInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNPage2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPage(ctx context.Context, v interface{}) (genericdb.Page, error) {
+	res, err := ec.unmarshalInputPage(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *genericdb.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNQueryCondition2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐQueryCondition(ctx context.Context, v interface{}) (genericdb.QueryCondition, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := genericdb.QueryCondition(tmp)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNQueryCondition2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐQueryCondition(ctx context.Context, sel ast.SelectionSet, v genericdb.QueryCondition) graphql.Marshaler {
+	res := graphql.MarshalString(string(v))
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx context.Context, v interface{}) (model.QueryOperators, error) {
+	var res model.QueryOperators
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx context.Context, sel ast.SelectionSet, v model.QueryOperators) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNQueryType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryType(ctx context.Context, v interface{}) (model.QueryType, error) {
+	var res model.QueryType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNQueryType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryType(ctx context.Context, sel ast.SelectionSet, v model.QueryType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNRecentApp2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRecentAppᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RecentApp) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRecentApp2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRecentApp(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) unmarshalNPage2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPage(ctx context.Context, v interface{}) (genericdb.Page, error) {
-	res, err := ec.unmarshalInputPage(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *genericdb.PageInfo) graphql.Marshaler {
+func (ec *executionContext) marshalNRecentApp2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRecentApp(ctx context.Context, sel ast.SelectionSet, v *model.RecentApp) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._PageInfo(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNQueryCondition2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐQueryCondition(ctx context.Context, v interface{}) (genericdb.QueryCondition, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := genericdb.QueryCondition(tmp)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNQueryCondition2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐQueryCondition(ctx context.Context, sel ast.SelectionSet, v genericdb.QueryCondition) graphql.Marshaler {
-	res := graphql.MarshalString(string(v))
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx context.Context, v interface{}) (model.QueryOperators, error) {
-	var res model.QueryOperators
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNQueryOperators2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperators(ctx context.Context, sel ast.SelectionSet, v model.QueryOperators) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNQueryType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryType(ctx context.Context, v interface{}) (model.QueryType, error) {
-	var res model.QueryType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNQueryType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryType(ctx context.Context, sel ast.SelectionSet, v model.QueryType) graphql.Marshaler {
-	return v
+	return ec._RecentApp(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNRefRoot2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRefRoot(ctx context.Context, v interface{}) (model.RefRoot, error) {
@@ -8075,26 +14138,31 @@ func (ec *executionContext) marshalNRefRoot2githubᚗcomᚋazarcᚑioᚋverathre
 	return v
 }
 
-func (ec *executionContext) unmarshalNRegisterAppCategory2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppCategory(ctx context.Context, v interface{}) (model.RegisterAppCategory, error) {
-	var res model.RegisterAppCategory
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNRegisterAppCategory2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppCategory(ctx context.Context, sel ast.SelectionSet, v model.RegisterAppCategory) graphql.Marshaler {
-	return v
-}
-
 func (ec *executionContext) unmarshalNRegisterAppModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppModule(ctx context.Context, v interface{}) (*model.RegisterAppModule, error) {
 	res, err := ec.unmarshalInputRegisterAppModule(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNRegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx context.Context, v interface{}) (*model.RegisterAppSlot, error) {
+	res, err := ec.unmarshalInputRegisterAppSlot(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNRegisterAppSlotModule2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlotModule(ctx context.Context, v interface{}) (*model.RegisterAppSlotModule, error) {
 	res, err := ec.unmarshalInputRegisterAppSlotModule(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNRegisterNavigationCategoryInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterNavigationCategoryInput(ctx context.Context, v interface{}) (*model.RegisterNavigationCategoryInput, error) {
+	res, err := ec.unmarshalInputRegisterNavigationCategoryInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRegisterSharedDependencyInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterSharedDependencyInput(ctx context.Context, v interface{}) (*model.RegisterSharedDependencyInput, error) {
+	res, err := ec.unmarshalInputRegisterSharedDependencyInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNShellConfigEventType2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigEventType(ctx context.Context, v interface{}) (model.ShellConfigEventType, error) {
 	var res model.ShellConfigEventType
 	err := res.UnmarshalGQL(v)
@@ -8180,6 +14248,16 @@ func (ec *executionContext) marshalNShellConfiguration2ᚖgithubᚗcomᚋazarc
 	return ec._ShellConfiguration(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNShellConfigurationPatchOperation2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationPatchOperation(ctx context.Context, sel ast.SelectionSet, v *model.ShellConfigurationPatchOperation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ShellConfigurationPatchOperation(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNShellConfigurationSubscription2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationSubscription(ctx context.Context, sel ast.SelectionSet, v model.ShellConfigurationSubscription) graphql.Marshaler {
 	return ec._ShellConfigurationSubscription(ctx, sel, &v)
 }
@@ -8214,6 +14292,16 @@ func (ec *executionContext) marshalNShellNavigationSlotModule2ᚖgithubᚗcomᚋ
 	return ec._ShellNavigationSlotModule(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNShellNavigationState2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationState(ctx context.Context, v interface{}) (model.ShellNavigationState, error) {
+	var res model.ShellNavigationState
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNShellNavigationState2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigationState(ctx context.Context, sel ast.SelectionSet, v model.ShellNavigationState) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNSortType2githubᚗcomᚋazarcᚑioᚋverathreadᚑnextᚑcommonᚋcommonᚋgenericdbᚐSortType(ctx context.Context, v interface{}) (genericdb.SortType, error) {
 	tmp, err := graphql.UnmarshalString(v)
 	res := genericdb.SortType(tmp)
@@ -8245,6 +14333,72 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	res := graphql.MarshalTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNTranslationInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInput(ctx context.Context, v interface{}) (*model.TranslationInput, error) {
+	res, err := ec.unmarshalInputTranslationInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNUserPreferences2githubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐUserPreferences(ctx context.Context, sel ast.SelectionSet, v model.UserPreferences) graphql.Marshaler {
+	return ec._UserPreferences(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNUserPreferences2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐUserPreferences(ctx context.Context, sel ast.SelectionSet, v *model.UserPreferences) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._UserPreferences(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -8377,11 +14531,147 @@ func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋ
 	return ec.___Field(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v interface{}) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalAny(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v any) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalAny(v)
+	return res
 }
 
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) marshalOAuditChange2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditChange(ctx context.Context, sel ast.SelectionSet, v []*model.AuditChange) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8405,7 +14695,7 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+			ret[i] = ec.marshalOAuditChange2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditChange(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8416,20 +14706,20 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
-
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
+func (ec *executionContext) marshalOAuditChange2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditChange(ctx context.Context, sel ast.SelectionSet, v *model.AuditChange) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AuditChange(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOAuditEvent2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEvent(ctx context.Context, sel ast.SelectionSet, v []*model.AuditEvent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8453,7 +14743,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalOAuditEvent2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEvent(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8464,54 +14754,70 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
-
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOAuditEvent2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEvent(ctx context.Context, sel ast.SelectionSet, v *model.AuditEvent) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
 		return graphql.Null
 	}
-	return ec.___Type(ctx, sel, v)
+	return ec._AuditEvent(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalOAuditEventQueryFields2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventQueryFields(ctx context.Context, v interface{}) ([]*model.AuditEventQueryFields, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.AuditEventQueryFields, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalOAuditEventQueryFields2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventQueryFields(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) unmarshalOAuditEventQueryFields2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventQueryFields(ctx context.Context, v interface{}) (*model.AuditEventQueryFields, error) {
+	if v == nil {
+		return nil, nil
 	}
-	return res
+	res, err := ec.unmarshalInputAuditEventQueryFields(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v interface{}) (any, error) {
+func (ec *executionContext) unmarshalOAuditEventsWhereRules2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventsWhereRules(ctx context.Context, v interface{}) ([]*model.AuditEventsWhereRules, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalAny(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.AuditEventsWhereRules, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalOAuditEventsWhereRules2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventsWhereRules(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v any) graphql.Marshaler {
+func (ec *executionContext) unmarshalOAuditEventsWhereRules2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐAuditEventsWhereRules(ctx context.Context, v interface{}) (*model.AuditEventsWhereRules, error) {
 	if v == nil {
-		return graphql.Null
+		return nil, nil
 	}
-	res := graphql.MarshalAny(v)
-	return res
+	res, err := ec.unmarshalInputAuditEventsWhereRules(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
@@ -8566,6 +14872,29 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return res
 }
 
+func (ec *executionContext) marshalOMaintenanceWindow2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐMaintenanceWindow(ctx context.Context, sel ast.SelectionSet, v *model.MaintenanceWindow) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MaintenanceWindow(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOModuleFormat2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx context.Context, v interface{}) (*model.ModuleFormat, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ModuleFormat)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOModuleFormat2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐModuleFormat(ctx context.Context, sel ast.SelectionSet, v *model.ModuleFormat) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOQueryOperatorAndDate2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐQueryOperatorAndDate(ctx context.Context, v interface{}) (*model.QueryOperatorAndDate, error) {
 	if v == nil {
 		return nil, nil
@@ -8610,6 +14939,26 @@ func (ec *executionContext) unmarshalORegisterAppNavigationInput2ᚖgithubᚗcom
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalORegisterAppSlot2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlotᚄ(ctx context.Context, v interface{}) ([]*model.RegisterAppSlot, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.RegisterAppSlot, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalORegisterAppSlot2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterAppSlot(ctx context.Context, v interface{}) (*model.RegisterAppSlot, error) {
 	if v == nil {
 		return nil, nil
@@ -8646,6 +14995,46 @@ func (ec *executionContext) unmarshalORegisterChildAppNavigationInput2ᚖgithub
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalORegisterNavigationCategoryInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterNavigationCategoryInputᚄ(ctx context.Context, v interface{}) ([]*model.RegisterNavigationCategoryInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.RegisterNavigationCategoryInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRegisterNavigationCategoryInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterNavigationCategoryInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalORegisterSharedDependencyInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterSharedDependencyInputᚄ(ctx context.Context, v interface{}) ([]*model.RegisterSharedDependencyInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.RegisterSharedDependencyInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRegisterSharedDependencyInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisterSharedDependencyInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalORegisteredApp2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐRegisteredApp(ctx context.Context, sel ast.SelectionSet, v []*model.RegisteredApp) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -8765,6 +15154,53 @@ func (ec *executionContext) unmarshalORegisteredAppsWhereRules2ᚖgithubᚗcom
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOShellConfigurationPatchOperation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationPatchOperationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ShellConfigurationPatchOperation) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNShellConfigurationPatchOperation2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellConfigurationPatchOperation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOShellNavigation2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐShellNavigation(ctx context.Context, sel ast.SelectionSet, v []*model.ShellNavigation) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -8974,6 +15410,44 @@ func (ec *executionContext) marshalOSortType2ᚖgithubᚗcomᚋazarcᚑioᚋvera
 	return res
 }
 
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚕᚖstring(ctx context.Context, v interface{}) ([]*string, error) {
 	if v == nil {
 		return nil, nil
@@ -9086,6 +15560,26 @@ func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel
 	return res
 }
 
+func (ec *executionContext) unmarshalOTranslationInput2ᚕᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInputᚄ(ctx context.Context, v interface{}) ([]*model.TranslationInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.TranslationInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNTranslationInput2ᚖgithubᚗcomᚋazarcᚑioᚋverathreadᚑgatewayᚋinternalᚋgqlᚋgraphᚋcommonᚋmodelᚐTranslationInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -2,5 +2,8 @@
 
 package pubmodel
 
+type Mutation struct {
+}
+
 type Subscription struct {
 }
@@ -0,0 +1,90 @@
+package pubresolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	pubgraph "github.com/azarc-io/verathread-gateway/internal/gql/graph/public"
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/util"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	gqlutil "github.com/azarc-io/verathread-next-common/util/gql"
+)
+
+// SetDefaultRoute is the resolver for the setDefaultRoute field.
+func (r *mutationResolver) SetDefaultRoute(ctx context.Context, route *string) (*model.UserPreferences, error) {
+	rsp, err := r.InternalService.SetDefaultRoute(ctx, route)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			status := http.StatusInternalServerError
+			if errors.Is(err, apptypes.ErrUserRequired) {
+				status = http.StatusUnauthorized
+			}
+			gqlutil.AddGeneralError(ctx, err, status)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// SetNavigationPinned is the resolver for the setNavigationPinned field.
+func (r *mutationResolver) SetNavigationPinned(ctx context.Context, id string, pinned bool) (*model.UserPreferences, error) {
+	rsp, err := r.InternalService.SetNavigationPinned(ctx, id, pinned)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			status := http.StatusInternalServerError
+			if errors.Is(err, apptypes.ErrUserRequired) {
+				status = http.StatusUnauthorized
+			}
+			gqlutil.AddGeneralError(ctx, err, status)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// SetNavigationHidden is the resolver for the setNavigationHidden field.
+func (r *mutationResolver) SetNavigationHidden(ctx context.Context, id string, hidden bool) (*model.UserPreferences, error) {
+	rsp, err := r.InternalService.SetNavigationHidden(ctx, id, hidden)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			status := http.StatusInternalServerError
+			if errors.Is(err, apptypes.ErrUserRequired) {
+				status = http.StatusUnauthorized
+			}
+			gqlutil.AddGeneralError(ctx, err, status)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// ClearRecentApps is the resolver for the clearRecentApps field.
+func (r *mutationResolver) ClearRecentApps(ctx context.Context) (*model.UserPreferences, error) {
+	rsp, err := r.InternalService.ClearRecentApps(ctx)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			status := http.StatusInternalServerError
+			if errors.Is(err, apptypes.ErrUserRequired) {
+				status = http.StatusUnauthorized
+			}
+			gqlutil.AddGeneralError(ctx, err, status)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// Mutation returns pubgraph.MutationResolver implementation.
+func (r *Resolver) Mutation() pubgraph.MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
@@ -6,11 +6,17 @@ package pubresolvers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
 	pubgraph "github.com/azarc-io/verathread-gateway/internal/gql/graph/public"
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/util"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
 	"github.com/azarc-io/verathread-next-common/common/genericdb"
+	gqlutil "github.com/azarc-io/verathread-next-common/util/gql"
 )
 
 // RegisteredApps is the resolver for the registeredApps field.
@@ -19,8 +25,48 @@ func (r *queryResolver) RegisteredApps(ctx context.Context, page genericdb.Page,
 }
 
 // ShellConfiguration is the resolver for the shellConfiguration field.
-func (r *queryResolver) ShellConfiguration(ctx context.Context, tenantID string) (*model.ShellConfiguration, error) {
-	panic(fmt.Errorf("not implemented: ShellConfiguration - shellConfiguration"))
+func (r *queryResolver) ShellConfiguration(ctx context.Context, tenantID string, locale *string, sinceVersion *int, sinceHash *string) (*model.ShellConfiguration, error) {
+	ctx = apputil.PreferLocale(ctx, locale)
+
+	cfg, err := r.InternalService.GetAppConfiguration(ctx, tenantID)
+	if err != nil || sinceVersion == nil {
+		return cfg, err
+	}
+
+	base, err := r.InternalService.GetAppConfigurationVersion(ctx, tenantID, *sinceVersion)
+	if err != nil && !errors.Is(err, apptypes.ErrConfigurationNotFound) {
+		return nil, err
+	}
+
+	return apputil.ConfigurationSince(base, cfg, sinceHash)
+}
+
+// UserPreferences is the resolver for the userPreferences field.
+func (r *queryResolver) UserPreferences(ctx context.Context) (*model.UserPreferences, error) {
+	rsp, err := r.InternalService.GetUserPreferences(ctx)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, apptypes.ErrUserRequired) {
+			status = http.StatusUnauthorized
+		}
+		gqlutil.AddGeneralError(ctx, err, status)
+		return nil, nil
+	}
+
+	return rsp, nil
+}
+
+// SearchNavigation is the resolver for the searchNavigation field.
+func (r *queryResolver) SearchNavigation(ctx context.Context, tenantID string, query string, limit *int, includeUnavailable *bool) ([]*model.NavigationSearchResult, error) {
+	rsp, err := r.InternalService.SearchNavigation(ctx, tenantID, query, limit, includeUnavailable != nil && *includeUnavailable)
+	if err != nil {
+		if !util.AddValidationErrors(ctx, err) {
+			gqlutil.AddGeneralError(ctx, err, http.StatusInternalServerError)
+		}
+		return nil, nil
+	}
+
+	return rsp, nil
 }
 
 // Query returns pubgraph.QueryResolver implementation.
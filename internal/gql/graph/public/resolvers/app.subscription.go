@@ -6,57 +6,136 @@ package pubresolvers
 
 import (
 	"context"
+	"errors"
 	"slices"
 
 	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
 	pubgraph "github.com/azarc-io/verathread-gateway/internal/gql/graph/public"
 	"github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
 	nats "github.com/nats-io/nats.go"
 	"github.com/rs/zerolog/log"
 )
 
 // ShellConfiguration is the resolver for the shellConfiguration field.
-func (r *subscriptionResolver) ShellConfiguration(ctx context.Context, tenantID string, events []model.ShellConfigEventType) (<-chan *model.ShellConfigurationSubscription, error) {
+func (r *subscriptionResolver) ShellConfiguration(ctx context.Context, tenantID string, events []model.ShellConfigEventType, locale *string, sinceVersion *int, sinceHash *string) (<-chan *model.ShellConfigurationSubscription, error) {
+	ctx = apputil.PreferLocale(ctx, locale)
+
 	var (
-		ch  = make(chan *model.ShellConfigurationSubscription, 1)
-		nc  = r.Opts.NatsUseCase.Client()
-		sub *nats.Subscription
-		err error
+		ch          = make(chan *model.ShellConfigurationSubscription, 1)
+		updated     = make(chan struct{}, 1)
+		maintenance = make(chan struct{}, 1)
+		nc          = r.Opts.NatsUseCase.Client()
+		sub         *nats.Subscription
+		err         error
+
+		// when the client asks for patches every event after the first is a patch from the last configuration sent
+		last *model.ShellConfiguration
 	)
 
+	// notify never blocks the nats dispatcher, an event that arrives while another one is pending is coalesced into
+	// it because the configuration is only fetched once the pending event is handled
+	notify := func(pending chan struct{}) nats.MsgHandler {
+		return func(_ *nats.Msg) {
+			select {
+			case pending <- struct{}{}:
+			default:
+			}
+		}
+	}
+
+	// send fetches the current configuration and sends it unless the client went away while waiting for it
+	send := func(eventType model.ShellConfigEventType) {
+		cfg, err := r.InternalService.GetAppConfiguration(ctx, tenantID)
+		if err != nil {
+			log.Warn().Err(err).Str("event", eventType.String()).Msgf("failed to fetch app configuration for shell sync")
+			return
+		}
+
+		out := cfg
+		if sinceVersion != nil {
+			if last != nil {
+				if patch, err := apputil.ConfigurationPatch(last, cfg); err != nil {
+					log.Warn().Err(err).Msgf("failed to create shell configuration patch, sending full configuration")
+				} else {
+					out = patch
+				}
+			}
+			last = cfg
+		}
+
+		select {
+		case ch <- &model.ShellConfigurationSubscription{Configuration: out, EventType: eventType}:
+		case <-ctx.Done():
+		}
+	}
+
 	// load initial apps config
 	if slices.Index(events, model.ShellConfigEventTypeInitial) > -1 {
-		if cfg, err := r.InternalService.GetAppConfiguration(ctx, tenantID); err != nil {
+		cfg, err := r.InternalService.GetAppConfiguration(ctx, tenantID)
+		if err != nil {
 			return nil, err
-		} else {
-			ch <- &model.ShellConfigurationSubscription{
-				Configuration: cfg,
-				EventType:     model.ShellConfigEventTypeInitial,
+		}
+
+		out := cfg
+		if sinceVersion != nil {
+			base, err := r.InternalService.GetAppConfigurationVersion(ctx, tenantID, *sinceVersion)
+			if err != nil && !errors.Is(err, types.ErrConfigurationNotFound) {
+				return nil, err
+			}
+
+			if out, err = apputil.ConfigurationSince(base, cfg, sinceHash); err != nil {
+				return nil, err
 			}
+			last = cfg
+		}
+
+		ch <- &model.ShellConfigurationSubscription{
+			Configuration: out,
+			EventType:     model.ShellConfigEventTypeInitial,
 		}
 
 		// subscribe to patch updates
-		sub, err = nc.Subscribe(types.ShellConfigurationUpdatedSubject, func(msg *nats.Msg) {
-			if cfg, err := r.InternalService.GetAppConfiguration(ctx, tenantID); err != nil {
-				log.Warn().Err(err).Msgf("failed to fetch app configuration for shell sync")
-			} else {
-				ch <- &model.ShellConfigurationSubscription{
-					Configuration: cfg,
-					EventType:     model.ShellConfigEventTypeUpdated,
-				}
-			}
-		})
+		if sub, err = nc.Subscribe(types.ShellConfigurationUpdatedSubject, notify(updated)); err != nil {
+			return nil, err
+		}
+	}
 
-		if err != nil {
-			close(ch)
+	// notify the shell when an app or the whole gateway enters or leaves maintenance so it can show a banner
+	var maintenanceSub *nats.Subscription
+	if slices.Index(events, model.ShellConfigEventTypeMaintenance) > -1 {
+		if maintenanceSub, err = nc.Subscribe(types.MaintenanceChangedSubject, notify(maintenance)); err != nil {
+			// nothing reads from the channel once the subscription failed, stop the config updates as well
+			if sub != nil {
+				_ = sub.Unsubscribe()
+			}
+			return nil, err
 		}
 	}
 
+	// a single goroutine sends the events so that they are delivered in order and it ends with the subscription
 	go func() {
-		<-ctx.Done()
-		log.Info().Msgf("socket closed")
-		if sub != nil {
-			_ = sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msgf("socket closed")
+				if sub != nil {
+					_ = sub.Unsubscribe()
+				}
+				if maintenanceSub != nil {
+					_ = maintenanceSub.Unsubscribe()
+				}
+				return
+			case <-updated:
+				send(model.ShellConfigEventTypeUpdated)
+			case <-maintenance:
+				// the cached maintenance windows of this replica are reloaded by their own subscription which may not
+				// have seen the change yet
+				if err := r.InternalService.ReloadMaintenance(ctx); err != nil {
+					log.Warn().Err(err).Msgf("failed to reload maintenance windows for shell sync")
+				}
+				send(model.ShellConfigEventTypeMaintenance)
+			}
 		}
 	}()
 
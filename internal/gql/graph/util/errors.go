@@ -0,0 +1,34 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// AddValidationErrors adds a graphql error for every field error of a validation error, returns false if the error is
+// not a validation error
+func AddValidationErrors(ctx context.Context, err error) bool {
+	var verr *apptypes.ValidationError
+	if !errors.As(err, &verr) {
+		return false
+	}
+
+	for _, fe := range verr.Errors {
+		graphql.AddError(ctx, &gqlerror.Error{
+			Path:    graphql.GetPath(ctx),
+			Message: fe.Field + ": " + fe.Message,
+			Extensions: map[string]interface{}{
+				"code":   fe.Code,
+				"field":  fe.Field,
+				"status": http.StatusBadRequest,
+			},
+		})
+	}
+
+	return true
+}
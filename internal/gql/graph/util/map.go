@@ -1,43 +1,96 @@
 package util
 
 import (
+	"cmp"
+	"slices"
+
 	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
 	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
 )
 
-func MapFromEntity(n *model.ShellNavigation, navigation *apptypes.Navigation, available bool) {
-	n.Title = navigation.Title
-	n.SubTitle = navigation.SubTitle
+// MapFromEntity maps a top level navigation entry and all of its children to the gql model, texts are localized for
+// the locale chain and fall back to the registered texts
+func MapFromEntity(n *model.ShellNavigation, navigation *apptypes.Navigation, available bool, locales ...string) {
+	tr := navigation.Translations.Resolve(locales)
+	n.Title = cmp.Or(tr.Title, navigation.Title)
+	n.SubTitle = cmp.Or(tr.SubTitle, navigation.SubTitle)
 	n.AuthRequired = navigation.AuthRequired
+	n.Hidden = navigation.Hidden
+	n.Icon = navigation.Icon
 	n.Healthy = available
+	n.State = stateFromAvailability(available)
 	n.ID = navigation.ID
-	n.Module = &model.ShellNavigationModule{
-		Path:          navigation.Module.Path,
-		ExposedModule: navigation.Module.ExposedModule,
-		ModuleName:    navigation.Module.ModuleName,
-		Outlet:        navigation.Module.Outlet,
-		RemoteEntry:   navigation.RemoteEntry,
-	}
-
-	for _, child := range navigation.Children {
-		nc := &model.ShellNavigationChild{}
-		MapChildFromEntity(nc, child, available)
-		n.Children = append(n.Children, nc)
-	}
+	n.Module = mapModule(navigation)
+	n.Children = mapChildren(navigation.Children, available, locales)
 }
 
-func MapChildFromEntity(c *model.ShellNavigationChild, navigation *apptypes.Navigation, available bool) {
-	c.Title = navigation.Title
-	c.SubTitle = navigation.SubTitle
+// MapChildFromEntity maps a child navigation entry and its children to the gql model, children are mapped the same
+// way at any depth
+func MapChildFromEntity(c *model.ShellNavigationChild, navigation *apptypes.Navigation, available bool, locales ...string) {
+	tr := navigation.Translations.Resolve(locales)
+	c.Title = cmp.Or(tr.Title, navigation.Title)
+	c.SubTitle = cmp.Or(tr.SubTitle, navigation.SubTitle)
 	c.AuthRequired = navigation.AuthRequired
+	c.Hidden = navigation.Hidden
+	c.Icon = navigation.Icon
 	c.Healthy = available
+	c.State = stateFromAvailability(available)
+	c.Module = mapModule(navigation)
+	c.Children = mapChildren(navigation.Children, available, locales)
+}
+
+func mapChildren(children []*apptypes.Navigation, available bool, locales []string) []*model.ShellNavigationChild {
+	var out []*model.ShellNavigationChild
+	for _, child := range sortedChildren(children) {
+		nc := &model.ShellNavigationChild{}
+		MapChildFromEntity(nc, child, available, locales...)
+		out = append(out, nc)
+	}
+
+	return out
+}
 
+// mapModule maps the module of a navigation entry, the module is required by the schema so an entry without one
+// still gets its remote entry
+func mapModule(navigation *apptypes.Navigation) *model.ShellNavigationModule {
+	m := &model.ShellNavigationModule{RemoteEntry: navigation.RemoteEntry, Format: MapModuleFormat(navigation.Format)}
 	if navigation.Module != nil {
-		c.Module = &model.ShellNavigationModule{
-			Path:          navigation.Module.Path,
-			ExposedModule: navigation.Module.ExposedModule,
-			ModuleName:    navigation.Module.ModuleName,
-			Outlet:        navigation.Module.Outlet,
-		}
+		m.Path = navigation.Module.Path
+		m.ExposedModule = navigation.Module.ExposedModule
+		m.ModuleName = navigation.Module.ModuleName
+		m.Outlet = navigation.Module.Outlet
 	}
+
+	return m
+}
+
+// MapModuleFormat maps the module format of an app to the gql model, apps registered before formats were introduced
+// are module federation containers
+func MapModuleFormat(format string) model.ModuleFormat {
+	switch format {
+	case apptypes.ModuleFormatESM:
+		return model.ModuleFormatEsm
+	case apptypes.ModuleFormatSystemJS:
+		return model.ModuleFormatSystemJs
+	default:
+		return model.ModuleFormatRemoteEntry
+	}
+}
+
+// sortedChildren returns the children ordered by their order, children with the same order keep the registered order
+func sortedChildren(children []*apptypes.Navigation) []*apptypes.Navigation {
+	sorted := slices.Clone(children)
+	slices.SortStableFunc(sorted, func(a, b *apptypes.Navigation) int {
+		return cmp.Compare(a.Order, b.Order)
+	})
+
+	return sorted
+}
+
+func stateFromAvailability(available bool) model.ShellNavigationState {
+	if available {
+		return model.ShellNavigationStateHealthy
+	}
+
+	return model.ShellNavigationStateUnhealthy
 }
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// AuditActorMiddleware stores the identity of the caller found in the actor header in the request context so that
+// changes made through the private api are attributed to them in the audit trail, the header is only trusted when the
+// request was sent by a trusted proxy and any other caller that sets it is recorded as unauthenticated
+func AuditActorMiddleware(cfg *apptypes.AuditConfig, networks []*net.IPNet) echo.MiddlewareFunc {
+	actorHeader := apptypes.DefaultAuditActorHeader
+	if cfg != nil && cfg.ActorHeader != "" {
+		actorHeader = cfg.ActorHeader
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if cfg == nil || !cfg.Enabled {
+				return next(c)
+			}
+
+			req := c.Request()
+
+			actor := req.Header.Get(actorHeader)
+			if actor == "" {
+				return next(c)
+			}
+
+			if !trustedPeer(c, networks) {
+				actor = apptypes.AuditActorUnauthenticated
+			}
+			c.SetRequest(req.WithContext(apputil.WithActor(req.Context(), actor)))
+
+			return next(c)
+		}
+	}
+}
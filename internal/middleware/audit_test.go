@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditActorMiddleware(t *testing.T) {
+	networks, err := TrustedNetworks(&apptypes.TrustConfig{Proxies: []string{"10.0.0.0/8"}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		header     string
+		actor      string
+	}{
+		{name: "trusted proxy", remoteAddr: "10.1.2.3:4000", header: "alice", actor: "alice"},
+		{name: "untrusted peer", remoteAddr: "203.0.113.7:4000", header: "alice", actor: apptypes.AuditActorUnauthenticated},
+		{name: "no header", remoteAddr: "203.0.113.7:4000", actor: apptypes.AuditActorAnonymous},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.header != "" {
+				req.Header.Set(apptypes.DefaultAuditActorHeader, tt.header)
+			}
+
+			e := echo.New()
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			var actor string
+			err := AuditActorMiddleware(&apptypes.AuditConfig{Enabled: true}, networks)(func(c echo.Context) error {
+				actor = apputil.ActorFromContext(c.Request().Context(), apptypes.AuditActorAnonymous)
+				return nil
+			})(c)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.actor, actor)
+		})
+	}
+}
@@ -4,19 +4,29 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-// ACAOHeaderOverwriteMiddleware header entry de-duplication
+// deduplicatedHeaders are set by both the gateway and the proxied apps
 // TODO make the keys configurable through the yaml config file
+var deduplicatedHeaders = []string{
+	"X-Content-Type-Options",
+	"X-Dns-Prefetch-Control",
+	"X-Download-Options",
+	"X-Frame-Options",
+	"X-Request-Id",
+	"X-Xss-Protection",
+	"Vary",
+}
+
+// ACAOHeaderOverwriteMiddleware header entry de-duplication, responses that never reached an upstream such as
+// gateway errors may not carry these headers at all
 func ACAOHeaderOverwriteMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		ctx.Response().Before(func() {
 			h := ctx.Response().Header()
-			h.Set("X-Content-Type-Options", h.Values("X-Content-Type-Options")[0])
-			h.Set("X-Dns-Prefetch-Control", h.Values("X-Dns-Prefetch-Control")[0])
-			h.Set("X-Download-Options", h.Values("X-Download-Options")[0])
-			h.Set("X-Frame-Options", h.Values("X-Frame-Options")[0])
-			h.Set("X-Request-Id", h.Values("X-Request-Id")[0])
-			h.Set("X-Xss-Protection", h.Values("X-Xss-Protection")[0])
-			h.Set("Vary", h.Values("Vary")[0])
+			for _, key := range deduplicatedHeaders {
+				if values := h.Values(key); len(values) > 1 {
+					h.Set(key, values[0])
+				}
+			}
 		})
 		return next(ctx)
 	}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+const acceptLanguageHeader = "Accept-Language"
+
+// LocaleMiddleware stores the locales of the Accept-Language header in the request context so that the shell
+// configuration can be localized, a locale passed to the api takes precedence over the header
+func LocaleMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if locales := apputil.ParseAcceptLanguage(c.Request().Header.Get(acceptLanguageHeader)); len(locales) > 0 {
+				req := c.Request()
+				c.SetRequest(req.WithContext(apputil.WithLocales(req.Context(), locales)))
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+// MaintenanceMiddleware rejects requests to apps that are in maintenance with a 503 and a Retry-After header, users
+// and ip addresses that are whitelisted either in the config or on the maintenance window itself are let through,
+// the user is the one authenticated by a trusted proxy and the ip address is only taken from trusted forwarding headers
+func MaintenanceMiddleware(
+	cfg *apptypes.MaintenanceConfig, networks []*net.IPNet, is apptypes.InternalService, log zerolog.Logger,
+) echo.MiddlewareFunc {
+	var (
+		whitelist []string
+		clientIP  = TrustedIPExtractor(networks)
+	)
+	if cfg != nil {
+		whitelist = cfg.Whitelist
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			app := c.Param("appId")
+
+			m, ok := is.GetMaintenance(app)
+			if !ok {
+				return next(c)
+			}
+
+			user, ip := apputil.UserFromContext(c.Request().Context()), clientIP(c.Request())
+			if (user != "" && slices.Contains(whitelist, user)) || slices.Contains(whitelist, ip) || m.Allows(user, ip) {
+				log.Debug().Str("app", app).Msgf("whitelisted request passed through maintenance")
+				return next(c)
+			}
+
+			c.Set(apptypes.AppNameKey, app)
+			c.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter(m, time.Now())))
+
+			message := m.Message
+			if message == "" {
+				message = fmt.Sprintf("app %s is down for maintenance", app)
+			}
+
+			return echo.NewHTTPError(http.StatusServiceUnavailable, message)
+		}
+	}
+}
+
+// retryAfter returns the number of seconds until the maintenance window ends
+func retryAfter(m *apptypes.Maintenance, now time.Time) int {
+	if m.Until == nil {
+		return int(apptypes.DefaultMaintenanceRetryAfter.Seconds())
+	}
+
+	return int(math.Max(1, math.Ceil(m.Until.Sub(now).Seconds())))
+}
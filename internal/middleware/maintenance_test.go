@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+type maintenanceService struct {
+	apptypes.InternalService
+	window *apptypes.Maintenance
+}
+
+func (s *maintenanceService) GetMaintenance(string) (*apptypes.Maintenance, bool) {
+	return s.window, s.window != nil
+}
+
+func TestMaintenanceMiddleware(t *testing.T) {
+	is := &maintenanceService{window: &apptypes.Maintenance{App: "app", Whitelist: []string{"alice", "198.51.100.1"}}}
+	networks, err := TrustedNetworks(&apptypes.TrustConfig{Proxies: []string{"10.0.0.0/8"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mw := MaintenanceMiddleware(&apptypes.MaintenanceConfig{Whitelist: []string{"bob"}}, networks, is, zerolog.Nop())
+
+	tests := []struct {
+		name       string
+		user       string
+		header     string
+		remoteAddr string
+		status     int
+	}{
+		{name: "anonymous", remoteAddr: "203.0.113.1:4000", status: http.StatusServiceUnavailable},
+		{name: "whitelisted on the window", user: "alice", remoteAddr: "203.0.113.1:4000", status: http.StatusOK},
+		{name: "whitelisted in the config", user: "bob", remoteAddr: "203.0.113.1:4000", status: http.StatusOK},
+		{name: "whitelisted ip", remoteAddr: "198.51.100.1:4000", status: http.StatusOK},
+		{name: "unverified user header", header: "alice", remoteAddr: "203.0.113.1:4000", status: http.StatusServiceUnavailable},
+		{name: "whitelisted ip forwarded by a trusted proxy", remoteAddr: "10.0.0.1:4000", status: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+
+			req := httptest.NewRequest(http.MethodGet, "/app/app/remoteEntry.js", nil)
+			req.RemoteAddr = tt.remoteAddr
+			req.Header.Set(apptypes.DefaultUserHeader, tt.header)
+			req.Header.Set(echo.HeaderXForwardedFor, "198.51.100.1")
+			if tt.user != "" {
+				req = req.WithContext(apputil.WithUser(req.Context(), tt.user))
+			}
+
+			c := e.NewContext(req, httptest.NewRecorder())
+			c.SetParamNames("appId")
+			c.SetParamValues("app")
+
+			err := mw(func(c echo.Context) error { return c.NoContent(http.StatusOK) })(c)
+
+			status := c.Response().Status
+			if he, ok := err.(*echo.HTTPError); ok {
+				status = he.Code
+			}
+			assert.Equal(t, tt.status, status)
+		})
+	}
+}
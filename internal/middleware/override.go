@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/url"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+// OverrideMiddleware reads developer overrides from either a header or a cookie, verifies the signature and stores the
+// parsed overrides in the request context so that both the shell configuration and the app proxies can apply them,
+// overrides are only applied for the user they were signed for and only while that user has the override role
+func OverrideMiddleware(cfg *apptypes.OverrideConfig, log zerolog.Logger) echo.MiddlewareFunc {
+	if cfg != nil && cfg.Enabled && cfg.Secret == "" {
+		log.Error().Msgf("developer overrides are enabled without a secret, all overrides will be rejected")
+	}
+
+	role := apptypes.DefaultOverrideRole
+	if cfg != nil && cfg.Role != "" {
+		role = cfg.Role
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if cfg == nil || !cfg.Enabled {
+				return next(c)
+			}
+
+			value := readOverrideValue(c, apptypes.OverrideHeader)
+			if value == "" {
+				return next(c)
+			}
+
+			ctx := c.Request().Context()
+			user := apputil.UserFromContext(ctx)
+			if user == "" || !apputil.HasRole(ctx, role) {
+				log.Warn().Str("override", value).Str("user", user).Msgf("ignoring developer override of a user without the %s role", role)
+				return next(c)
+			}
+
+			signature := readOverrideValue(c, apptypes.OverrideSignatureHeader)
+			if err := apputil.VerifyOverrides(cfg.Secret, user, value, signature, time.Now()); err != nil {
+				log.Warn().Err(err).Str("override", value).Str("user", user).Msgf("ignoring developer override")
+				return next(c)
+			}
+
+			overrides, err := apputil.ParseOverrides(value, cfg.AllowedHosts)
+			if err != nil {
+				log.Warn().Err(err).Str("override", value).Msgf("ignoring invalid developer override")
+				return next(c)
+			}
+
+			req := c.Request()
+			c.SetRequest(req.WithContext(apputil.WithOverrides(req.Context(), overrides)))
+
+			return next(c)
+		}
+	}
+}
+
+// readOverrideValue reads a value from the header first and falls back to a cookie of the same name
+func readOverrideValue(c echo.Context, name string) string {
+	if value := c.Request().Header.Get(name); value != "" {
+		return value
+	}
+
+	if cookie, err := c.Cookie(name); err == nil {
+		if value, err := url.QueryUnescape(cookie.Value); err == nil {
+			return value
+		}
+	}
+
+	return ""
+}
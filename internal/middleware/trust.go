@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net"
+	"slices"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/labstack/echo/v4"
+)
+
+// TrustedNetworks parses the networks of the trusted proxies, single addresses are accepted as well as cidr ranges
+func TrustedNetworks(cfg *apptypes.TrustConfig) ([]*net.IPNet, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	networks := make([]*net.IPNet, 0, len(cfg.Proxies))
+	for _, proxy := range cfg.Proxies {
+		if ip := net.ParseIP(proxy); ip != nil {
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+// TrustedIPExtractor returns the extractor of the client ip address used for access decisions, the X-Forwarded-For
+// header is only followed through trusted proxies and the address of the peer is used as is when there are none
+func TrustedIPExtractor(networks []*net.IPNet) echo.IPExtractor {
+	if len(networks) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, network := range networks {
+		options = append(options, echo.TrustIPRange(network))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
+// trustedPeer reports whether the request was sent by one of the trusted proxies
+func trustedPeer(c echo.Context, networks []*net.IPNet) bool {
+	host, _, err := net.SplitHostPort(c.Request().RemoteAddr)
+	if err != nil {
+		host = c.Request().RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(networks, func(network *net.IPNet) bool {
+		return network.Contains(ip)
+	})
+}
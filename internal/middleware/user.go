@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net"
+	"strings"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// UserMiddleware stores the id and roles of the user found in the user and roles headers in the request context so
+// that user preferences can be read and stored and the shell configuration can be tailored to the user, the headers
+// are ignored unless the request was sent by a trusted proxy that authenticated the user
+func UserMiddleware(cfg *apptypes.PreferencesConfig, trust *apptypes.TrustConfig, networks []*net.IPNet) echo.MiddlewareFunc {
+	userHeader, rolesHeader := apptypes.DefaultUserHeader, apptypes.DefaultRolesHeader
+	if cfg != nil && cfg.UserHeader != "" {
+		userHeader = cfg.UserHeader
+	}
+	if trust != nil && trust.RolesHeader != "" {
+		rolesHeader = trust.RolesHeader
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			user := req.Header.Get(userHeader)
+			if user == "" || !trustedPeer(c, networks) {
+				return next(c)
+			}
+
+			ctx := apputil.WithUser(req.Context(), user)
+			if roles := parseRoles(req.Header.Get(rolesHeader)); len(roles) > 0 {
+				ctx = apputil.WithRoles(ctx, roles)
+			}
+			c.SetRequest(req.WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// parseRoles splits a comma or space separated list of roles
+func parseRoles(header string) []string {
+	return strings.FieldsFunc(header, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserMiddleware(t *testing.T) {
+	trust := &apptypes.TrustConfig{Proxies: []string{"10.0.0.0/8", "192.168.1.10"}}
+
+	networks, err := TrustedNetworks(trust)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		networks   bool
+		user       string
+		admin      bool
+	}{
+		{name: "trusted proxy", remoteAddr: "10.1.2.3:4000", networks: true, user: "alice", admin: true},
+		{name: "trusted single address", remoteAddr: "192.168.1.10:4000", networks: true, user: "alice", admin: true},
+		{name: "untrusted peer", remoteAddr: "203.0.113.7:4000", networks: true},
+		{name: "no trusted proxies", remoteAddr: "10.1.2.3:4000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var trusted = networks
+			if !tt.networks {
+				trusted = nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			req.Header.Set(apptypes.DefaultUserHeader, "alice")
+			req.Header.Set(apptypes.DefaultRolesHeader, "developer, admin")
+
+			e := echo.New()
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			var user string
+			var admin bool
+			err := UserMiddleware(nil, trust, trusted)(func(c echo.Context) error {
+				user = apputil.UserFromContext(c.Request().Context())
+				admin = apputil.HasRole(c.Request().Context(), "admin")
+				return nil
+			})(c)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.user, user)
+			assert.Equal(t, tt.admin, admin)
+		})
+	}
+}
+
+func TestTrustedNetworksRejectsInvalidProxy(t *testing.T) {
+	_, err := TrustedNetworks(&apptypes.TrustConfig{Proxies: []string{"not-a-network"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,55 @@
+package preferences
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+)
+
+type (
+	// memoryStore keeps user preferences in memory, used for single node development and tests
+	memoryStore struct {
+		mu          sync.Mutex
+		preferences map[string][]byte
+	}
+)
+
+func (s *memoryStore) GetUserPreferences(_ context.Context, user string) (*apptypes.UserPreferences, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefs := &apptypes.UserPreferences{User: user}
+	if b, ok := s.preferences[user]; ok {
+		if err := json.Unmarshal(b, prefs); err != nil {
+			return nil, err
+		}
+	}
+
+	return prefs, nil
+}
+
+func (s *memoryStore) SaveUserPreferences(_ context.Context, p *apptypes.UserPreferences) error {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.preferences[p.User] = b
+	s.mu.Unlock()
+
+	return nil
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// NewMemoryStore creates a store that keeps user preferences in memory
+func NewMemoryStore() apptypes.PreferencesStore {
+	return &memoryStore{
+		preferences: make(map[string][]byte),
+	}
+}
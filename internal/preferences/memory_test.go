@@ -0,0 +1,28 @@
+package preferences
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	s, ctx := NewMemoryStore(), context.Background()
+
+	prefs, err := s.GetUserPreferences(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", prefs.User, "unknown users get empty preferences")
+
+	prefs.Pinned = []string{"nav"}
+	require.NoError(t, s.SaveUserPreferences(ctx, prefs))
+
+	prefs, err = s.GetUserPreferences(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nav"}, prefs.Pinned)
+
+	other, err := s.GetUserPreferences(ctx, "bob")
+	require.NoError(t, err)
+	assert.Empty(t, other.Pinned, "preferences are kept per user")
+}
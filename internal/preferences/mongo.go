@@ -0,0 +1,75 @@
+package preferences
+
+import (
+	"context"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/azarc-io/verathread-next-common/common/genericdb"
+	mongouc "github.com/azarc-io/verathread-next-common/usecase/mongo"
+	gqlutil "github.com/azarc-io/verathread-next-common/util/gql"
+)
+
+type (
+	// mongoStore keeps user preferences in mongo, every change is inserted as a new document the same way audit events
+	// are and the newest document of a user is the current one
+	mongoStore struct {
+		muc mongouc.MongoUseCase
+	}
+
+	// preferencesWhere selects the documents of a user, it has the shape of the where rules generated for the gql api
+	preferencesWhere struct {
+		Condition genericdb.QueryCondition `query:"condition"`
+		Fields    []*preferencesFields     `query:"op"`
+	}
+
+	preferencesFields struct {
+		User *model.QueryOperatorAndValue `bson:"user" yaml:"user"`
+	}
+
+	// preferencesSort orders the documents of a user newest first
+	preferencesSort struct {
+		UpdatedAt *genericdb.SortType `bson:"updatedAt" yaml:"updatedAt" queryType:"Date"`
+	}
+)
+
+func (s *mongoStore) GetUserPreferences(ctx context.Context, user string) (*apptypes.UserPreferences, error) {
+	var (
+		newest = genericdb.SortType("DES")
+		where  = &preferencesWhere{
+			Condition: genericdb.QueryCondition("And"),
+			Fields: []*preferencesFields{{
+				User: &model.QueryOperatorAndValue{Op: model.QueryOperatorsEqual, Value: user},
+			}},
+		}
+		query = &genericdb.GenericPagedQuery{
+			Page:  gqlutil.ToGenericPage(genericdb.Page{Limit: 1, Page: 1}),
+			Query: gqlutil.ToGenericQuery(where, &genericdb.GenericRule{}),
+			Sort:  gqlutil.GetSortFields(&preferencesSort{UpdatedAt: &newest}),
+		}
+		result []*apptypes.UserPreferences
+	)
+
+	if _, err := s.muc.GenericClient().PagedQuery(ctx, apptypes.PreferencesCollection, query, &result); err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return &apptypes.UserPreferences{User: user}, nil
+	}
+
+	return result[0], nil
+}
+
+func (s *mongoStore) SaveUserPreferences(ctx context.Context, p *apptypes.UserPreferences) error {
+	return s.muc.GenericClient().InsertOne(ctx, apptypes.PreferencesCollection, p)
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// NewMongoStore creates a store that keeps user preferences in mongo
+func NewMongoStore(muc mongouc.MongoUseCase) apptypes.PreferencesStore {
+	return &mongoStore{muc: muc}
+}
@@ -0,0 +1,21 @@
+package preferences
+
+import (
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+)
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// New creates the store that keeps user preferences, preferences are stored in mongo so that they outlive the app
+// registry and are only kept in memory when the gateway runs without mongo
+func New(opts *apptypes.APIGatewayOptions, log zerolog.Logger) apptypes.PreferencesStore {
+	if opts.MongoUseCase == nil {
+		log.Warn().Msgf("mongo is not configured, user preferences are kept in memory and lost on restart")
+		return NewMemoryStore()
+	}
+
+	return NewMongoStore(opts.MongoUseCase)
+}
@@ -16,6 +16,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/azarc-io/verathread-gateway/internal/cache"
 	apputil "github.com/azarc-io/verathread-gateway/internal/util"
 	"github.com/erni27/imcache"
 	"github.com/rs/zerolog"
@@ -33,26 +34,65 @@ import (
 // 499 too instead of the more problematic 5xx, which does not allow to detect this situation
 const StatusCodeContextCanceled = 499
 
+var (
+	errAssetNotCacheable   = errors.New("asset is no longer cacheable")
+	errAssetRevalidation   = errors.New("asset revalidation failed")
+	errUpstreamUnavailable = errors.New("upstream unavailable")
+)
+
 // proxyCacheTimeoutDuration how long to store cached proxies for
 var proxyCacheTimeoutDuration = time.Minute * 2
 
+// revalidateTimeoutDuration how long to wait for the upstream when revalidating a cached asset
+var revalidateTimeoutDuration = time.Second * 10
+
 type (
 	proxy struct {
 		httpProxyCache *imcache.Sharded[string, *httputil.ReverseProxy]
+		httpClient     *http.Client
 		log            zerolog.Logger
 		filesToScan    []string
+		policy         *cache.Policy
+		assets         *cache.AssetCache
+		fallback       *cache.Fallback
+	}
+
+	// proxyRequest carries the target of a single proxied request through the request context, the reverse proxies
+	// are cached and shared by all requests to a target so their hooks must never capture request state
+	proxyRequest struct {
+		target *apptypes.ProxyTarget
+		url    *url.URL
+		uri    string
+		path   string
+		asset  bool
+		err    error
 	}
+
+	proxyRequestContextKey struct{}
 )
 
-// responseModifier modifies proxied responses, unzipping them if required and scanning for tokens
-func (p *proxy) responseModifier(response *http.Response, c echo.Context) error {
+// proxyRequestFromContext returns the proxied request state, nil if the request is not being proxied
+func proxyRequestFromContext(ctx context.Context) *proxyRequest {
+	pr, _ := ctx.Value(proxyRequestContextKey{}).(*proxyRequest)
+	return pr
+}
+
+// responseModifier modifies proxied responses, unzipping them if required and scanning for tokens, module assets
+// are given caching headers based on whether they are content hashed and stored in the asset cache
+func (p *proxy) responseModifier(response *http.Response) error {
 	var (
+		pr       = proxyRequestFromContext(response.Request.Context())
+		method   = response.Request.Method
 		encoding = response.Header.Get(echo.HeaderContentEncoding)
 		body     []byte
 		err      error
 		reader   io.Reader
 	)
 
+	if pr == nil {
+		return nil
+	}
+
 	if strings.Contains(encoding, "gzip") || strings.Contains(encoding, "deflate") {
 		reader, err = gzip.NewReader(response.Body)
 		if err != nil {
@@ -62,6 +102,7 @@ func (p *proxy) responseModifier(response *http.Response, c echo.Context) error
 		if err != nil {
 			return err
 		}
+		response.Header.Del(echo.HeaderContentEncoding)
 	} else {
 		body, err = io.ReadAll(response.Body)
 		if err != nil {
@@ -70,20 +111,173 @@ func (p *proxy) responseModifier(response *http.Response, c echo.Context) error
 	}
 
 	// will replace and cache tokens in served files
-	if apputil.ShouldReplace(c.Request().URL.Path, p.filesToScan) {
-		body = apputil.ReplaceTokens(c, body)
+	if apputil.ShouldReplace(pr.path, p.filesToScan) {
+		body = apputil.ReplaceTokensForApp(pr.target.Name, body)
 	}
 
 	response.Body = io.NopCloser(bytes.NewBuffer(body))
-	response.Header.Set("Cache-Control", "max-age=31536000")
+	if method != http.MethodHead {
+		response.ContentLength = int64(len(body))
+		response.Header.Set(echo.HeaderContentLength, strconv.Itoa(len(body)))
+	}
+
+	if pr.asset {
+		tgt := pr.target
+
+		// treat gateway errors of the upstream like an unreachable upstream so the fallback can be served
+		if p.fallback != nil && isUnavailableStatus(response.StatusCode) {
+			return fmt.Errorf("%w: %s returned %d", errUpstreamUnavailable, pr.uri, response.StatusCode)
+		}
+
+		if p.fallback != nil && method == http.MethodGet && response.StatusCode == http.StatusOK && !tgt.Overridden {
+			p.fallback.Save(tgt.ID, tgt.Version, pr.uri, response.Header.Clone(), body)
+		}
+
+		if p.assets != nil && method == http.MethodGet && !tgt.Overridden {
+			if entry, stored := p.assets.Store(tgt.ID, tgt.Version, pr.uri, response.StatusCode, response.Header, body); stored {
+				response.Header.Set("ETag", entry.ETag)
+				response.Header.Set(apptypes.CacheStatusHeader, cache.Miss.String())
+			}
+		}
+
+		response.Header.Set("Cache-Control", p.policy.ClientCacheControl(pr.uri, response.Header))
+	}
 
 	return nil
 }
 
-// errorHandler handles proxy errors
-func (p *proxy) errorHandler(resp http.ResponseWriter, req *http.Request, err error, tgt *apptypes.ProxyTarget, c echo.Context) {
-	target := c.Get(apptypes.TargetURLKey).(*url.URL)
-	desc := target.String()
+// serveCached serves a module asset from the asset cache, stale entries are served straight away and revalidated in
+// the background while expired entries are revalidated first, returns false if the request must be proxied which is
+// always the case for developer overrides
+func (p *proxy) serveCached(tgt *apptypes.ProxyTarget, c echo.Context) bool {
+	req := c.Request()
+	if p.assets == nil || tgt.Overridden || (req.Method != http.MethodGet && req.Method != http.MethodHead) {
+		return false
+	}
+
+	entry, state := p.assets.Lookup(tgt.ID, tgt.Version, req.URL.RequestURI())
+
+	switch state {
+	case cache.Miss:
+		return false
+	case cache.Stale:
+		if p.assets.BeginRevalidation(entry) {
+			go func() {
+				defer p.assets.EndRevalidation(entry)
+				if _, err := p.revalidate(tgt, entry); err != nil {
+					p.log.Warn().Err(err).Str("uri", entry.URI).Msgf("failed to revalidate stale asset")
+				}
+			}()
+		}
+	case cache.Expired:
+		updated, err := p.revalidate(tgt, entry)
+		if errors.Is(err, errUpstreamUnavailable) {
+			p.log.Warn().Err(err).Str("uri", entry.URI).Msgf("failed to revalidate expired asset, serving degraded")
+			p.writeFallback(c.Response(), req, entry)
+			return true
+		}
+		if err != nil {
+			// the upstream answered, let the request through so that the client sees the upstream response
+			p.log.Debug().Err(err).Str("uri", entry.URI).Msgf("expired asset could not be revalidated, proxying")
+			return false
+		}
+		entry = updated
+	}
+
+	h := c.Response().Header()
+	for k, v := range entry.Header {
+		h[k] = append([]string(nil), v...)
+	}
+	h.Set("ETag", entry.ETag)
+	h.Set("Cache-Control", p.policy.ClientCacheControl(entry.URI, entry.Header))
+	h.Set(apptypes.CacheStatusHeader, state.String())
+
+	if etagMatches(req.Header.Get("If-None-Match"), entry.ETag) {
+		c.Response().WriteHeader(http.StatusNotModified)
+		return true
+	}
+
+	h.Set(echo.HeaderContentLength, strconv.Itoa(len(entry.Body)))
+	c.Response().WriteHeader(entry.Status)
+
+	if req.Method == http.MethodGet {
+		if _, err := c.Response().Write(entry.Body); err != nil {
+			p.log.Warn().Err(err).Str("uri", entry.URI).Msgf("failed to write cached asset")
+		}
+	}
+
+	return true
+}
+
+// revalidate asks the upstream whether a cached asset changed, conditional headers are used when the upstream
+// provided them so that unchanged assets are not transferred again
+func (p *proxy) revalidate(tgt *apptypes.ProxyTarget, entry *cache.Entry) (*cache.Entry, error) {
+	ref, err := url.ParseRequestURI(entry.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	target := tgt.WebURL.JoinPath(ref.Path)
+	target.RawQuery = ref.RawQuery
+
+	ctx, cancel := context.WithTimeout(context.Background(), revalidateTimeoutDuration)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.UpstreamETag != "" {
+		req.Header.Set("If-None-Match", entry.UpstreamETag)
+	}
+
+	if entry.LastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.LastModified)
+	}
+
+	rsp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUpstreamUnavailable, err)
+	}
+	defer rsp.Body.Close()
+
+	switch rsp.StatusCode {
+	case http.StatusNotModified:
+		return p.assets.Refresh(entry, rsp.Header), nil
+	case http.StatusOK:
+		body, err := io.ReadAll(rsp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		if apputil.ShouldReplace(ref.Path, p.filesToScan) {
+			body = apputil.ReplaceTokensForApp(tgt.Name, body)
+		}
+
+		if updated, ok := p.assets.Store(tgt.ID, tgt.Version, entry.URI, rsp.StatusCode, rsp.Header, body); ok {
+			return updated, nil
+		}
+
+		return nil, fmt.Errorf("%w: %s", errAssetNotCacheable, entry.URI)
+	default:
+		if isUnavailableStatus(rsp.StatusCode) {
+			return nil, fmt.Errorf("%w: %s returned %d", errUpstreamUnavailable, entry.URI, rsp.StatusCode)
+		}
+		return nil, fmt.Errorf("%w: %s returned %d", errAssetRevalidation, entry.URI, rsp.StatusCode)
+	}
+}
+
+// errorHandler handles proxy errors, the error is recorded on the proxied request and reported once the proxy returns
+func (p *proxy) errorHandler(resp http.ResponseWriter, req *http.Request, err error) {
+	pr := proxyRequestFromContext(req.Context())
+	if pr == nil {
+		resp.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	tgt := pr.target
+	desc := pr.url.String()
 	if tgt.Name != "" {
 		desc = fmt.Sprintf("%s(%s)", tgt.Name, desc)
 	}
@@ -95,14 +289,80 @@ func (p *proxy) errorHandler(resp http.ResponseWriter, req *http.Request, err er
 	if errors.Is(err, context.Canceled) || strings.Contains(err.Error(), "operation was canceled") {
 		httpError := echo.NewHTTPError(StatusCodeContextCanceled, fmt.Sprintf("client closed connection: %v", err))
 		httpError.Internal = err
-		c.Set("_error", httpError)
+		pr.err = httpError
 	} else {
-		httpError := echo.NewHTTPError(http.StatusBadGateway, fmt.Sprintf("remote %s unreachable, could not forward: %v", desc, err))
+		if p.serveFallback(resp, req, pr) {
+			p.log.Warn().Err(err).Str("app", tgt.Name).Msgf("remote %s unreachable, served last known good asset", desc)
+			return
+		}
+
+		p.log.Warn().Err(err).Str("app", tgt.Name).Msgf("remote %s unreachable, could not forward", desc)
+
+		httpError := echo.NewHTTPError(http.StatusBadGateway, fmt.Sprintf("app %s is unreachable", tgt.Name))
 		httpError.Internal = err
-		c.Set("_error", httpError)
+		pr.err = httpError
 	}
 }
 
+// serveFallback serves the last known good copy of a module asset when the upstream can not be reached, returns false
+// if this is not an asset request or no copy exists
+func (p *proxy) serveFallback(resp http.ResponseWriter, req *http.Request, pr *proxyRequest) bool {
+	if !pr.asset || p.fallback == nil {
+		return false
+	}
+
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+
+	entry := p.fallback.Load(pr.target.ID, pr.target.Version, pr.uri)
+	if entry == nil {
+		return false
+	}
+
+	p.writeFallback(resp, req, entry)
+
+	return true
+}
+
+// writeFallback writes a degraded response, the fallback headers tell the shell that the app is being served stale
+// and browsers are told not to cache it
+func (p *proxy) writeFallback(resp http.ResponseWriter, req *http.Request, entry *cache.Entry) {
+	h := resp.Header()
+	for k, v := range entry.Header {
+		h[k] = append([]string(nil), v...)
+	}
+	h.Set("Cache-Control", "no-store")
+	h.Set(echo.HeaderContentLength, strconv.Itoa(len(entry.Body)))
+	h.Set(apptypes.FallbackHeader, "stale")
+	h.Set(apptypes.FallbackVersionHeader, entry.Version)
+	h.Set("Warning", `110 - "Response is Stale"`)
+
+	resp.WriteHeader(http.StatusOK)
+
+	if req.Method == http.MethodGet {
+		if _, err := resp.Write(entry.Body); err != nil {
+			p.log.Warn().Err(err).Str("uri", entry.URI).Msgf("failed to write fallback asset")
+		}
+	}
+}
+
+// isUnavailableStatus returns true for status codes that indicate the upstream app itself is down
+func isUnavailableStatus(status int) bool {
+	return status == http.StatusBadGateway || status == http.StatusServiceUnavailable || status == http.StatusGatewayTimeout
+}
+
+// proxyError returns the error recorded while proxying a request, nil if the request succeeded or the response has
+// already been written
+func (p *proxy) proxyError(c echo.Context) error {
+	err, ok := c.Get("_error").(error)
+	if !ok || c.Response().Committed {
+		return nil
+	}
+
+	return err
+}
+
 // proxyRaw proxies raw TCP connection, capable of handling web sockets and SSE
 func (p *proxy) proxyRaw(t *apptypes.ProxyTarget, c echo.Context) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -112,23 +372,13 @@ func (p *proxy) proxyRaw(t *apptypes.ProxyTarget, c echo.Context) http.Handler {
 			err     error
 		)
 
-		in, _, err = c.Response().Hijack()
-		if err != nil {
-			c.Set("_error", fmt.Errorf("proxy raw, hijack error=%w, url=%s", err, target.String()))
-			return
-		}
-		defer func(in net.Conn) {
-			err = in.Close()
-			if err != nil {
-				p.log.Warn().Err(err).Msgf("error while closing inbound proxy connection")
-			}
-		}(in)
-
+		// dial before hijacking so that an unreachable app can still be reported with a proper status code
 		out, err = net.Dial("tcp", target.Host)
 		if err != nil {
 			log.Warn().Err(err).Str("url", target.String()).Msgf("proxy raw, dial error")
-			c.Set("_error", echo.NewHTTPError(http.StatusBadGateway,
-				fmt.Sprintf("proxy raw, dial error=%v, url=%s", err, target.String())))
+			httpError := echo.NewHTTPError(http.StatusBadGateway, fmt.Sprintf("app %s is unreachable", t.Name))
+			httpError.Internal = fmt.Errorf("proxy raw, dial error=%w, url=%s", err, target.String())
+			c.Set("_error", httpError)
 			return
 		}
 		defer func(out net.Conn) {
@@ -138,11 +388,22 @@ func (p *proxy) proxyRaw(t *apptypes.ProxyTarget, c echo.Context) http.Handler {
 			}
 		}(out)
 
+		in, _, err = c.Response().Hijack()
+		if err != nil {
+			c.Set("_error", fmt.Errorf("proxy raw, hijack error=%w, url=%s", err, target.String()))
+			return
+		}
+		defer func(in net.Conn) {
+			err = in.Close()
+			if err != nil {
+				p.log.Warn().Err(err).Msgf("error while closing inbound proxy connection")
+			}
+		}(in)
+
 		// Write header
 		err = r.Write(out)
 		if err != nil {
-			c.Set("_error", echo.NewHTTPError(http.StatusBadGateway,
-				fmt.Sprintf("proxy raw, request header copy error=%v, url=%s", err, target.String())))
+			p.log.Warn().Err(err).Str("url", target.String()).Msgf("proxy raw, request header copy error")
 			return
 		}
 
@@ -158,33 +419,53 @@ func (p *proxy) proxyRaw(t *apptypes.ProxyTarget, c echo.Context) http.Handler {
 		err = <-errCh
 		if err != nil && err != io.EOF {
 			p.log.Warn().Err(err).Str("url", target.String()).Msgf("proxy raw, copy body error")
-			c.Set("_error", fmt.Errorf("proxy raw, copy body error=%w, url=%s", err, target.String()))
 		}
 	})
 }
 
 // proxyHTTP proxies http requests, uses caching to improve performance and reduce memory allocations
 func (p *proxy) proxyHTTP(tgt *apptypes.ProxyTarget, c echo.Context) http.Handler {
-	target := c.Get(apptypes.TargetURLKey).(*url.URL)
-	proxy, exists := p.httpProxyCache.Get(target.String())
+	var (
+		target   = c.Get(apptypes.TargetURLKey).(*url.URL)
+		asset, _ = c.Get(apptypes.AssetRequestKey).(bool)
+		proxy    = p.httpProxy(target)
+	)
 
-	if !exists {
-		log.Info().Str("target", target.String()).Msgf("creating new proxy for target service")
-		proxy = httputil.NewSingleHostReverseProxy(target)
-		p.httpProxyCache.Set(target.String(), proxy, imcache.WithExpiration(proxyCacheTimeoutDuration))
-	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pr := &proxyRequest{target: tgt, url: target, uri: r.URL.RequestURI(), path: r.URL.Path, asset: asset}
+		proxy.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), proxyRequestContextKey{}, pr)))
 
-	proxy.ModifyResponse = func(response *http.Response) error {
-		return p.responseModifier(response, c)
-	}
+		if pr.err != nil {
+			c.Set("_error", pr.err)
+		}
+	})
+}
 
-	proxy.ErrorHandler = func(resp http.ResponseWriter, req *http.Request, err error) {
-		p.errorHandler(resp, req, err, tgt, c)
+// httpProxy returns the cached reverse proxy for a target url, the hooks are set once when the proxy is created and
+// read everything they need from the proxied request
+func (p *proxy) httpProxy(target *url.URL) *httputil.ReverseProxy {
+	if proxy, exists := p.httpProxyCache.Get(target.String()); exists {
+		return proxy
 	}
 
+	log.Info().Str("target", target.String()).Msgf("creating new proxy for target service")
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	proxy.ModifyResponse = p.responseModifier
+	proxy.ErrorHandler = p.errorHandler
+	p.httpProxyCache.Set(target.String(), proxy, imcache.WithExpiration(proxyCacheTimeoutDuration))
+
 	return proxy
 }
 
+// evict drops the cached reverse proxies for the given target urls
+func (p *proxy) evict(urls ...string) {
+	for _, u := range urls {
+		if p.httpProxyCache.Remove(u) {
+			p.log.Debug().Str("target", u).Msgf("evicted http proxy from cache")
+		}
+	}
+}
+
 // rewriteURL applies any url re-write expressions
 func (p *proxy) rewriteURL(rewriteRegex map[*regexp.Regexp]string, req *http.Request) error {
 	if len(rewriteRegex) == 0 {
@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/azarc-io/verathread-gateway/internal/cache"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/erni27/imcache"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAssetProxy creates a proxy that caches module assets and keeps them as a fallback
+func newAssetProxy(t *testing.T) *proxy {
+	t.Helper()
+
+	cfg := &apptypes.AssetCacheConfig{Enabled: true}
+	policy, err := cache.NewPolicy(cfg)
+	require.NoError(t, err)
+
+	return &proxy{
+		log:            zerolog.Nop(),
+		httpClient:     http.DefaultClient,
+		httpProxyCache: imcache.NewSharded[string, *httputil.ReverseProxy](apptypes.CacheShards, imcache.DefaultStringHasher64{}),
+		policy:         policy,
+		assets:         cache.NewAssetCache(cfg, policy, "", zerolog.Nop()),
+		fallback:       cache.NewFallback(&apptypes.FallbackConfig{Enabled: true}, policy, t.TempDir(), zerolog.Nop()),
+	}
+}
+
+// serveAsset proxies an asset request the way the web route of the gateway does
+func serveAsset(p *proxy, e *echo.Echo, tgt *apptypes.ProxyTarget, uri string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest(http.MethodGet, uri, http.NoBody)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set(apptypes.TargetURLKey, tgt.WebURL)
+	c.Set(apptypes.AppNameKey, tgt.Name)
+	c.Set(apptypes.AssetRequestKey, true)
+
+	p.proxyHTTP(tgt, c).ServeHTTP(rec, req)
+
+	return rec, p.proxyError(c)
+}
+
+func TestProxyHTTP_ConcurrentAssets(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=60")
+		_, _ = w.Write([]byte(r.URL.RequestURI()))
+	}))
+	defer upstream.Close()
+
+	var (
+		p      = newAssetProxy(t)
+		e      = echo.New()
+		web, _ = url.Parse(upstream.URL)
+		tgt    = &apptypes.ProxyTarget{ID: "app", Name: "app", Version: "1.0.0", WebURL: web}
+		wg     sync.WaitGroup
+	)
+
+	// all requests share the cached reverse proxy of the target, every response must be stored under its own uri
+	for i := 0; i < 50; i++ {
+		uri := fmt.Sprintf("/app/app/chunk.%08x.js", i)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			rec, err := serveAsset(p, e, tgt, uri)
+			assert.NoError(t, err)
+			assert.Equal(t, uri, rec.Body.String())
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < 50; i++ {
+		uri := fmt.Sprintf("/app/app/chunk.%08x.js", i)
+
+		entry, state := p.assets.Lookup(tgt.ID, tgt.Version, uri)
+		require.Equal(t, cache.Fresh, state, uri)
+		assert.Equal(t, uri, string(entry.Body))
+
+		fallback := p.fallback.Load(tgt.ID, tgt.Version, uri)
+		require.NotNil(t, fallback, uri)
+		assert.Equal(t, uri, string(fallback.Body))
+	}
+}
+
+func TestProxyHTTP_Unreachable(t *testing.T) {
+	upstream := httptest.NewServer(http.NotFoundHandler())
+	web, _ := url.Parse(upstream.URL)
+	upstream.Close()
+
+	var (
+		p   = newAssetProxy(t)
+		e   = echo.New()
+		tgt = &apptypes.ProxyTarget{ID: "app", Name: "app", Version: "1.0.0", WebURL: web}
+	)
+
+	_, err := serveAsset(p, e, tgt, "/app/app/remoteEntry.js")
+
+	var httpError *echo.HTTPError
+	require.ErrorAs(t, err, &httpError)
+	assert.Equal(t, http.StatusBadGateway, httpError.Code)
+
+	// the last known good copy is served once there is one
+	p.fallback.Save(tgt.ID, tgt.Version, "/app/app/remoteEntry.js", http.Header{}, []byte("last known good"))
+
+	rec, err := serveAsset(p, e, tgt, "/app/app/remoteEntry.js")
+	require.NoError(t, err)
+	assert.Equal(t, "last known good", rec.Body.String())
+	assert.Equal(t, "stale", rec.Header().Get(apptypes.FallbackHeader))
+}
+
+func TestRevalidate_NotModified(t *testing.T) {
+	var requests int
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.Header().Set("Cache-Control", "max-age=60")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v2"`)
+		_, _ = w.Write([]byte("changed"))
+	}))
+	defer upstream.Close()
+
+	var (
+		p      = newAssetProxy(t)
+		web, _ = url.Parse(upstream.URL)
+		tgt    = &apptypes.ProxyTarget{ID: "app", Name: "app", Version: "1.0.0", WebURL: web}
+		header = http.Header{"Etag": []string{`"v1"`}, "Cache-Control": []string{"max-age=0, stale-while-revalidate=0"}}
+	)
+
+	entry, ok := p.assets.Store(tgt.ID, tgt.Version, "/remoteEntry.js", http.StatusOK, header, []byte("original"))
+	require.True(t, ok)
+
+	// the upstream confirms the cached copy with a 304, the body is kept and the entry is fresh again
+	refreshed, err := p.revalidate(tgt, entry)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("original"), refreshed.Body)
+	assert.Equal(t, entry.ETag, refreshed.ETag)
+
+	_, state := p.assets.Lookup(tgt.ID, tgt.Version, "/remoteEntry.js")
+	assert.Equal(t, cache.Fresh, state)
+
+	// a changed asset replaces the cached copy
+	entry.UpstreamETag = `"stale"`
+	updated, err := p.revalidate(tgt, entry)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("changed"), updated.Body)
+	assert.NotEqual(t, entry.ETag, updated.ETag)
+	assert.Equal(t, 2, requests)
+}
@@ -0,0 +1,141 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog"
+)
+
+// leaderKey is the key of the leader lock in the election bucket
+const leaderKey = "leader"
+
+type (
+	// memoryElection promotes the only replica straight away, used together with the memory registry
+	memoryElection struct{}
+
+	// natsElection elects a leader by creating a key in a key value bucket with a ttl, the leader keeps updating the
+	// key while the other replicas try to create it, when the leader goes away the key expires and the next replica
+	// to create it takes over
+	natsElection struct {
+		ctx    context.Context
+		nuc    NatsClient
+		bucket string
+		ttl    time.Duration
+		id     string
+		log    zerolog.Logger
+	}
+)
+
+func (e *memoryElection) SubscribeToElectionEvents(fn func(onPromote <-chan time.Time, onDemote <-chan time.Time)) {
+	promote := make(chan time.Time, 1)
+	promote <- time.Now()
+
+	go fn(promote, make(chan time.Time))
+}
+
+func (e *natsElection) SubscribeToElectionEvents(fn func(onPromote <-chan time.Time, onDemote <-chan time.Time)) {
+	promote, demote := make(chan time.Time), make(chan time.Time)
+
+	go fn(promote, demote)
+	go e.campaign(promote, demote)
+}
+
+// campaign tries to take or keep the leader lock every third of the ttl until the context is cancelled, the lock is
+// released on the way out so that another replica can take over without waiting for the ttl
+func (e *natsElection) campaign(promote, demote chan<- time.Time) {
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	var (
+		kv       jetstream.KeyValue
+		revision uint64
+		err      error
+	)
+
+	for {
+		leading := revision != 0
+
+		if kv == nil {
+			if kv, err = e.init(); err != nil {
+				e.log.Warn().Err(err).Msgf("could not create the leader election bucket")
+			}
+		}
+
+		if kv != nil {
+			if leading {
+				revision, err = kv.Update(e.ctx, leaderKey, []byte(e.id), revision)
+			} else {
+				revision, err = kv.Create(e.ctx, leaderKey, []byte(e.id))
+			}
+
+			if err != nil {
+				revision = 0
+				if !isRevisionConflict(err) && !errors.Is(err, context.Canceled) {
+					e.log.Warn().Err(err).Msgf("could not acquire the leader lock")
+				}
+			}
+		}
+
+		switch {
+		case !leading && revision != 0:
+			e.log.Info().Str("id", e.id).Msgf("promoted to leader")
+			e.notify(promote)
+		case leading && revision == 0:
+			e.log.Info().Str("id", e.id).Msgf("demoted from leader")
+			e.notify(demote)
+		}
+
+		select {
+		case <-e.ctx.Done():
+			if revision != 0 {
+				ctx, cancel := context.WithTimeout(context.Background(), e.ttl)
+				_ = kv.Delete(ctx, leaderKey, jetstream.LastRevision(revision))
+				cancel()
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// notify sends an election event unless the election has been stopped
+func (e *natsElection) notify(ch chan<- time.Time) {
+	select {
+	case ch <- time.Now():
+	case <-e.ctx.Done():
+	}
+}
+
+// init creates the election bucket, entries expire after the ttl unless they are updated
+func (e *natsElection) init() (jetstream.KeyValue, error) {
+	js, err := jetstream.New(e.nuc.Client())
+	if err != nil {
+		return nil, err
+	}
+
+	return js.CreateOrUpdateKeyValue(e.ctx, jetstream.KeyValueConfig{Bucket: e.bucket + "_election", TTL: e.ttl})
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// NewNatsElection creates a leader election backed by a jetstream key value bucket, a leader that stops updating its
+// lock loses it after the ttl, the election ends when the context is cancelled
+func NewNatsElection(
+	ctx context.Context, nuc NatsClient, bucket string, ttl time.Duration, log zerolog.Logger,
+) apptypes.LeaderElection {
+	return &natsElection{
+		ctx:    ctx,
+		nuc:    nuc,
+		bucket: bucket,
+		ttl:    ttl,
+		id:     uuid.NewString(),
+		log:    log,
+	}
+}
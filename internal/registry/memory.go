@@ -0,0 +1,413 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+)
+
+type (
+	// memoryRegistry keeps everything in process, it is intended for tests and single node development, values are
+	// stored encoded so that callers never share state with the registry
+	memoryRegistry struct {
+		mu            sync.Mutex
+		ttl           time.Duration
+		apps          map[string][]byte
+		heartbeats    map[string]time.Time
+		history       map[string][][]byte
+		maintenance   map[string][]byte
+		overrides     map[string][]byte
+		configuration map[string][]byte
+		versions      map[string]map[int][]byte
+		version       int
+	}
+)
+
+func (r *memoryRegistry) GetApp(_ context.Context, id string) (*apptypes.App, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.apps[id]
+	if !ok {
+		return nil, apptypes.ErrAppNotFound
+	}
+
+	var app apptypes.App
+	if err := json.Unmarshal(b, &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+func (r *memoryRegistry) ListApps(_ context.Context) ([]*apptypes.App, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	apps := make([]*apptypes.App, 0, len(r.apps))
+	for _, b := range r.apps {
+		var app apptypes.App
+		if err := json.Unmarshal(b, &app); err != nil {
+			return nil, err
+		}
+		apps = append(apps, &app)
+	}
+
+	return apps, nil
+}
+
+func (r *memoryRegistry) SaveApp(_ context.Context, app *apptypes.App) error {
+	b, err := json.Marshal(app)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.apps[app.ID] = b
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *memoryRegistry) SetAppAvailable(_ context.Context, id string, available bool) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.apps[id]
+	if !ok {
+		return false, apptypes.ErrAppNotFound
+	}
+
+	var app apptypes.App
+	if err := json.Unmarshal(b, &app); err != nil {
+		return false, err
+	}
+
+	if app.Available == available {
+		return false, nil
+	}
+
+	app.Available = available
+
+	b, err := json.Marshal(&app)
+	if err != nil {
+		return false, err
+	}
+	r.apps[id] = b
+
+	return true, nil
+}
+
+func (r *memoryRegistry) DeleteApp(_ context.Context, id string) error {
+	r.mu.Lock()
+	delete(r.apps, id)
+	delete(r.heartbeats, id)
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *memoryRegistry) AppendHistory(_ context.Context, rev *apptypes.AppRevision, limit int) error {
+	b, err := json.Marshal(rev)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history := append([][]byte{b}, r.history[rev.App.ID]...)
+	if len(history) > limit {
+		history = history[:limit]
+	}
+	r.history[rev.App.ID] = history
+
+	return nil
+}
+
+func (r *memoryRegistry) ListHistory(_ context.Context, id string) ([]*apptypes.AppRevision, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history := make([]*apptypes.AppRevision, 0, len(r.history[id]))
+	for _, b := range r.history[id] {
+		var rev apptypes.AppRevision
+		if err := json.Unmarshal(b, &rev); err != nil {
+			return nil, err
+		}
+		history = append(history, &rev)
+	}
+
+	return history, nil
+}
+
+func (r *memoryRegistry) Heartbeat(_ context.Context, id string) error {
+	r.mu.Lock()
+	r.heartbeats[id] = time.Now().Add(r.ttl)
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *memoryRegistry) RefreshHeartbeat(_ context.Context, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	expires, ok := r.heartbeats[id]
+	if !ok || time.Now().After(expires) {
+		return false, nil
+	}
+
+	r.heartbeats[id] = time.Now().Add(r.ttl)
+
+	return true, nil
+}
+
+func (r *memoryRegistry) ListHeartbeats(_ context.Context) (map[string]bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		now   = time.Now()
+		alive = make(map[string]bool, len(r.heartbeats))
+	)
+
+	for id, expires := range r.heartbeats {
+		if now.Before(expires) {
+			alive[id] = true
+		}
+	}
+
+	return alive, nil
+}
+
+// WatchExpired checks for expired heartbeats every second, blocks until the context is cancelled
+func (r *memoryRegistry) WatchExpired(ctx context.Context, fn func(id string)) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			for _, id := range r.expire(now) {
+				fn(id)
+			}
+		}
+	}
+}
+
+func (r *memoryRegistry) GetConfiguration(_ context.Context, locale string) (*model.ShellConfiguration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.configuration[locale]
+	if !ok {
+		return nil, apptypes.ErrConfigurationNotFound
+	}
+
+	var configuration model.ShellConfiguration
+	if err := json.Unmarshal(b, &configuration); err != nil {
+		return nil, err
+	}
+
+	return &configuration, nil
+}
+
+func (r *memoryRegistry) SaveConfiguration(_ context.Context, locale string, configuration *model.ShellConfiguration) error {
+	b, err := json.Marshal(configuration)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.configuration[locale] = b
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *memoryRegistry) GetConfigurationVersion(_ context.Context, locale string, version int) (*model.ShellConfiguration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.versions[locale][version]
+	if !ok {
+		return nil, apptypes.ErrConfigurationNotFound
+	}
+
+	var configuration model.ShellConfiguration
+	if err := json.Unmarshal(b, &configuration); err != nil {
+		return nil, err
+	}
+
+	return &configuration, nil
+}
+
+func (r *memoryRegistry) SaveConfigurationVersion(_ context.Context, locale string, configuration *model.ShellConfiguration) error {
+	b, err := json.Marshal(configuration)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.versions[locale] == nil {
+		r.versions[locale] = make(map[int][]byte)
+	}
+	r.versions[locale][configuration.Version] = b
+
+	return nil
+}
+
+func (r *memoryRegistry) DeleteConfigurationVersion(_ context.Context, locale string, version int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// the locale is dropped with its last version so that removed locales do not linger
+	delete(r.versions[locale], version)
+	if len(r.versions[locale]) == 0 {
+		delete(r.versions, locale)
+	}
+
+	return nil
+}
+
+func (r *memoryRegistry) ListConfigurationVersions(_ context.Context) (map[string][]int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make(map[string][]int, len(r.versions))
+	for locale, versions := range r.versions {
+		for version := range versions {
+			out[locale] = append(out[locale], version)
+		}
+		slices.Sort(out[locale])
+	}
+
+	return out, nil
+}
+
+func (r *memoryRegistry) NextConfigurationVersion(_ context.Context, current int) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.version = max(r.version, current) + 1
+
+	return r.version, nil
+}
+
+func (r *memoryRegistry) ListMaintenance(_ context.Context) (map[string]*apptypes.Maintenance, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	windows := make(map[string]*apptypes.Maintenance, len(r.maintenance))
+	for field, b := range r.maintenance {
+		var m apptypes.Maintenance
+		if err := json.Unmarshal(b, &m); err != nil {
+			return nil, err
+		}
+		windows[field] = &m
+	}
+
+	return windows, nil
+}
+
+func (r *memoryRegistry) SaveMaintenance(_ context.Context, field string, m *apptypes.Maintenance) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.maintenance[field] = b
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *memoryRegistry) DeleteMaintenance(_ context.Context, field string) error {
+	r.mu.Lock()
+	delete(r.maintenance, field)
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *memoryRegistry) ListNavigationOverrides(_ context.Context) (map[string]*apptypes.NavigationOverride, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	overrides := make(map[string]*apptypes.NavigationOverride, len(r.overrides))
+	for id, b := range r.overrides {
+		var o apptypes.NavigationOverride
+		if err := json.Unmarshal(b, &o); err != nil {
+			return nil, err
+		}
+		overrides[id] = &o
+	}
+
+	return overrides, nil
+}
+
+func (r *memoryRegistry) SaveNavigationOverride(_ context.Context, o *apptypes.NavigationOverride) error {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.overrides[o.ID] = b
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *memoryRegistry) DeleteNavigationOverride(_ context.Context, id string) error {
+	r.mu.Lock()
+	delete(r.overrides, id)
+	r.mu.Unlock()
+
+	return nil
+}
+
+// expire removes and returns the ids of all heartbeats that have expired
+func (r *memoryRegistry) expire(now time.Time) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var expired []string
+	for id, expires := range r.heartbeats {
+		if now.After(expires) {
+			expired = append(expired, id)
+			delete(r.heartbeats, id)
+		}
+	}
+
+	return expired
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// NewMemoryRegistry creates a registry that keeps all state in memory, heartbeats expire after the given ttl
+func NewMemoryRegistry(ttl time.Duration) apptypes.Registry {
+	return &memoryRegistry{
+		ttl:           ttl,
+		apps:          make(map[string][]byte),
+		heartbeats:    make(map[string]time.Time),
+		history:       make(map[string][][]byte),
+		maintenance:   make(map[string][]byte),
+		overrides:     make(map[string][]byte),
+		configuration: make(map[string][]byte),
+		versions:      make(map[string]map[int][]byte),
+	}
+}
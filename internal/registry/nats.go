@@ -0,0 +1,769 @@
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog"
+)
+
+const (
+	configurationEntry  = "shell.configuration"
+	versionPrefix       = "shell.version."
+	versionCounterEntry = "shell.counter"
+	maintenancePrefix   = "maintenance."
+	historyPrefix       = "history."
+	overridePrefix      = "override."
+
+	// errCodeWrongLastSequence is returned by jetstream when the expected revision of a key does not match
+	errCodeWrongLastSequence jetstream.ErrorCode = 10071
+)
+
+var (
+	errWatcherClosed  = errors.New("heartbeat watcher closed")
+	errTooManyUpdates = errors.New("too many concurrent updates")
+)
+
+type (
+	// NatsClient is the part of the nats use case the nats registry and election need
+	NatsClient interface {
+		Client() *nats.Conn
+	}
+
+	// natsRegistry stores apps in jetstream key value buckets, heartbeats live in a bucket with a ttl so expired
+	// entries are removed by the server while a watch on that bucket is used to report apps that stopped sending them
+	natsRegistry struct {
+		nuc    NatsClient
+		bucket string
+		ttl    time.Duration
+		log    zerolog.Logger
+
+		mu         sync.Mutex
+		apps       jetstream.KeyValue
+		heartbeats jetstream.KeyValue
+		config     jetstream.KeyValue
+	}
+)
+
+func (r *natsRegistry) GetApp(ctx context.Context, id string) (*apptypes.App, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+
+	entry, err := r.apps.Get(ctx, encodeKey(id))
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, apptypes.ErrAppNotFound
+		}
+		return nil, err
+	}
+
+	var app apptypes.App
+	if err := json.Unmarshal(entry.Value(), &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+func (r *natsRegistry) ListApps(ctx context.Context) ([]*apptypes.App, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+
+	keys, err := r.apps.Keys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return []*apptypes.App{}, nil
+		}
+		return nil, err
+	}
+
+	apps := make([]*apptypes.App, 0, len(keys))
+	for _, key := range keys {
+		entry, err := r.apps.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		var app apptypes.App
+		if err := json.Unmarshal(entry.Value(), &app); err != nil {
+			return nil, err
+		}
+		apps = append(apps, &app)
+	}
+
+	return apps, nil
+}
+
+func (r *natsRegistry) SaveApp(ctx context.Context, app *apptypes.App) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(app)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.apps.Put(ctx, encodeKey(app.ID), b)
+
+	return err
+}
+
+// SetAppAvailable updates the app with its last known revision, the update is retried if another replica changed the
+// app in the meantime
+func (r *natsRegistry) SetAppAvailable(ctx context.Context, id string, available bool) (bool, error) {
+	if err := r.init(ctx); err != nil {
+		return false, err
+	}
+
+	for i := 0; i < maxTxRetries; i++ {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		entry, err := r.apps.Get(ctx, encodeKey(id))
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				return false, apptypes.ErrAppNotFound
+			}
+			return false, err
+		}
+
+		var app apptypes.App
+		if err := json.Unmarshal(entry.Value(), &app); err != nil {
+			return false, err
+		}
+
+		if app.Available == available {
+			return false, nil
+		}
+
+		app.Available = available
+
+		b, err := json.Marshal(&app)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = r.apps.Update(ctx, encodeKey(id), b, entry.Revision())
+		if err == nil {
+			return true, nil
+		}
+
+		if !isRevisionConflict(err) {
+			return false, err
+		}
+	}
+
+	return false, fmt.Errorf("failed to update availability of app %s: %w", id, errTooManyUpdates)
+}
+
+func (r *natsRegistry) DeleteApp(ctx context.Context, id string) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	if err := r.apps.Purge(ctx, encodeKey(id)); err != nil {
+		return err
+	}
+
+	return r.heartbeats.Purge(ctx, encodeKey(id))
+}
+
+// AppendHistory stores the history of an app as a single entry, the update is retried if another replica changed the
+// history in the meantime
+func (r *natsRegistry) AppendHistory(ctx context.Context, rev *apptypes.AppRevision, limit int) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	key := historyPrefix + encodeKey(rev.App.ID)
+
+	for i := 0; i < maxTxRetries; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var (
+			history  []*apptypes.AppRevision
+			revision uint64
+		)
+
+		entry, err := r.config.Get(ctx, key)
+		switch {
+		case err == nil:
+			revision = entry.Revision()
+			if err := json.Unmarshal(entry.Value(), &history); err != nil {
+				return err
+			}
+		case !errors.Is(err, jetstream.ErrKeyNotFound):
+			return err
+		}
+
+		history = append([]*apptypes.AppRevision{rev}, history...)
+		if len(history) > limit {
+			history = history[:limit]
+		}
+
+		b, err := json.Marshal(history)
+		if err != nil {
+			return err
+		}
+
+		if revision == 0 {
+			_, err = r.config.Create(ctx, key, b)
+		} else {
+			_, err = r.config.Update(ctx, key, b, revision)
+		}
+
+		if err == nil || !isRevisionConflict(err) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("failed to append history of app %s: %w", rev.App.ID, errTooManyUpdates)
+}
+
+func (r *natsRegistry) ListHistory(ctx context.Context, id string) ([]*apptypes.AppRevision, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+
+	var history []*apptypes.AppRevision
+
+	entry, err := r.config.Get(ctx, historyPrefix+encodeKey(id))
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return history, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(entry.Value(), &history); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (r *natsRegistry) Heartbeat(ctx context.Context, id string) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	_, err := r.heartbeats.Put(ctx, encodeKey(id), []byte(time.Now().Format(time.RFC3339)))
+
+	return err
+}
+
+// RefreshHeartbeat only refreshes heartbeats that have not expired yet, the server removes expired entries lazily so
+// the age of the entry is checked as well
+func (r *natsRegistry) RefreshHeartbeat(ctx context.Context, id string) (bool, error) {
+	if err := r.init(ctx); err != nil {
+		return false, err
+	}
+
+	entry, err := r.heartbeats.Get(ctx, encodeKey(id))
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if time.Since(entry.Created()) > r.ttl {
+		return false, nil
+	}
+
+	return true, r.Heartbeat(ctx, id)
+}
+
+// ListHeartbeats returns the ids of all apps that sent a heartbeat within the ttl
+func (r *natsRegistry) ListHeartbeats(ctx context.Context) (map[string]bool, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+
+	alive := make(map[string]bool)
+
+	keys, err := r.heartbeats.Keys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return alive, nil
+		}
+		return nil, err
+	}
+
+	for _, key := range keys {
+		entry, err := r.heartbeats.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		if time.Since(entry.Created()) > r.ttl {
+			continue
+		}
+
+		id, err := decodeKey(key)
+		if err != nil {
+			r.log.Warn().Err(err).Str("key", key).Msgf("could not decode heartbeat key")
+			continue
+		}
+
+		alive[id] = true
+	}
+
+	return alive, nil
+}
+
+// WatchExpired watches the heartbeat bucket and reports apps that have not sent a heartbeat within the ttl, entries
+// removed by the bucket ttl do not produce an update so the last seen time of every app is tracked, blocks until
+// the context is cancelled
+func (r *natsRegistry) WatchExpired(ctx context.Context, fn func(id string)) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	watcher, err := r.heartbeats.WatchAll(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = watcher.Stop()
+	}()
+
+	var (
+		ticker   = time.NewTicker(time.Second)
+		lastSeen = make(map[string]time.Time)
+	)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case entry, ok := <-watcher.Updates():
+			if !ok {
+				return errWatcherClosed
+			}
+
+			// a nil entry marks the end of the initial values
+			if entry == nil {
+				continue
+			}
+
+			if entry.Operation() == jetstream.KeyValuePut {
+				lastSeen[entry.Key()] = entry.Created()
+			} else {
+				delete(lastSeen, entry.Key())
+			}
+		case now := <-ticker.C:
+			for key, seen := range lastSeen {
+				if now.Sub(seen) <= r.ttl {
+					continue
+				}
+
+				delete(lastSeen, key)
+
+				id, err := decodeKey(key)
+				if err != nil {
+					r.log.Warn().Err(err).Str("key", key).Msgf("could not decode heartbeat key")
+					continue
+				}
+
+				r.log.Info().Str("app", id).Msgf("handling app heartbeat expiry")
+				fn(id)
+			}
+		}
+	}
+}
+
+func (r *natsRegistry) GetConfiguration(ctx context.Context, locale string) (*model.ShellConfiguration, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+
+	entry, err := r.config.Get(ctx, localizedConfigurationEntry(locale))
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, apptypes.ErrConfigurationNotFound
+		}
+		return nil, err
+	}
+
+	var configuration model.ShellConfiguration
+	if err := json.Unmarshal(entry.Value(), &configuration); err != nil {
+		return nil, err
+	}
+
+	return &configuration, nil
+}
+
+func (r *natsRegistry) SaveConfiguration(ctx context.Context, locale string, configuration *model.ShellConfiguration) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	b, err := configuration.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.config.Put(ctx, localizedConfigurationEntry(locale), b)
+
+	return err
+}
+
+func (r *natsRegistry) GetConfigurationVersion(ctx context.Context, locale string, version int) (*model.ShellConfiguration, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+
+	entry, err := r.config.Get(ctx, configurationVersionEntry(locale, version))
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, apptypes.ErrConfigurationNotFound
+		}
+		return nil, err
+	}
+
+	var configuration model.ShellConfiguration
+	if err := json.Unmarshal(entry.Value(), &configuration); err != nil {
+		return nil, err
+	}
+
+	return &configuration, nil
+}
+
+func (r *natsRegistry) SaveConfigurationVersion(ctx context.Context, locale string, configuration *model.ShellConfiguration) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	b, err := configuration.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.config.Put(ctx, configurationVersionEntry(locale, configuration.Version), b)
+
+	return err
+}
+
+func (r *natsRegistry) DeleteConfigurationVersion(ctx context.Context, locale string, version int) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	return r.config.Purge(ctx, configurationVersionEntry(locale, version))
+}
+
+// ListConfigurationVersions reads the keys of the kept versions, the locale and version are encoded in the key
+func (r *natsRegistry) ListConfigurationVersions(ctx context.Context) (map[string][]int, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+
+	entries, err := listPrefix(ctx, r.config, versionPrefix, jetstream.MetaOnly())
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string][]int)
+	for _, entry := range entries {
+		id, err := decodeKey(strings.TrimPrefix(entry.Key(), versionPrefix))
+		if err != nil {
+			return nil, err
+		}
+
+		locale, number := "", id
+		if i := strings.LastIndex(id, "@"); i >= 0 {
+			locale, number = id[:i], id[i+1:]
+		}
+
+		version, err := strconv.Atoi(number)
+		if err != nil {
+			return nil, fmt.Errorf("invalid shell configuration version key %s: %w", id, err)
+		}
+		out[locale] = append(out[locale], version)
+	}
+
+	for _, versions := range out {
+		slices.Sort(versions)
+	}
+
+	return out, nil
+}
+
+func (r *natsRegistry) NextConfigurationVersion(ctx context.Context, current int) (int, error) {
+	if err := r.init(ctx); err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < maxTxRetries; i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		var (
+			last     int
+			revision uint64
+		)
+
+		entry, err := r.config.Get(ctx, versionCounterEntry)
+		switch {
+		case err == nil:
+			revision = entry.Revision()
+			if last, err = strconv.Atoi(string(entry.Value())); err != nil {
+				return 0, err
+			}
+		case !errors.Is(err, jetstream.ErrKeyNotFound):
+			return 0, err
+		}
+
+		version := max(last, current) + 1
+		value := []byte(strconv.Itoa(version))
+
+		if revision == 0 {
+			_, err = r.config.Create(ctx, versionCounterEntry, value)
+		} else {
+			_, err = r.config.Update(ctx, versionCounterEntry, value, revision)
+		}
+
+		if err == nil {
+			return version, nil
+		}
+
+		if !isRevisionConflict(err) {
+			return 0, err
+		}
+	}
+
+	return 0, fmt.Errorf("failed to allocate shell configuration version: %w", errTooManyUpdates)
+}
+
+func (r *natsRegistry) ListMaintenance(ctx context.Context) (map[string]*apptypes.Maintenance, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+
+	windows := make(map[string]*apptypes.Maintenance)
+
+	entries, err := listPrefix(ctx, r.config, maintenancePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		field, err := decodeKey(strings.TrimPrefix(entry.Key(), maintenancePrefix))
+		if err != nil {
+			r.log.Warn().Err(err).Str("key", entry.Key()).Msgf("could not decode maintenance key")
+			continue
+		}
+
+		var m apptypes.Maintenance
+		if err := json.Unmarshal(entry.Value(), &m); err != nil {
+			r.log.Warn().Err(err).Str("app", field).Msgf("could not unmarshal maintenance window")
+			continue
+		}
+		windows[field] = &m
+	}
+
+	return windows, nil
+}
+
+func (r *natsRegistry) SaveMaintenance(ctx context.Context, field string, m *apptypes.Maintenance) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	b, err := m.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.config.Put(ctx, maintenancePrefix+encodeKey(field), b)
+
+	return err
+}
+
+func (r *natsRegistry) DeleteMaintenance(ctx context.Context, field string) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	return r.config.Purge(ctx, maintenancePrefix+encodeKey(field))
+}
+
+func (r *natsRegistry) ListNavigationOverrides(ctx context.Context) (map[string]*apptypes.NavigationOverride, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+
+	overrides := make(map[string]*apptypes.NavigationOverride)
+
+	entries, err := listPrefix(ctx, r.config, overridePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		var o apptypes.NavigationOverride
+		if err := json.Unmarshal(entry.Value(), &o); err != nil {
+			r.log.Warn().Err(err).Str("key", entry.Key()).Msgf("could not unmarshal navigation override")
+			continue
+		}
+		overrides[o.ID] = &o
+	}
+
+	return overrides, nil
+}
+
+func (r *natsRegistry) SaveNavigationOverride(ctx context.Context, o *apptypes.NavigationOverride) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	b, err := o.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.config.Put(ctx, overridePrefix+encodeKey(o.ID), b)
+
+	return err
+}
+
+func (r *natsRegistry) DeleteNavigationOverride(ctx context.Context, id string) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+
+	return r.config.Purge(ctx, overridePrefix+encodeKey(id))
+}
+
+// init creates the key value buckets on first use, the nats connection is not available when the registry is created
+func (r *natsRegistry) init(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.apps != nil {
+		return nil
+	}
+
+	js, err := jetstream.New(r.nuc.Client())
+	if err != nil {
+		return err
+	}
+
+	apps, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: r.bucket + "_apps"})
+	if err != nil {
+		return err
+	}
+
+	heartbeats, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: r.bucket + "_heartbeats", TTL: r.ttl})
+	if err != nil {
+		return err
+	}
+
+	config, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: r.bucket + "_config"})
+	if err != nil {
+		return err
+	}
+
+	r.apps, r.heartbeats, r.config = apps, heartbeats, config
+
+	return nil
+}
+
+// listPrefix returns the current entries whose key starts with the prefix, the config bucket also holds history and
+// configuration versions so only the matching subjects are read instead of every key in the bucket
+func listPrefix(ctx context.Context, kv jetstream.KeyValue, prefix string, opts ...jetstream.WatchOpt) ([]jetstream.KeyValueEntry, error) {
+	watcher, err := kv.Watch(ctx, prefix+">", append([]jetstream.WatchOpt{jetstream.IgnoreDeletes()}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = watcher.Stop()
+	}()
+
+	var entries []jetstream.KeyValueEntry
+	for entry := range watcher.Updates() {
+		// a nil entry marks the end of the initial values
+		if entry == nil {
+			return entries, nil
+		}
+		entries = append(entries, entry)
+	}
+
+	// the updates are only closed before the initial values were delivered when the context is done
+	return nil, ctx.Err()
+}
+
+// isRevisionConflict reports whether a create or update lost the race against a concurrent write of the same key
+func isRevisionConflict(err error) bool {
+	var apiErr *jetstream.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode == errCodeWrongLastSequence {
+		return true
+	}
+	return errors.Is(err, jetstream.ErrKeyExists)
+}
+
+// localizedConfigurationEntry returns the key of the shell configuration of a locale
+func localizedConfigurationEntry(locale string) string {
+	if locale == "" {
+		return configurationEntry
+	}
+
+	return configurationEntry + "." + encodeKey(locale)
+}
+
+// configurationVersionEntry returns the key of an earlier version of the shell configuration of a locale
+func configurationVersionEntry(locale string, version int) string {
+	return versionPrefix + encodeKey(fmt.Sprintf("%s@%d", locale, version))
+}
+
+// encodeKey encodes an id into a valid key, ids may contain characters that are not allowed in keys
+func encodeKey(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+// decodeKey decodes a key created by encodeKey
+func decodeKey(key string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(key)
+	return string(b), err
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// NewNatsRegistry creates a registry backed by jetstream key value buckets, heartbeats expire after the given ttl
+func NewNatsRegistry(nuc NatsClient, bucket string, ttl time.Duration, log zerolog.Logger) apptypes.Registry {
+	return &natsRegistry{
+		nuc:    nuc,
+		bucket: bucket,
+		ttl:    ttl,
+		log:    log,
+	}
+}
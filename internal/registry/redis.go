@@ -0,0 +1,436 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	redisuc "github.com/azarc-io/verathread-next-common/usecase/redis"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+)
+
+const (
+	appsKey           = "apps"
+	configurationKey  = "shell:configuration"
+	versionsKey       = "shell:configuration:versions"
+	versionCounterKey = "shell:counter"
+	historyKeyPrefix  = "app:history:"
+	scanCount         = 100
+)
+
+type (
+	// redisRegistry stores apps in the `apps` hash and relies on keyspace notifications for expired keep alive keys,
+	// redis must be configured with `notify-keyspace-events Ex` for apps to be marked as unavailable
+	redisRegistry struct {
+		ruc redisuc.RedisUseCase
+		log zerolog.Logger
+	}
+)
+
+func (r *redisRegistry) GetApp(ctx context.Context, id string) (*apptypes.App, error) {
+	var app apptypes.App
+
+	cmd := r.ruc.Client().HGet(ctx, appsKey, id)
+	if err := cmd.Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, apptypes.ErrAppNotFound
+		}
+		return nil, err
+	}
+
+	if err := cmd.Scan(&app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+func (r *redisRegistry) ListApps(ctx context.Context) ([]*apptypes.App, error) {
+	iter := r.ruc.Client().HGetAll(ctx, appsKey)
+	if iter.Err() != nil {
+		return nil, iter.Err()
+	}
+
+	apps := make([]*apptypes.App, 0, len(iter.Val()))
+	for _, val := range iter.Val() {
+		var app apptypes.App
+		if err := json.Unmarshal([]byte(val), &app); err != nil {
+			return nil, err
+		}
+		apps = append(apps, &app)
+	}
+
+	return apps, nil
+}
+
+func (r *redisRegistry) SaveApp(ctx context.Context, app *apptypes.App) error {
+	return r.ruc.Client().HSet(ctx, appsKey, app.ID, app).Err()
+}
+
+// SetAppAvailable updates the app in an optimistic transaction, the update is retried when the apps hash changed
+// between reading and writing the app
+func (r *redisRegistry) SetAppAvailable(ctx context.Context, id string, available bool) (bool, error) {
+	changed := false
+
+	update := func(tx *redis.Tx) error {
+		var app apptypes.App
+
+		cmd := tx.HGet(ctx, appsKey, id)
+		if err := cmd.Err(); err != nil {
+			if errors.Is(err, redis.Nil) {
+				return apptypes.ErrAppNotFound
+			}
+			return err
+		}
+
+		if err := cmd.Scan(&app); err != nil {
+			return err
+		}
+
+		if changed = app.Available != available; !changed {
+			return nil
+		}
+
+		app.Available = available
+
+		_, err := tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, appsKey, id, &app)
+			return nil
+		})
+
+		return err
+	}
+
+	for i := 0; i < maxTxRetries; i++ {
+		err := r.ruc.Client().Watch(ctx, update, appsKey)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return changed, err
+		}
+	}
+
+	return false, fmt.Errorf("failed to update availability of app %s: %w", id, redis.TxFailedErr)
+}
+
+func (r *redisRegistry) DeleteApp(ctx context.Context, id string) error {
+	rc := r.ruc.Client()
+
+	if err := rc.HDel(ctx, appsKey, id).Err(); err != nil {
+		return err
+	}
+
+	return rc.Del(ctx, r.keepAliveKey(id)).Err()
+}
+
+func (r *redisRegistry) AppendHistory(ctx context.Context, rev *apptypes.AppRevision, limit int) error {
+	b, err := json.Marshal(rev)
+	if err != nil {
+		return err
+	}
+
+	key := historyKeyPrefix + rev.App.ID
+
+	_, err = r.ruc.Client().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, key, b)
+		pipe.LTrim(ctx, key, 0, int64(limit-1))
+		return nil
+	})
+
+	return err
+}
+
+func (r *redisRegistry) ListHistory(ctx context.Context, id string) ([]*apptypes.AppRevision, error) {
+	values, err := r.ruc.Client().LRange(ctx, historyKeyPrefix+id, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*apptypes.AppRevision, 0, len(values))
+	for _, val := range values {
+		var rev apptypes.AppRevision
+		if err := json.Unmarshal([]byte(val), &rev); err != nil {
+			return nil, err
+		}
+		history = append(history, &rev)
+	}
+
+	return history, nil
+}
+
+func (r *redisRegistry) Heartbeat(ctx context.Context, id string) error {
+	return r.ruc.Client().Set(ctx, r.keepAliveKey(id), true, apptypes.KeepAliveTTL).Err()
+}
+
+func (r *redisRegistry) RefreshHeartbeat(ctx context.Context, id string) (bool, error) {
+	return r.ruc.Client().Expire(ctx, r.keepAliveKey(id), apptypes.KeepAliveTTL).Result()
+}
+
+// ListHeartbeats returns the ids of all apps with a live keep alive key
+func (r *redisRegistry) ListHeartbeats(ctx context.Context) (map[string]bool, error) {
+	var (
+		alive = make(map[string]bool)
+		iter  = r.ruc.Client().Scan(ctx, 0, apptypes.KeepAliveKeySpacePrefix+":*", scanCount).Iterator()
+	)
+
+	for iter.Next(ctx) {
+		alive[strings.TrimPrefix(iter.Val(), apptypes.KeepAliveKeySpacePrefix+":")] = true
+	}
+
+	return alive, iter.Err()
+}
+
+// WatchExpired subscribes to keyspace expiry events and reports apps whose keep alive key expired, blocks until the
+// context is cancelled
+func (r *redisRegistry) WatchExpired(ctx context.Context, fn func(id string)) error {
+	sub := r.ruc.Client().PSubscribe(ctx, apptypes.KeySpaceExpiryChannel)
+	defer func() {
+		_ = sub.Close()
+	}()
+
+	for {
+		msg, err := sub.ReceiveMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to receive app expiry event from redis: %w", err)
+		}
+
+		if strings.HasPrefix(msg.Payload, apptypes.KeepAliveKeySpacePrefix) {
+			r.log.Info().Msgf("handling app event: %s => %s", msg.Channel, msg.Payload)
+			fn(strings.TrimPrefix(msg.Payload, apptypes.KeepAliveKeySpacePrefix+":"))
+		}
+	}
+}
+
+func (r *redisRegistry) GetConfiguration(ctx context.Context, locale string) (*model.ShellConfiguration, error) {
+	var configuration model.ShellConfiguration
+
+	cmd := r.ruc.Client().Get(ctx, localizedConfigurationKey(locale))
+	if err := cmd.Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, apptypes.ErrConfigurationNotFound
+		}
+		return nil, err
+	}
+
+	if err := cmd.Scan(&configuration); err != nil {
+		return nil, err
+	}
+
+	return &configuration, nil
+}
+
+func (r *redisRegistry) SaveConfiguration(ctx context.Context, locale string, configuration *model.ShellConfiguration) error {
+	return r.ruc.Client().Set(ctx, localizedConfigurationKey(locale), configuration, 0).Err()
+}
+
+func (r *redisRegistry) GetConfigurationVersion(ctx context.Context, locale string, version int) (*model.ShellConfiguration, error) {
+	var configuration model.ShellConfiguration
+
+	cmd := r.ruc.Client().HGet(ctx, localizedVersionsKey(locale), strconv.Itoa(version))
+	if err := cmd.Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, apptypes.ErrConfigurationNotFound
+		}
+		return nil, err
+	}
+
+	if err := cmd.Scan(&configuration); err != nil {
+		return nil, err
+	}
+
+	return &configuration, nil
+}
+
+func (r *redisRegistry) SaveConfigurationVersion(ctx context.Context, locale string, configuration *model.ShellConfiguration) error {
+	return r.ruc.Client().HSet(ctx, localizedVersionsKey(locale), strconv.Itoa(configuration.Version), configuration).Err()
+}
+
+func (r *redisRegistry) DeleteConfigurationVersion(ctx context.Context, locale string, version int) error {
+	return r.ruc.Client().HDel(ctx, localizedVersionsKey(locale), strconv.Itoa(version)).Err()
+}
+
+// ListConfigurationVersions returns the versions kept in the version hash of every locale
+func (r *redisRegistry) ListConfigurationVersions(ctx context.Context) (map[string][]int, error) {
+	var (
+		out  = make(map[string][]int)
+		iter = r.ruc.Client().Scan(ctx, 0, versionsKey+"*", scanCount).Iterator()
+	)
+
+	for iter.Next(ctx) {
+		// the versions of the default configuration are kept under the versions key itself
+		key, locale := iter.Val(), ""
+		if key != versionsKey {
+			var ok bool
+			if locale, ok = strings.CutPrefix(key, versionsKey+":"); !ok {
+				continue
+			}
+		}
+
+		fields, err := r.ruc.Client().HKeys(ctx, key).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, field := range fields {
+			version, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid shell configuration version %s of locale %s: %w", field, locale, err)
+			}
+			out[locale] = append(out[locale], version)
+		}
+		slices.Sort(out[locale])
+	}
+
+	return out, iter.Err()
+}
+
+func (r *redisRegistry) NextConfigurationVersion(ctx context.Context, current int) (int, error) {
+	version := 0
+
+	next := func(tx *redis.Tx) error {
+		last, err := tx.Get(ctx, versionCounterKey).Int()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+
+		version = max(last, current) + 1
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, versionCounterKey, version, 0)
+			return nil
+		})
+
+		return err
+	}
+
+	for i := 0; i < maxTxRetries; i++ {
+		err := r.ruc.Client().Watch(ctx, next, versionCounterKey)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return version, err
+		}
+	}
+
+	return 0, fmt.Errorf("failed to allocate shell configuration version: %w", redis.TxFailedErr)
+}
+
+// localizedVersionsKey returns the key of the hash that keeps the earlier versions of the shell configuration of a
+// locale keyed by version
+func localizedVersionsKey(locale string) string {
+	if locale == "" {
+		return versionsKey
+	}
+
+	return versionsKey + ":" + locale
+}
+
+// localizedConfigurationKey returns the key of the shell configuration of a locale, localized configurations are kept
+// next to the default configuration e.g. shell:configuration:de-CH
+func localizedConfigurationKey(locale string) string {
+	if locale == "" {
+		return configurationKey
+	}
+
+	return configurationKey + ":" + locale
+}
+
+func (r *redisRegistry) ListMaintenance(ctx context.Context) (map[string]*apptypes.Maintenance, error) {
+	iter := r.ruc.Client().HGetAll(ctx, apptypes.MaintenanceKey)
+	if iter.Err() != nil {
+		return nil, iter.Err()
+	}
+
+	windows := make(map[string]*apptypes.Maintenance, len(iter.Val()))
+	for field, val := range iter.Val() {
+		var m apptypes.Maintenance
+		if err := json.Unmarshal([]byte(val), &m); err != nil {
+			r.log.Warn().Err(err).Str("app", field).Msgf("could not unmarshal maintenance window")
+			continue
+		}
+		windows[field] = &m
+	}
+
+	return windows, nil
+}
+
+func (r *redisRegistry) SaveMaintenance(ctx context.Context, field string, m *apptypes.Maintenance) error {
+	return r.ruc.Client().HSet(ctx, apptypes.MaintenanceKey, field, m).Err()
+}
+
+func (r *redisRegistry) DeleteMaintenance(ctx context.Context, field string) error {
+	return r.ruc.Client().HDel(ctx, apptypes.MaintenanceKey, field).Err()
+}
+
+func (r *redisRegistry) ListNavigationOverrides(ctx context.Context) (map[string]*apptypes.NavigationOverride, error) {
+	iter := r.ruc.Client().HGetAll(ctx, apptypes.NavigationOverridesKey)
+	if iter.Err() != nil {
+		return nil, iter.Err()
+	}
+
+	overrides := make(map[string]*apptypes.NavigationOverride, len(iter.Val()))
+	for id, val := range iter.Val() {
+		var o apptypes.NavigationOverride
+		if err := json.Unmarshal([]byte(val), &o); err != nil {
+			r.log.Warn().Err(err).Str("navigation", id).Msgf("could not unmarshal navigation override")
+			continue
+		}
+		overrides[id] = &o
+	}
+
+	return overrides, nil
+}
+
+func (r *redisRegistry) SaveNavigationOverride(ctx context.Context, o *apptypes.NavigationOverride) error {
+	return r.ruc.Client().HSet(ctx, apptypes.NavigationOverridesKey, o.ID, o).Err()
+}
+
+func (r *redisRegistry) DeleteNavigationOverride(ctx context.Context, id string) error {
+	return r.ruc.Client().HDel(ctx, apptypes.NavigationOverridesKey, id).Err()
+}
+
+// checkKeyspaceEvents warns when redis is not configured to publish expiry events, apps are then only marked as
+// unavailable by the periodic reconciliation
+func checkKeyspaceEvents(ctx context.Context, ruc redisuc.RedisUseCase, log zerolog.Logger) {
+	cfg, err := ruc.Client().ConfigGet(ctx, "notify-keyspace-events").Result()
+	if err != nil {
+		log.Debug().Err(err).Msgf("could not read redis keyspace notification config")
+		return
+	}
+
+	flags := cfg["notify-keyspace-events"]
+	if expiryEventsEnabled(flags) {
+		return
+	}
+
+	log.Warn().Str("notify-keyspace-events", flags).
+		Msgf("redis keyspace expiry notifications are disabled, unavailable apps are only detected by reconciliation")
+}
+
+// expiryEventsEnabled reports whether the notify-keyspace-events flags publish expired keyevents, the watcher
+// subscribes to the keyevent channel so keyspace (K) notifications alone are not enough. A is an alias that includes x
+func expiryEventsEnabled(flags string) bool {
+	return strings.Contains(flags, "E") && strings.ContainsAny(flags, "xA")
+}
+
+// keepAliveKey generates a cache key for a keep alive that requires constant refresh otherwise it will trigger
+// the keyspace watcher and eventually mark the app as unavailable
+func (r *redisRegistry) keepAliveKey(id string) string {
+	return apptypes.KeepAliveKeySpacePrefix + ":" + id
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// NewRedisRegistry creates a registry backed by redis
+func NewRedisRegistry(ruc redisuc.RedisUseCase, log zerolog.Logger) apptypes.Registry {
+	return &redisRegistry{ruc: ruc, log: log}
+}
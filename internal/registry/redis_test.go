@@ -0,0 +1,30 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiryEventsEnabled(t *testing.T) {
+	tests := []struct {
+		flags   string
+		enabled bool
+	}{
+		{flags: "", enabled: false},
+		{flags: "Ex", enabled: true},
+		{flags: "EA", enabled: true},
+		{flags: "KEA", enabled: true},
+		{flags: "xE", enabled: true},
+		{flags: "Kx", enabled: false},
+		{flags: "KA", enabled: false},
+		{flags: "E", enabled: false},
+		{flags: "Eg", enabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.flags, func(t *testing.T) {
+			assert.Equal(t, tt.enabled, expiryEventsEnabled(tt.flags))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package registry
+
+import (
+	"fmt"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+)
+
+// maxTxRetries limits how often an optimistic update of a key is retried while other replicas keep changing it, shared
+// by every backend that updates keys with check and set
+const maxTxRetries = 10
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// New creates the registry backend selected in the config, redis is used when no backend is configured
+func New(opts *apptypes.APIGatewayOptions, log zerolog.Logger) (apptypes.Registry, error) {
+	backend, bucket := backendConfig(opts)
+
+	log.Info().Msgf("using %s app registry", backend)
+
+	switch backend {
+	case apptypes.RegistryRedis:
+		// expiry events are only published when keyspace notifications are enabled, checked once at startup
+		checkKeyspaceEvents(opts.Context, opts.RedisUseCase, log)
+		return NewRedisRegistry(opts.RedisUseCase, log), nil
+	case apptypes.RegistryMemory:
+		return NewMemoryRegistry(apptypes.KeepAliveTTL), nil
+	case apptypes.RegistryNats:
+		return NewNatsRegistry(opts.NatsUseCase, bucket, apptypes.KeepAliveTTL, log), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", apptypes.ErrUnknownRegistry, backend)
+	}
+}
+
+// NewElection creates the leader election that matches the registry backend, redis uses the election of the redis use
+// case, the only replica of a memory registry is always the leader and nats holds a lock in a key value bucket
+func NewElection(opts *apptypes.APIGatewayOptions, log zerolog.Logger) (apptypes.LeaderElection, error) {
+	backend, bucket := backendConfig(opts)
+
+	switch backend {
+	case apptypes.RegistryRedis:
+		return opts.RedisUseCase, nil
+	case apptypes.RegistryMemory:
+		return &memoryElection{}, nil
+	case apptypes.RegistryNats:
+		return NewNatsElection(opts.Context, opts.NatsUseCase, bucket, apptypes.KeepAliveTTL, log), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", apptypes.ErrUnknownRegistry, backend)
+	}
+}
+
+// backendConfig returns the configured registry backend and bucket, falling back to the defaults
+func backendConfig(opts *apptypes.APIGatewayOptions) (backend, bucket string) {
+	backend, bucket = apptypes.RegistryRedis, apptypes.DefaultRegistryBucket
+
+	if cfg := opts.Config.Registry; cfg != nil {
+		if cfg.Backend != "" {
+			backend = cfg.Backend
+		}
+		if cfg.Bucket != "" {
+			bucket = cfg.Bucket
+		}
+	}
+
+	return backend, bucket
+}
@@ -0,0 +1,404 @@
+package registry
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	"github.com/azarc-io/verathread-gateway/internal/testutil"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// registryTTL is the heartbeat ttl used by the suite, short enough for expiry to be observed quickly
+const registryTTL = time.Second
+
+// testRegistry runs the behaviour every registry backend must share, newRegistry returns an empty registry whose
+// heartbeats expire after registryTTL
+func testRegistry(t *testing.T, newRegistry func(t *testing.T) apptypes.Registry) {
+	t.Run("apps", func(t *testing.T) {
+		r, ctx := newRegistry(t), context.Background()
+
+		_, err := r.GetApp(ctx, "app")
+		require.ErrorIs(t, err, apptypes.ErrAppNotFound)
+
+		require.NoError(t, r.SaveApp(ctx, &apptypes.App{ID: "app", Name: "app", Version: "1.0.0"}))
+		require.NoError(t, r.SaveApp(ctx, &apptypes.App{ID: "other", Name: "other"}))
+
+		app, err := r.GetApp(ctx, "app")
+		require.NoError(t, err)
+		assert.Equal(t, "1.0.0", app.Version)
+
+		// returned apps are copies
+		app.Version = "2.0.0"
+		app, err = r.GetApp(ctx, "app")
+		require.NoError(t, err)
+		assert.Equal(t, "1.0.0", app.Version)
+
+		apps, err := r.ListApps(ctx)
+		require.NoError(t, err)
+		assert.Len(t, apps, 2)
+
+		require.NoError(t, r.Heartbeat(ctx, "app"))
+		require.NoError(t, r.DeleteApp(ctx, "app"))
+
+		_, err = r.GetApp(ctx, "app")
+		require.ErrorIs(t, err, apptypes.ErrAppNotFound)
+
+		alive, err := r.ListHeartbeats(ctx)
+		require.NoError(t, err)
+		assert.False(t, alive["app"], "deleting an app removes its heartbeat")
+	})
+
+	t.Run("availability", func(t *testing.T) {
+		r, ctx := newRegistry(t), context.Background()
+
+		_, err := r.SetAppAvailable(ctx, "app", true)
+		require.ErrorIs(t, err, apptypes.ErrAppNotFound)
+
+		require.NoError(t, r.SaveApp(ctx, &apptypes.App{ID: "app", Version: "1.0.0"}))
+
+		changed, err := r.SetAppAvailable(ctx, "app", true)
+		require.NoError(t, err)
+		assert.True(t, changed)
+
+		changed, err = r.SetAppAvailable(ctx, "app", true)
+		require.NoError(t, err)
+		assert.False(t, changed, "setting the current availability is not a change")
+
+		app, err := r.GetApp(ctx, "app")
+		require.NoError(t, err)
+		assert.True(t, app.Available)
+		assert.Equal(t, "1.0.0", app.Version, "only the availability is written")
+	})
+
+	t.Run("history", func(t *testing.T) {
+		r, ctx := newRegistry(t), context.Background()
+
+		history, err := r.ListHistory(ctx, "app")
+		require.NoError(t, err)
+		assert.Empty(t, history)
+
+		for i := 1; i <= 3; i++ {
+			require.NoError(t, r.AppendHistory(ctx, &apptypes.AppRevision{Revision: i, App: &apptypes.App{ID: "app"}}, 2))
+		}
+
+		history, err = r.ListHistory(ctx, "app")
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, 3, history[0].Revision, "history is newest first")
+		assert.Equal(t, 2, history[1].Revision)
+	})
+
+	t.Run("concurrent history", func(t *testing.T) {
+		r, ctx := newRegistry(t), context.Background()
+
+		var wg sync.WaitGroup
+		for i := 1; i <= 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				assert.NoError(t, r.AppendHistory(ctx, &apptypes.AppRevision{Revision: i, App: &apptypes.App{ID: "app"}}, 20))
+			}(i)
+		}
+		wg.Wait()
+
+		history, err := r.ListHistory(ctx, "app")
+		require.NoError(t, err)
+		assert.Len(t, history, 10, "no revision may be lost to a concurrent append")
+	})
+
+	t.Run("heartbeats", func(t *testing.T) {
+		r, ctx := newRegistry(t), context.Background()
+
+		refreshed, err := r.RefreshHeartbeat(ctx, "app")
+		require.NoError(t, err)
+		assert.False(t, refreshed, "a missing heartbeat is not refreshed")
+
+		require.NoError(t, r.Heartbeat(ctx, "app"))
+
+		refreshed, err = r.RefreshHeartbeat(ctx, "app")
+		require.NoError(t, err)
+		assert.True(t, refreshed)
+
+		alive, err := r.ListHeartbeats(ctx)
+		require.NoError(t, err)
+		assert.True(t, alive["app"])
+
+		time.Sleep(registryTTL + 200*time.Millisecond)
+
+		refreshed, err = r.RefreshHeartbeat(ctx, "app")
+		require.NoError(t, err)
+		assert.False(t, refreshed, "an expired heartbeat is not refreshed")
+
+		alive, err = r.ListHeartbeats(ctx)
+		require.NoError(t, err)
+		assert.False(t, alive["app"])
+	})
+
+	t.Run("watch expired", func(t *testing.T) {
+		r := newRegistry(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		expired := make(chan string, 1)
+		done := make(chan error, 1)
+		go func() {
+			done <- r.WatchExpired(ctx, func(id string) { expired <- id })
+		}()
+
+		require.NoError(t, r.Heartbeat(ctx, "app"))
+
+		select {
+		case id := <-expired:
+			assert.Equal(t, "app", id)
+		case <-time.After(registryTTL * 5):
+			t.Fatal("expired heartbeat was not reported")
+		}
+
+		cancel()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(time.Second * 5):
+			t.Fatal("watch did not return after the context was cancelled")
+		}
+	})
+
+	t.Run("configuration", func(t *testing.T) {
+		r, ctx := newRegistry(t), context.Background()
+
+		_, err := r.GetConfiguration(ctx, "")
+		require.ErrorIs(t, err, apptypes.ErrConfigurationNotFound)
+
+		require.NoError(t, r.SaveConfiguration(ctx, "", &model.ShellConfiguration{Version: 1}))
+		require.NoError(t, r.SaveConfiguration(ctx, "de", &model.ShellConfiguration{Version: 2}))
+
+		configuration, err := r.GetConfiguration(ctx, "")
+		require.NoError(t, err)
+		assert.Equal(t, 1, configuration.Version)
+
+		configuration, err = r.GetConfiguration(ctx, "de")
+		require.NoError(t, err)
+		assert.Equal(t, 2, configuration.Version)
+	})
+
+	t.Run("configuration versions", func(t *testing.T) {
+		r, ctx := newRegistry(t), context.Background()
+
+		require.NoError(t, r.SaveConfigurationVersion(ctx, "", &model.ShellConfiguration{Version: 1}))
+		require.NoError(t, r.SaveConfigurationVersion(ctx, "de", &model.ShellConfiguration{Version: 1, Locales: []string{"de"}}))
+		require.NoError(t, r.SaveConfigurationVersion(ctx, "", &model.ShellConfiguration{Version: 12}))
+		require.NoError(t, r.SaveConfigurationVersion(ctx, "", &model.ShellConfiguration{Version: 3}))
+
+		versions, err := r.ListConfigurationVersions(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]int{"": {1, 3, 12}, "de": {1}}, versions)
+
+		configuration, err := r.GetConfigurationVersion(ctx, "", 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, configuration.Version)
+
+		configuration, err = r.GetConfigurationVersion(ctx, "de", 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"de"}, configuration.Locales)
+
+		require.NoError(t, r.DeleteConfigurationVersion(ctx, "", 1))
+
+		_, err = r.GetConfigurationVersion(ctx, "", 1)
+		require.ErrorIs(t, err, apptypes.ErrConfigurationNotFound)
+
+		_, err = r.GetConfigurationVersion(ctx, "de", 1)
+		require.NoError(t, err, "deleting a version of one locale keeps the other locales")
+
+		require.NoError(t, r.DeleteConfigurationVersion(ctx, "de", 1))
+
+		versions, err = r.ListConfigurationVersions(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]int{"": {3, 12}}, versions, "a locale without versions is not listed")
+	})
+
+	t.Run("concurrent configuration versions", func(t *testing.T) {
+		r, ctx := newRegistry(t), context.Background()
+
+		// the counter starts after the version of a configuration saved before the counter existed
+		version, err := r.NextConfigurationVersion(ctx, 4)
+		require.NoError(t, err)
+		assert.Equal(t, 5, version)
+
+		var (
+			wg       sync.WaitGroup
+			mu       sync.Mutex
+			versions = make(map[int]bool)
+		)
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				// every replica read the same current configuration before allocating
+				version, err := r.NextConfigurationVersion(ctx, 5)
+				assert.NoError(t, err)
+
+				mu.Lock()
+				versions[version] = true
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		assert.Len(t, versions, 10, "no version may be handed out twice")
+		for v := 6; v <= 15; v++ {
+			assert.True(t, versions[v], "version %d is allocated", v)
+		}
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		r, ctx := newRegistry(t), context.Background()
+
+		require.NoError(t, r.SaveMaintenance(ctx, apptypes.MaintenanceGatewayField, &apptypes.Maintenance{Message: "gateway"}))
+		require.NoError(t, r.SaveMaintenance(ctx, "app", &apptypes.Maintenance{App: "app", Message: "app"}))
+
+		windows, err := r.ListMaintenance(ctx)
+		require.NoError(t, err)
+		require.Len(t, windows, 2)
+		assert.Equal(t, "gateway", windows[apptypes.MaintenanceGatewayField].Message)
+
+		require.NoError(t, r.DeleteMaintenance(ctx, "app"))
+
+		windows, err = r.ListMaintenance(ctx)
+		require.NoError(t, err)
+		assert.Len(t, windows, 1)
+	})
+
+	t.Run("navigation overrides", func(t *testing.T) {
+		r, ctx := newRegistry(t), context.Background()
+
+		hidden := true
+		require.NoError(t, r.SaveNavigationOverride(ctx, &apptypes.NavigationOverride{ID: "nav", Hidden: &hidden}))
+
+		overrides, err := r.ListNavigationOverrides(ctx)
+		require.NoError(t, err)
+		require.Contains(t, overrides, "nav")
+		assert.True(t, *overrides["nav"].Hidden)
+
+		require.NoError(t, r.DeleteNavigationOverride(ctx, "nav"))
+
+		overrides, err = r.ListNavigationOverrides(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, overrides)
+	})
+}
+
+func TestMemoryRegistry(t *testing.T) {
+	testRegistry(t, func(*testing.T) apptypes.Registry {
+		return NewMemoryRegistry(registryTTL)
+	})
+}
+
+func TestNatsRegistry(t *testing.T) {
+	testRegistry(t, func(t *testing.T) apptypes.Registry {
+		srv := testutil.RunNats(t)
+		return NewNatsRegistry(testutil.ConnectNats(t, srv), "test", registryTTL, zerolog.Nop())
+	})
+}
+
+func TestNatsRegistry_SharedBucket(t *testing.T) {
+	var (
+		srv   = testutil.RunNats(t)
+		one   = NewNatsRegistry(testutil.ConnectNats(t, srv), "test", registryTTL, zerolog.Nop())
+		other = NewNatsRegistry(testutil.ConnectNats(t, srv), "test", registryTTL, zerolog.Nop())
+		ctx   = context.Background()
+	)
+
+	// maintenance windows and overrides share the config bucket with history and versions
+	require.NoError(t, one.AppendHistory(ctx, &apptypes.AppRevision{Revision: 1, App: &apptypes.App{ID: "app"}}, 10))
+	require.NoError(t, one.SaveConfigurationVersion(ctx, "", &model.ShellConfiguration{Version: 1}))
+	require.NoError(t, one.SaveMaintenance(ctx, "app", &apptypes.Maintenance{App: "app"}))
+	require.NoError(t, one.SaveNavigationOverride(ctx, &apptypes.NavigationOverride{ID: "nav"}))
+
+	windows, err := other.ListMaintenance(ctx)
+	require.NoError(t, err)
+	assert.Len(t, windows, 1, "only maintenance windows are listed")
+	assert.Contains(t, windows, "app")
+
+	overrides, err := other.ListNavigationOverrides(ctx)
+	require.NoError(t, err)
+	assert.Len(t, overrides, 1, "only navigation overrides are listed")
+	assert.Contains(t, overrides, "nav")
+}
+
+func TestNatsRegistry_CanceledUpdates(t *testing.T) {
+	var (
+		srv         = testutil.RunNats(t)
+		reg         = NewNatsRegistry(testutil.ConnectNats(t, srv), "test", registryTTL, zerolog.Nop())
+		ctx, cancel = context.WithCancel(context.Background())
+	)
+
+	require.NoError(t, reg.SaveApp(ctx, &apptypes.App{ID: "app"}))
+	cancel()
+
+	// check and set updates give up once the caller is gone instead of retrying
+	_, err := reg.SetAppAvailable(ctx, "app", true)
+	require.ErrorIs(t, err, context.Canceled)
+
+	err = reg.AppendHistory(ctx, &apptypes.AppRevision{Revision: 1, App: &apptypes.App{ID: "app"}}, 10)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = reg.NextConfigurationVersion(ctx, 0)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestNatsElection(t *testing.T) {
+	var (
+		srv      = testutil.RunNats(t)
+		promoted = make(chan int, 2)
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	for i := 0; i < 2; i++ {
+		NewNatsElection(ctx, testutil.ConnectNats(t, srv), "test", registryTTL, zerolog.Nop()).
+			SubscribeToElectionEvents(func(onPromote <-chan time.Time, _ <-chan time.Time) {
+				select {
+				case <-onPromote:
+					promoted <- i
+				case <-ctx.Done():
+				}
+			})
+	}
+
+	select {
+	case <-promoted:
+	case <-time.After(registryTTL * 3):
+		t.Fatal("no replica was promoted")
+	}
+
+	select {
+	case <-promoted:
+		t.Fatal("two replicas were promoted at the same time")
+	case <-time.After(registryTTL):
+	}
+}
+
+func TestMemoryElection(t *testing.T) {
+	promoted := make(chan struct{})
+
+	(&memoryElection{}).SubscribeToElectionEvents(func(onPromote <-chan time.Time, _ <-chan time.Time) {
+		<-onPromote
+		close(promoted)
+	})
+
+	select {
+	case <-promoted:
+	case <-time.After(time.Second):
+		t.Fatal("the only replica was not promoted")
+	}
+}
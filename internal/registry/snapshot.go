@@ -0,0 +1,328 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	snapshotDirPermission  = os.FileMode(0o755)
+	snapshotFilePermission = os.FileMode(0o644)
+	snapshotFile           = "snapshot.json"
+)
+
+type (
+	// Snapshot wraps a registry and keeps a last known good copy of the registered apps, maintenance windows and shell
+	// configuration on disk, reads that fail are served from the copy so that the platform keeps working while the
+	// registry is unavailable. Writes always go to the wrapped registry
+	Snapshot struct {
+		apptypes.Registry
+		path string
+		log  zerolog.Logger
+
+		mu         sync.RWMutex
+		data       *snapshotData
+		degraded   bool
+		degradedAt time.Time
+	}
+
+	snapshotData struct {
+		Apps          map[string]*apptypes.App         `json:"apps"`
+		Maintenance   map[string]*apptypes.Maintenance `json:"maintenance"`
+		Configuration *model.ShellConfiguration        `json:"configuration"`
+		SavedAt       time.Time                        `json:"savedAt"`
+	}
+)
+
+func (s *Snapshot) GetApp(ctx context.Context, id string) (*apptypes.App, error) {
+	app, err := s.Registry.GetApp(ctx, id)
+	if err == nil || errors.Is(err, apptypes.ErrAppNotFound) {
+		s.recovered()
+		return app, err
+	}
+
+	s.fallback(err)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if app, ok := s.data.Apps[id]; ok {
+		c := *app
+		return &c, nil
+	}
+
+	return nil, err
+}
+
+func (s *Snapshot) ListApps(ctx context.Context) ([]*apptypes.App, error) {
+	apps, err := s.Registry.ListApps(ctx)
+	if err == nil {
+		s.recovered()
+		return apps, nil
+	}
+
+	s.fallback(err)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.data.SavedAt.IsZero() {
+		return nil, err
+	}
+
+	apps = make([]*apptypes.App, 0, len(s.data.Apps))
+	for _, app := range s.data.Apps {
+		c := *app
+		apps = append(apps, &c)
+	}
+
+	return apps, nil
+}
+
+// GetConfiguration only keeps the configuration of the default locale, localized configurations fall back to it
+// while the registry is unavailable
+func (s *Snapshot) GetConfiguration(ctx context.Context, locale string) (*model.ShellConfiguration, error) {
+	configuration, err := s.Registry.GetConfiguration(ctx, locale)
+	if err == nil || errors.Is(err, apptypes.ErrConfigurationNotFound) {
+		s.recovered()
+		return configuration, err
+	}
+
+	s.fallback(err)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.data.Configuration == nil {
+		return nil, err
+	}
+
+	// the configuration is modified by callers, hand out a copy
+	b, merr := s.data.Configuration.MarshalBinary()
+	if merr != nil {
+		return nil, err
+	}
+
+	var c model.ShellConfiguration
+	if merr = json.Unmarshal(b, &c); merr != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (s *Snapshot) ListMaintenance(ctx context.Context) (map[string]*apptypes.Maintenance, error) {
+	windows, err := s.Registry.ListMaintenance(ctx)
+	if err == nil {
+		s.recovered()
+		return windows, nil
+	}
+
+	s.fallback(err)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.data.SavedAt.IsZero() {
+		return nil, err
+	}
+
+	windows = make(map[string]*apptypes.Maintenance, len(s.data.Maintenance))
+	for field, m := range s.data.Maintenance {
+		c := *m
+		windows[field] = &c
+	}
+
+	return windows, nil
+}
+
+// Refresh reads the current state from the wrapped registry and persists it, called whenever the navigation is rebuilt
+func (s *Snapshot) Refresh(ctx context.Context) error {
+	apps, err := s.Registry.ListApps(ctx)
+	if err != nil {
+		return err
+	}
+
+	windows, err := s.Registry.ListMaintenance(ctx)
+	if err != nil {
+		return err
+	}
+
+	configuration, err := s.Registry.GetConfiguration(ctx, "")
+	if err != nil && !errors.Is(err, apptypes.ErrConfigurationNotFound) {
+		return err
+	}
+
+	data := &snapshotData{
+		Apps:          make(map[string]*apptypes.App, len(apps)),
+		Maintenance:   windows,
+		Configuration: configuration,
+		SavedAt:       time.Now(),
+	}
+
+	for _, app := range apps {
+		data.Apps[app.ID] = app
+	}
+
+	if err := s.write(data); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.data = data
+	s.mu.Unlock()
+
+	s.recovered()
+
+	return nil
+}
+
+// Health only fails while the registry is unavailable and there is no snapshot to serve from, a registry served from
+// the snapshot is degraded rather than down and is reported through Status instead
+func (s *Snapshot) Health() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.degraded || !s.data.SavedAt.IsZero() {
+		return nil
+	}
+
+	return fmt.Errorf("%w since %s", apptypes.ErrRegistryUnavailable, s.degradedAt.Format(time.RFC3339))
+}
+
+// Status reports whether reads are currently served from the snapshot
+func (s *Snapshot) Status() *apptypes.RegistryStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	status := &apptypes.RegistryStatus{Degraded: s.degraded}
+	if s.degraded {
+		since := s.degradedAt
+		status.Since = &since
+	}
+
+	if !s.data.SavedAt.IsZero() {
+		savedAt := s.data.SavedAt
+		status.SnapshotAt = &savedAt
+	}
+
+	return status
+}
+
+// fallback flags the registry as degraded
+func (s *Snapshot) fallback(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.degraded {
+		s.log.Warn().Err(err).Msgf("registry is unavailable, serving from last known good snapshot")
+		s.degraded = true
+		s.degradedAt = time.Now()
+	}
+}
+
+// recovered clears the degraded flag after a successful read
+func (s *Snapshot) recovered() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.degraded {
+		s.log.Info().Msgf("registry has recovered")
+		s.degraded = false
+	}
+}
+
+// write persists the snapshot, the file is written to a temporary location first so readers never see partial files
+func (s *Snapshot) write(data *snapshotData) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), snapshotDirPermission); err != nil {
+		return err
+	}
+
+	// every refresh writes its own temporary file so that concurrent refreshes never write to the same file
+	f, err := os.CreateTemp(filepath.Dir(s.path), snapshotFile+".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(b); err == nil {
+		err = f.Chmod(snapshotFilePermission)
+	}
+
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	if err == nil {
+		err = os.Rename(f.Name(), s.path)
+	}
+
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+
+	return err
+}
+
+// read loads the snapshot from disk, returns an empty snapshot if there is none
+func (s *Snapshot) read() *snapshotData {
+	data := &snapshotData{}
+
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			s.log.Warn().Err(err).Msgf("failed to read registry snapshot")
+		}
+		return data
+	}
+
+	if err := json.Unmarshal(b, data); err != nil {
+		s.log.Warn().Err(err).Msgf("failed to decode registry snapshot")
+		return &snapshotData{}
+	}
+
+	s.log.Info().Int("apps", len(data.Apps)).Msgf("loaded registry snapshot taken at %s", data.SavedAt.Format(time.RFC3339))
+
+	return data
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// NewSnapshot wraps a registry with a last known good snapshot, returns nil if it is disabled or there is no data
+// directory
+func NewSnapshot(reg apptypes.Registry, opts *apptypes.APIGatewayOptions, log zerolog.Logger) *Snapshot {
+	if cfg := opts.Config.Registry; cfg == nil || !cfg.Snapshot {
+		return nil
+	}
+
+	if opts.DataDir == "" {
+		log.Warn().Msgf("registry snapshots require a data directory, snapshots are disabled")
+		return nil
+	}
+
+	s := &Snapshot{
+		Registry: reg,
+		path:     filepath.Join(opts.DataDir, "registry", snapshotFile),
+		log:      log,
+	}
+
+	s.data = s.read()
+
+	return s
+}
@@ -0,0 +1,193 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errRegistryDown = errors.New("registry down")
+
+// flakyRegistry is a memory registry whose reads fail while it is down
+type flakyRegistry struct {
+	apptypes.Registry
+
+	down atomic.Bool
+}
+
+func (r *flakyRegistry) GetApp(ctx context.Context, id string) (*apptypes.App, error) {
+	if r.down.Load() {
+		return nil, errRegistryDown
+	}
+	return r.Registry.GetApp(ctx, id)
+}
+
+func (r *flakyRegistry) ListApps(ctx context.Context) ([]*apptypes.App, error) {
+	if r.down.Load() {
+		return nil, errRegistryDown
+	}
+	return r.Registry.ListApps(ctx)
+}
+
+func (r *flakyRegistry) GetConfiguration(ctx context.Context, locale string) (*model.ShellConfiguration, error) {
+	if r.down.Load() {
+		return nil, errRegistryDown
+	}
+	return r.Registry.GetConfiguration(ctx, locale)
+}
+
+func (r *flakyRegistry) ListMaintenance(ctx context.Context) (map[string]*apptypes.Maintenance, error) {
+	if r.down.Load() {
+		return nil, errRegistryDown
+	}
+	return r.Registry.ListMaintenance(ctx)
+}
+
+// newSnapshot wraps a populated memory registry with a snapshot kept in dir
+func newSnapshot(t *testing.T, dir string) (*Snapshot, *flakyRegistry) {
+	t.Helper()
+
+	var (
+		ctx = context.Background()
+		reg = &flakyRegistry{Registry: NewMemoryRegistry(registryTTL)}
+	)
+
+	require.NoError(t, reg.SaveApp(ctx, &apptypes.App{ID: "app", Name: "app"}))
+	require.NoError(t, reg.SaveConfiguration(ctx, "", &model.ShellConfiguration{Version: 3}))
+	require.NoError(t, reg.SaveMaintenance(ctx, "app", &apptypes.Maintenance{App: "app"}))
+
+	s := NewSnapshot(reg, &apptypes.APIGatewayOptions{
+		DataDir: dir,
+		Config:  &apptypes.APIGatewayConfig{Registry: &apptypes.RegistryConfig{Snapshot: true}},
+	}, zerolog.Nop())
+	require.NotNil(t, s)
+
+	return s, reg
+}
+
+func TestSnapshot_RestartServesSavedCopy(t *testing.T) {
+	var (
+		dir = t.TempDir()
+		ctx = context.Background()
+	)
+
+	s, _ := newSnapshot(t, dir)
+	require.NoError(t, s.Refresh(ctx))
+
+	// after a restart the registry is down, reads are served from the snapshot on disk
+	restarted, reg := newSnapshot(t, dir)
+	reg.down.Store(true)
+
+	apps, err := restarted.ListApps(ctx)
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "app", apps[0].ID)
+
+	app, err := restarted.GetApp(ctx, "app")
+	require.NoError(t, err)
+	assert.Equal(t, "app", app.Name)
+
+	configuration, err := restarted.GetConfiguration(ctx, "en")
+	require.NoError(t, err)
+	assert.Equal(t, 3, configuration.Version, "localized configurations fall back to the default one")
+
+	windows, err := restarted.ListMaintenance(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, windows, "app")
+}
+
+func TestSnapshot_CorruptFile(t *testing.T) {
+	var (
+		dir = t.TempDir()
+		ctx = context.Background()
+	)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "registry"), snapshotDirPermission))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "registry", snapshotFile), []byte("{not json"), snapshotFilePermission))
+
+	// a corrupt snapshot is ignored, there is nothing to serve while the registry is down
+	s, reg := newSnapshot(t, dir)
+	reg.down.Store(true)
+
+	_, err := s.ListApps(ctx)
+	require.ErrorIs(t, err, errRegistryDown)
+	require.ErrorIs(t, s.Health(), apptypes.ErrRegistryUnavailable)
+
+	// the next refresh replaces it
+	reg.down.Store(false)
+	require.NoError(t, s.Refresh(ctx))
+
+	restarted, reg := newSnapshot(t, dir)
+	reg.down.Store(true)
+
+	apps, err := restarted.ListApps(ctx)
+	require.NoError(t, err)
+	assert.Len(t, apps, 1)
+}
+
+func TestSnapshot_Health(t *testing.T) {
+	ctx := context.Background()
+
+	s, reg := newSnapshot(t, t.TempDir())
+	require.NoError(t, s.Refresh(ctx))
+	require.NoError(t, s.Health())
+	assert.False(t, s.Status().Degraded)
+
+	// served from the snapshot the registry is degraded but not down
+	reg.down.Store(true)
+	_, err := s.ListApps(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Health())
+	status := s.Status()
+	assert.True(t, status.Degraded)
+	assert.NotNil(t, status.Since)
+	assert.NotNil(t, status.SnapshotAt)
+
+	// the first successful read clears the degraded state
+	reg.down.Store(false)
+	_, err = s.ListApps(ctx)
+	require.NoError(t, err)
+	assert.False(t, s.Status().Degraded)
+}
+
+func TestSnapshot_ConcurrentRefresh(t *testing.T) {
+	var (
+		dir = t.TempDir()
+		ctx = context.Background()
+		wg  sync.WaitGroup
+	)
+
+	s, _ := newSnapshot(t, dir)
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, s.Refresh(ctx))
+		}()
+	}
+	wg.Wait()
+
+	files, err := os.ReadDir(filepath.Join(dir, "registry"))
+	require.NoError(t, err)
+	require.Len(t, files, 1, "no temporary files are left behind")
+	assert.Equal(t, snapshotFile, files[0].Name())
+
+	restarted, reg := newSnapshot(t, dir)
+	reg.down.Store(true)
+
+	apps, err := restarted.ListApps(ctx)
+	require.NoError(t, err)
+	assert.Len(t, apps, 1)
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -8,13 +9,14 @@ import (
 	"net/url"
 	"regexp"
 	"runtime/debug"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
 	"github.com/erni27/imcache"
-	"github.com/redis/go-redis/v9"
+	"github.com/google/uuid"
 
 	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
 	apputil "github.com/azarc-io/verathread-gateway/internal/util"
@@ -27,66 +29,143 @@ type (
 		log  zerolog.Logger
 		opts *apptypes.APIGatewayOptions
 		sync.Mutex
-		watchSub    *redis.PubSub
+		registry    apptypes.Registry
+		preferences apptypes.PreferencesStore
 		targetCache *imcache.Sharded[string, *apptypes.ProxyTarget]
+
+		maintenanceMu       sync.RWMutex
+		maintenance         map[string]*apptypes.Maintenance
+		maintenanceEntries  map[string]*apptypes.Maintenance
+		maintenanceLoadedAt time.Time
+
+		searchIndex *apputil.SearchIndex
 	}
 )
 
 /************************************************************************/
-/* KEYSPACE NOTIFICATION
+/* HEARTBEAT EXPIRY
 /************************************************************************/
 
-// Watch runs only on a single instance in the cluster and watches the redis keyspace for notifications.
-// - whenever a key has expired it will update the availability flag of the app and regenerate the cached configuration
-func (s *service) Watch() error {
-	rc := s.opts.RedisUseCase.Client()
+// Watch runs only on a single instance in the cluster and watches the registry for expired heartbeats, it blocks until
+// the context is cancelled and is supervised by the domain which restarts it when it fails.
+// - whenever a heartbeat has expired it will update the availability flag of the app and regenerate the cached configuration
+func (s *service) Watch(ctx context.Context) error {
+	s.log.Info().Msgf("watching for application heartbeat expiry")
 
-	s.log.Info().Msgf("watching for application cache keyspace changes")
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// expiry events may have been missed while there was no leader
+	if _, err := s.reconcile(ctx); err != nil {
+		s.log.Warn().Err(err).Msgf("could not reconcile app availability")
+	}
 
 	if err := s.rebuildNavigation(); err != nil {
 		s.log.Warn().Err(err).Msgf("could not rebuild navigation")
 	}
 
-	s.watchSub = rc.PSubscribe(s.opts.Context, apptypes.KeySpaceExpiryChannel)
+	go s.reconcileLoop(ctx)
+	go s.maintenanceExpiryLoop(ctx)
+
+	return s.registry.WatchExpired(ctx, s.handleExpired)
+}
+
+// handleExpired marks an app whose heartbeat expired as unavailable and rebuilds the navigation
+func (s *service) handleExpired(id string) {
+	if err := s.markApplicationAsUnavailable(id); err != nil {
+		s.log.Warn().Err(err).Msgf("could not mark app as unavailable on heartbeat expiry")
+		return
+	}
+
+	if err := s.rebuildNavigation(); err != nil {
+		s.log.Warn().Err(err).Msgf("could not rebuild navigation on heartbeat expiry")
+	}
+}
+
+/************************************************************************/
+/* RECONCILIATION
+/************************************************************************/
+
+// reconcileLoop periodically reconciles app availability, expiry events are not guaranteed to be delivered e.g. when
+// keyspace notifications are disabled, during leader fail over or while the subscription is failing
+func (s *service) reconcileLoop(ctx context.Context) {
+	interval := apptypes.DefaultReconcileInterval
+	if cfg := s.opts.Config.Registry; cfg != nil && cfg.ReconcileInterval > 0 {
+		interval = cfg.ReconcileInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-	go func() {
-		for {
-			msg, err := s.watchSub.ReceiveMessage(s.opts.Context)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := s.reconcile(ctx)
 			if err != nil {
-				s.log.Warn().Err(err).Msgf("failed to receive app expiry event from redis")
-				if errors.Is(err, redis.ErrClosed) {
-					time.Sleep(time.Second)
-				}
+				s.log.Warn().Err(err).Msgf("could not reconcile app availability")
 				continue
 			}
 
-			if strings.HasPrefix(msg.Payload, apptypes.KeepAliveKeySpacePrefix) {
-				id := strings.Split(msg.Payload, ":")
-				s.log.Info().Msgf("handling app event: %s => %s", msg.Channel, msg.Payload)
+			if !changed {
+				continue
+			}
 
-				if err := s.markApplicationAsUnavailable(id[2]); err != nil {
-					s.log.Warn().Err(err).Msgf("could not mark app as unavailable on keyspace event")
-					continue
-				}
+			if err := s.rebuildNavigation(); err != nil {
+				s.log.Warn().Err(err).Msgf("could not rebuild navigation after reconciliation")
+			}
+		}
+	}
+}
 
-				if err := s.rebuildNavigation(); err != nil {
-					s.log.Warn().Err(err).Msgf("could not rebuild navigation on keyspace event")
-					continue
-				}
+// reconcile compares the heartbeats against the registered apps and fixes the availability of apps in both
+// directions, returns true if any app was changed
+func (s *service) reconcile(ctx context.Context) (bool, error) {
+	apps, err := s.registry.ListApps(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	alive, err := s.registry.ListHeartbeats(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	changed := false
+	for _, app := range apps {
+		available := alive[app.ID]
+		if app.Available == available {
+			continue
+		}
+
+		// only the availability is written so that a registration that happened since listing the apps is kept
+		updated, err := s.registry.SetAppAvailable(ctx, app.ID, available)
+		if err != nil {
+			if errors.Is(err, apptypes.ErrAppNotFound) {
+				continue
 			}
+			return changed, err
 		}
-	}()
 
-	return nil
-}
+		if !updated {
+			continue
+		}
 
-// UnWatch called when an instance looses leadership and stops watching for keyspace events
-func (s *service) UnWatch() error {
-	if s.watchSub != nil {
-		return s.watchSub.Close()
+		s.log.Info().Str("app", app.ID).Bool("available", available).Msgf("reconciled app availability")
+
+		eventType := model.AuditEventTypeUnavailable
+		if available {
+			eventType = model.AuditEventTypeAvailable
+		}
+		s.audit(ctx, eventType, app.ID, apptypes.AuditActorSystem,
+			apputil.NewAuditChange("available", !available, available))
+
+		s.invalidateProxyTarget(app.ID)
+		changed = true
 	}
 
-	return nil
+	return changed, nil
 }
 
 /************************************************************************/
@@ -99,17 +178,14 @@ func (s *service) GetProxyTarget(appID string) (*apptypes.ProxyTarget, bool) {
 	var (
 		target *apptypes.ProxyTarget
 		exists bool
-		rc     = s.opts.RedisUseCase.Client()
-		app    apptypes.App
 	)
 
 	if target, exists = s.targetCache.Get(appID); !exists {
-		cmd := rc.HGet(s.opts.Context, "apps", appID)
-		if cmd.Err() != nil {
-			return nil, false
-		}
-		if err := cmd.Scan(&app); err != nil {
-			s.log.Warn().Err(err).Msgf("fetched cached app but could not unmarshal the data")
+		app, err := s.registry.GetApp(s.opts.Context, appID)
+		if err != nil {
+			if !errors.Is(err, apptypes.ErrAppNotFound) {
+				s.log.Warn().Err(err).Msgf("could not load app from the registry")
+			}
 			return nil, false
 		}
 
@@ -128,6 +204,7 @@ func (s *service) GetProxyTarget(appID string) (*apptypes.ProxyTarget, bool) {
 		target = &apptypes.ProxyTarget{
 			ID:           app.ID,
 			Name:         app.Name,
+			Version:      app.Version,
 			WebURL:       webURL,
 			APIURL:       apiURL,
 			Meta:         map[string]interface{}{}, // TODO fill in auth etc.
@@ -147,6 +224,37 @@ func (s *service) GetProxyTarget(appID string) (*apptypes.ProxyTarget, bool) {
 	return target, exists
 }
 
+// EvictProxyTarget removes a proxy target from the local cache, returns the evicted target if it was cached
+func (s *service) EvictProxyTarget(appID string) (*apptypes.ProxyTarget, bool) {
+	target, exists := s.targetCache.Get(appID)
+	s.targetCache.Remove(appID)
+
+	return target, exists
+}
+
+// invalidateProxyTarget evicts the proxy target of an app locally and tells all other replicas to do the same, the
+// previous urls of the app are sent along so that replicas can drop proxies cached for them
+func (s *service) invalidateProxyTarget(appID string, previousURLs ...string) {
+	s.targetCache.Remove(appID)
+
+	ev := &apptypes.ProxyTargetInvalidatedEvent{App: appID}
+	for _, u := range previousURLs {
+		if u != "" {
+			ev.URLs = append(ev.URLs, u)
+		}
+	}
+
+	b, err := json.Marshal(ev)
+	if err != nil {
+		s.log.Warn().Err(err).Str("app", appID).Msgf("failed to marshal proxy target invalidation")
+		return
+	}
+
+	if err := s.opts.NatsUseCase.Client().Publish(apptypes.ProxyTargetInvalidatedSubject, b); err != nil {
+		s.log.Warn().Err(err).Str("app", appID).Msgf("failed to publish proxy target invalidation")
+	}
+}
+
 /************************************************************************/
 /* APP REGISTRATION
 /************************************************************************/
@@ -157,35 +265,34 @@ func (s *service) GetProxyTarget(appID string) (*apptypes.ProxyTarget, bool) {
 // and the app is also offline.
 func (s *service) RegisterApp(ctx context.Context, req *model.RegisterAppInput) (*model.RegisterAppOutput, error) {
 	var (
-		ent       apptypes.App
-		rc        = s.opts.RedisUseCase.Client()
+		ent       *apptypes.App
 		err       error
-		appExists bool
+		appExists = true
 		appKey    = req.Name
 	)
 
-	er := rc.HExists(ctx, "apps", appKey)
-	if err = er.Err(); err != nil {
-		s.log.Error().Str("package", req.Package).Err(err).Msgf("failed to retrieve check for cached app entry")
-		return nil, fmt.Errorf("failed to retrieve check for cached app entry: %w", err)
-	}
-	appExists = er.Val()
+	// the routes claimed by other apps are checked before the app is saved, registrations are serialized within this
+	// replica but the check and the save are not atomic across replicas because the registry has no transaction that
+	// spans apps, two replicas registering apps with the same route at the same time can therefore both succeed
+	s.Lock()
+	defer s.Unlock()
 
-	if appExists {
-		gar := rc.HGet(ctx, "apps", appKey)
-		if err = gar.Err(); err != nil {
-			s.log.Error().Str("package", req.Package).Err(err).Msgf("failed to retrieve cached app entry")
-			return nil, fmt.Errorf("failed to retrieve cached app entry: %w", err)
-		}
+	if err = s.checkRegistration(ctx, req); err != nil {
+		return nil, err
+	}
 
-		if err = gar.Scan(&ent); err != nil {
-			s.log.Error().Str("package", req.Package).Err(err).Msgf("failed to scan cached app")
-			return nil, fmt.Errorf("failed to scan cached app: %w", err)
-		}
-	} else {
-		ent = apptypes.App{}
+	ent, err = s.registry.GetApp(ctx, appKey)
+	if errors.Is(err, apptypes.ErrAppNotFound) {
+		ent, err, appExists = &apptypes.App{}, nil, false
+	}
+	if err != nil {
+		s.log.Error().Str("package", req.Package).Err(err).Msgf("failed to retrieve registered app entry")
+		return nil, fmt.Errorf("failed to retrieve registered app entry: %w", err)
 	}
 
+	previous := *ent
+	previousURLs := []string{ent.WebURL, ent.APIURL}
+
 	if !appExists {
 		ent.CreatedAt = time.Now()
 		s.log.Info().Str("pkg", req.Package).Msgf("registering app")
@@ -193,7 +300,66 @@ func (s *service) RegisterApp(ctx context.Context, req *model.RegisterAppInput)
 		s.log.Info().Str("pkg", req.Package).Msgf("updating app")
 	}
 
-	ent.ID = appKey
+	mapRegistration(ent, req)
+	ent.Available = true
+
+	// update the registry
+	if err = s.registry.SaveApp(ctx, ent); err != nil {
+		s.log.Error().Err(err).Msgf("failed to register application")
+		return nil, fmt.Errorf("failed to register application: %w", err)
+	}
+
+	eventType, before := model.AuditEventTypeUpdated, &previous
+	if !appExists {
+		eventType, before = model.AuditEventTypeRegistered, nil
+	}
+	s.auditAppChange(ctx, eventType, apputil.ActorFromContext(ctx, apptypes.AuditActorAppPrefix+req.Package), before, ent)
+
+	// keep the registration so that it can be rolled back later
+	if err = s.recordRevision(ctx, ent, 0); err != nil {
+		s.log.Warn().Err(err).Str("app", ent.ID).Msgf("failed to record app registration history")
+	}
+
+	// clear the cached proxy target for this app id on every replica
+	s.invalidateProxyTarget(ent.ID, previousURLs...)
+
+	// set the initial keep alive token
+	if err = s.registry.Heartbeat(ctx, ent.ID); err != nil {
+		return nil, err
+	}
+
+	return &model.RegisterAppOutput{ID: ent.ID}, s.rebuildNavigation()
+}
+
+// checkRegistration validates a registration and applies the conflict policy against the other registered apps, the
+// registration is modified in place and the caller must hold the service lock
+func (s *service) checkRegistration(ctx context.Context, req *model.RegisterAppInput) error {
+	apputil.MigrateLegacySlots(req)
+
+	if err := apputil.ValidateRegistration(req); err != nil {
+		return err
+	}
+
+	if err := apputil.ValidateSlots(req, s.opts.Config.Navigation); err != nil {
+		return err
+	}
+
+	apps, err := s.registry.ListApps(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list registered apps: %w", err)
+	}
+
+	if err = apputil.ValidateCategories(req, apps, s.opts.Config.Navigation); err != nil {
+		return err
+	}
+
+	return s.resolveConflicts(req, apps)
+}
+
+// mapRegistration sets the registered fields of an app from a checked registration, the availability and creation
+// time are left to the caller
+func mapRegistration(ent *apptypes.App, req *model.RegisterAppInput) {
+	ent.ID = req.Name
 	ent.Name = req.Name
 	ent.Package = req.Package
 	ent.Version = req.Version
@@ -201,10 +367,12 @@ func (s *service) RegisterApp(ctx context.Context, req *model.RegisterAppInput)
 	ent.WebURL = req.WebURL
 	ent.RemoteEntry = req.RemoteEntryFile
 	ent.Proxy = req.Proxy
+	ent.Format = apputil.MapModuleFormatToEntity(req.Format)
+	ent.Shared = apputil.MapSharedDependenciesToEntity(req.Shared)
 	ent.Navigation = []*apptypes.Navigation{}
+	ent.Categories = apputil.MapRegisterCategoriesToEntity(req.Categories)
 	ent.UpdatedAt = time.Now()
 	ent.Adopted = true
-	ent.Available = true
 	ent.RemoteEntryRewriteRegEx = map[string]string{
 		"/app/*/*": "/$2",
 	}
@@ -214,71 +382,165 @@ func (s *service) RegisterApp(ctx context.Context, req *model.RegisterAppInput)
 			ID: hashutil.GetHash64([]byte(req.Package + ":" + navigation.Module.Path)),
 		}
 
-		apputil.MapNavInputToNavEntity(navigation, n)
+		apputil.MapNavInputToNavEntity(navigation, n, ent)
 
 		ent.Navigation = append(ent.Navigation, n)
+	}
 
-		if navigation.Proxy {
-			n.RemoteEntry = fmt.Sprintf("%s/app/%s/remoteEntry.js", "", ent.ID)
-		} else {
-			n.RemoteEntry = fmt.Sprintf("%s/%s", req.WebURL, req.RemoteEntryFile)
-		}
+	ent.Slots = make([]*apptypes.NavigationSlot, 0, len(req.Slots))
+	for _, slot := range req.Slots {
+		ent.Slots = append(ent.Slots, apputil.MapRegisterSlotToEntity(slot))
+	}
+}
+
+// resolveConflicts applies the configured conflict policy to routes and slots that are already used by other apps
+func (s *service) resolveConflicts(req *model.RegisterAppInput, apps []*apptypes.App) error {
+	policy := apptypes.ConflictPolicyReject
+	if cfg := s.opts.Config.Registration; cfg != nil && cfg.ConflictPolicy != "" {
+		policy = cfg.ConflictPolicy
+	}
+
+	before, slots := len(req.Navigation), len(req.Slots)
+	if err := apputil.ResolveConflicts(req, apps, policy); err != nil {
+		return err
+	}
+
+	if dropped := before - len(req.Navigation); dropped > 0 {
+		s.log.Warn().Str("app", req.Name).Int("dropped", dropped).Msgf("dropped navigation entries claimed by other apps")
 	}
 
-	if req.Slot1 != nil {
-		ent.Slot1 = apputil.MapRegisterSlotToEntity(req.Slot1)
+	if dropped := slots - len(req.Slots); dropped > 0 {
+		s.log.Warn().Str("app", req.Name).Int("dropped", dropped).Msgf("dropped slot contributions claimed by other apps")
 	}
 
-	if req.Slot2 != nil {
-		ent.Slot2 = apputil.MapRegisterSlotToEntity(req.Slot2)
+	return nil
+}
+
+/************************************************************************/
+/* REGISTRATION HISTORY
+/************************************************************************/
+
+// GetAppRegistrationHistory returns the retained registrations of an app, newest first
+func (s *service) GetAppRegistrationHistory(ctx context.Context, id string) ([]*model.AppRegistrationRevision, error) {
+	history, err := s.registry.ListHistory(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list app registration history: %w", err)
+	}
+
+	out := make([]*model.AppRegistrationRevision, 0, len(history))
+	for _, rev := range history {
+		out = append(out, apputil.MapRevisionToModel(rev))
 	}
 
-	if req.Slot3 != nil {
-		ent.Slot3 = apputil.MapRegisterSlotToEntity(req.Slot3)
+	return out, nil
+}
+
+// RollbackAppRegistration restores a previous registration of an app, the availability of the app is left untouched
+// since it reflects the running instance rather than the registration, the rollback itself is recorded as a new revision.
+// The revision is checked like a new registration so that it cannot take back routes other apps have claimed since
+func (s *service) RollbackAppRegistration(ctx context.Context, id string, revision int) (*model.RegisterAppOutput, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	history, err := s.registry.ListHistory(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list app registration history: %w", err)
 	}
 
-	// clear the cached proxy target for this app id
-	s.targetCache.Remove(ent.ID)
+	idx := slices.IndexFunc(history, func(rev *apptypes.AppRevision) bool {
+		return rev.Revision == revision
+	})
+	if idx < 0 {
+		return nil, apptypes.ErrRevisionNotFound
+	}
 
-	// update the cache
-	st := rc.HSet(ctx, "apps", ent.ID, ent)
-	if st.Err() != nil {
-		s.log.Error().Err(st.Err()).Msgf("failed to cache application")
+	current, err := s.registry.GetApp(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	// set the initial keep alive token
-	kcmd := rc.Set(ctx, s.appKeepAliveKey(ent.Name), true, apptypes.KeepAliveTTL)
-	if kcmd.Err() != nil {
+	req := apputil.MapAppToRegisterInput(history[idx].App)
+	if err = s.checkRegistration(ctx, req); err != nil {
 		return nil, err
 	}
 
+	ent := &apptypes.App{
+		CreatedAt: current.CreatedAt,
+		Available: current.Available,
+	}
+	mapRegistration(ent, req)
+
+	if err = s.registry.SaveApp(ctx, ent); err != nil {
+		return nil, fmt.Errorf("failed to restore app registration: %w", err)
+	}
+
+	s.log.Info().Str("app", id).Int("revision", revision).Msgf("rolled back app registration")
+	s.auditAppChange(ctx, model.AuditEventTypeRolledBack, apputil.ActorFromContext(ctx, apptypes.AuditActorAnonymous), current, ent)
+
+	if err = s.recordRevision(ctx, ent, revision); err != nil {
+		s.log.Warn().Err(err).Str("app", id).Msgf("failed to record app registration history")
+	}
+
+	s.invalidateProxyTarget(ent.ID, current.WebURL, current.APIURL)
+
 	return &model.RegisterAppOutput{ID: ent.ID}, s.rebuildNavigation()
 }
 
-// KeepAlive monitors the healthiness of a remove application, after registering apps must send a keep alive
-// this refreshes the ttl on the cache entry preventing the app from being marked as unavailable
-func (s *service) KeepAlive(ctx context.Context, req *model.KeepAliveAppInput) (*model.KeepAliveAppOutput, error) {
-	var (
-		rc        = s.opts.RedisUseCase.Client()
-		err       error
-		appExists bool
-		appKey    = s.appKeepAliveKey(req.Name)
-	)
+// recordRevision appends the app to its registration history, revisions are numbered sequentially per app
+func (s *service) recordRevision(ctx context.Context, app *apptypes.App, rollbackOf int) error {
+	limit := apptypes.DefaultHistoryLimit
+	if cfg := s.opts.Config.Registration; cfg != nil && cfg.HistoryLimit > 0 {
+		limit = cfg.HistoryLimit
+	}
+
+	history, err := s.registry.ListHistory(ctx, app.ID)
+	if err != nil {
+		return err
+	}
 
-	er := rc.Exists(ctx, appKey)
-	if err = er.Err(); err != nil {
-		s.log.Error().Str("package", req.Pkg).Err(err).Msgf("failed to retrieve check for cached app entry")
-		return nil, fmt.Errorf("failed to retrieve check for cached app entry: %w", err)
+	rev := &apptypes.AppRevision{
+		Revision:     1,
+		RegisteredAt: app.UpdatedAt,
+		RollbackOf:   rollbackOf,
+		App:          app,
+	}
+	if len(history) > 0 {
+		rev.Revision = history[0].Revision + 1
 	}
-	appExists = er.Val() > 0
 
-	if appExists {
-		cmd := rc.Expire(ctx, appKey, apptypes.KeepAliveTTL)
+	return s.registry.AppendHistory(ctx, rev, limit)
+}
 
-		if cmd.Err() != nil {
-			s.log.Error().Str("package", req.Pkg).Err(err).Msgf("could not refresh cached app expiry")
-		}
+// UnregisterApp removes an app and its heartbeat from the registry, its navigation is removed from the shell and every
+// replica drops its proxy target, the registration history is kept so that it can still be looked up
+func (s *service) UnregisterApp(ctx context.Context, id string) error {
+	current, err := s.registry.GetApp(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err = s.registry.DeleteApp(ctx, id); err != nil {
+		return fmt.Errorf("failed to unregister app: %w", err)
+	}
+
+	s.log.Info().Str("app", id).Msgf("unregistered app")
+	s.audit(ctx, model.AuditEventTypeUnregistered, id, apputil.ActorFromContext(ctx, apptypes.AuditActorAnonymous))
+
+	s.invalidateProxyTarget(id, current.WebURL, current.APIURL)
+
+	return s.rebuildNavigation()
+}
+
+// KeepAlive monitors the healthiness of a remove application, after registering apps must send a keep alive
+// this refreshes the ttl on the cache entry preventing the app from being marked as unavailable
+func (s *service) KeepAlive(ctx context.Context, req *model.KeepAliveAppInput) (*model.KeepAliveAppOutput, error) {
+	alive, err := s.registry.RefreshHeartbeat(ctx, req.Name)
+	if err != nil {
+		s.log.Error().Str("package", req.Pkg).Err(err).Msgf("could not refresh app heartbeat")
+		return nil, fmt.Errorf("could not refresh app heartbeat: %w", err)
+	}
 
+	if alive {
 		rsp := &model.KeepAliveAppOutput{
 			RegistrationRequired: false,
 			Ok:                   true,
@@ -299,104 +561,1109 @@ func (s *service) KeepAlive(ctx context.Context, req *model.KeepAliveAppInput) (
 // GetAppConfiguration fetches the shell app configuration from the cache, does not build the configuration, that instead
 // happens any time an app is added, removed or updated
 func (s *service) GetAppConfiguration(ctx context.Context, tenant string) (*model.ShellConfiguration, error) {
-	var (
-		rc            = s.opts.RedisUseCase.Client()
-		configuration model.ShellConfiguration
-	)
+	configuration, err := s.localizedConfiguration(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.decorateConfiguration(ctx, tenant, configuration); err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
+}
+
+// GetAppConfigurationVersion fetches an earlier version of the shell app configuration in the callers locale, it is
+// decorated the same way as the current configuration so that the two can be compared
+func (s *service) GetAppConfigurationVersion(ctx context.Context, tenant string, version int) (*model.ShellConfiguration, error) {
+	current, err := s.localizedConfiguration(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	cmd := rc.Get(ctx, "shell:configuration")
-	if cmd.Err() != nil {
-		return nil, cmd.Err()
+	locale := ""
+	if current.Locale != nil && *current.Locale != apputil.DefaultLocale(s.opts.Config.Localization) {
+		locale = *current.Locale
 	}
 
-	if err := cmd.Scan(&configuration); err != nil {
+	configuration, err := s.registry.GetConfigurationVersion(ctx, locale, version)
+	if err != nil {
 		return nil, err
 	}
 
-	return &configuration, nil
+	if err := s.decorateConfiguration(ctx, tenant, configuration); err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
 }
 
-/************************************************************************/
-/* HELPERS
-/************************************************************************/
+// decorateConfiguration applies the callers overrides, maintenance windows and preferences to a configuration, the
+// hash is recalculated afterwards so that it matches the document the caller receives
+func (s *service) decorateConfiguration(ctx context.Context, tenant string, configuration *model.ShellConfiguration) error {
+	if overrides := apputil.OverridesFromContext(ctx); len(overrides) > 0 {
+		s.applyOverrides(ctx, configuration, overrides)
+	}
+
+	windows, entries := s.cachedMaintenance(ctx)
+	applyMaintenance(configuration, windows, entries)
 
-// rebuildNavigation rebuilds the navigation structure for the shell and updates the entry in the cache, this process
-// is contention free because it is and should only be run on the leader in the cluster
-//
-//nolint:prealloc
-func (s *service) rebuildNavigation() error {
 	var (
-		rc   = s.opts.RedisUseCase.Client()
-		nc   = s.opts.NatsUseCase.Client()
-		apps []*apptypes.App
+		prefs *apptypes.UserPreferences
+		err   error
 	)
+	if user := apputil.UserFromContext(ctx); user != "" {
+		if prefs, err = s.preferences.GetUserPreferences(ctx, user); err != nil {
+			s.log.Warn().Err(err).Str("user", user).Msgf("could not load user preferences")
+		} else {
+			cfg := s.opts.Config.Preferences
+			apputil.ApplyUserPreferences(configuration, prefs, cfg != nil && cfg.FavouritesCategory)
+		}
+	}
 
-	s.log.Info().Msgf("rebuilding navigation cache due to change event")
+	route := s.resolveDefaultRoute(ctx, tenant, configuration, prefs)
+	configuration.DefaultRoute = &route
 
-	iter := rc.HGetAll(s.opts.Context, "apps")
-	if iter.Err() != nil {
-		return iter.Err()
+	hash, err := apputil.HashConfiguration(configuration)
+	if err != nil {
+		return err
 	}
+	configuration.Hash = &hash
 
-	for _, val := range iter.Val() {
-		var app apptypes.App
-		if err := json.Unmarshal([]byte(val), &app); err != nil {
-			s.log.Error().Err(err).Msgf("faled to unmarshal: \n%s", string(debug.Stack()))
-			return err
+	return nil
+}
+
+// localizedConfiguration loads the configuration of the first locale in the callers locale chain that apps have
+// provided translations for, the configuration of the default locale is used when there is none
+func (s *service) localizedConfiguration(ctx context.Context) (*model.ShellConfiguration, error) {
+	configuration, err := s.registry.GetConfiguration(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	defaultLocale := apputil.DefaultLocale(s.opts.Config.Localization)
+	for _, locale := range apputil.LocaleChain(apputil.LocalesFromContext(ctx), s.opts.Config.Localization) {
+		if locale == defaultLocale {
+			break
+		}
+
+		if !slices.Contains(configuration.Locales, locale) {
+			continue
+		}
+
+		localized, err := s.registry.GetConfiguration(ctx, locale)
+		if err != nil {
+			s.log.Warn().Err(err).Str("locale", locale).Msgf("could not load localized shell configuration")
+			break
 		}
-		apps = append(apps, &app)
+
+		return localized, nil
 	}
 
-	mappings := apputil.MapAppsToNavigation(apps)
-	cmd := rc.Set(s.opts.Context, "shell:configuration", mappings, 0)
+	return configuration, nil
+}
+
+// resolveDefaultRoute picks the page the shell lands on, the users own preference comes first followed by the tenant
+// and gateway defaults, a route is skipped when its entry is unhealthy, in maintenance or requires a user that is not known
+func (s *service) resolveDefaultRoute(
+	ctx context.Context, tenant string, configuration *model.ShellConfiguration, prefs *apptypes.UserPreferences,
+) string {
+	var candidates []string
 
-	if cmd.Err() == nil {
-		if err := nc.Publish(apptypes.ShellConfigurationUpdatedSubject, []byte("{}")); err != nil {
-			s.log.Warn().Err(err).Msgf("failed to publish configuration rebuilt event")
+	if prefs != nil && prefs.DefaultRoute != "" {
+		candidates = append(candidates, prefs.DefaultRoute)
+	}
+
+	if cfg := s.opts.Config.Navigation; cfg != nil {
+		if route := cfg.TenantDefaultRoutes[tenant]; route != "" {
+			candidates = append(candidates, route)
+		}
+		if cfg.DefaultRoute != "" {
+			candidates = append(candidates, cfg.DefaultRoute)
 		}
 	}
 
-	return cmd.Err()
+	return apputil.ResolveDefaultRoute(configuration, candidates, apputil.UserFromContext(ctx) != "")
 }
 
-// appKeepAliveKey generates a cache key for a keep alive that requires constant refresh otherwise it will trigger
-// the keyspace watcher and eventually mark the app as unavailable
-func (s *service) appKeepAliveKey(name string) string {
-	return "app:keepalive:" + name
+/************************************************************************/
+/* USER PREFERENCES
+/************************************************************************/
+
+// GetUserPreferences returns the preferences of the user making the request
+func (s *service) GetUserPreferences(ctx context.Context) (*model.UserPreferences, error) {
+	user := apputil.UserFromContext(ctx)
+	if user == "" {
+		return nil, apptypes.ErrUserRequired
+	}
+
+	prefs, err := s.preferences.GetUserPreferences(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user preferences: %w", err)
+	}
+
+	return apputil.MapUserPreferencesToModel(prefs), nil
 }
 
-// markApplicationAsUnavailable marks an app as unavailable, this is called when an app stops sending
-// keep alive messages for a period of time and before the navigation is rebuilt
-func (s *service) markApplicationAsUnavailable(id string) error {
-	var (
-		rc  = s.opts.RedisUseCase.Client()
-		app apptypes.App
-	)
+// SetDefaultRoute stores the route the user wants the shell to land on, the route must belong to a navigation entry,
+// a nil or empty route clears the preference
+func (s *service) SetDefaultRoute(ctx context.Context, route *string) (*model.UserPreferences, error) {
+	return s.updatePreferences(ctx, func(prefs *apptypes.UserPreferences) error {
+		prefs.DefaultRoute = ""
+		if route == nil || *route == "" {
+			return nil
+		}
+
+		configuration, err := s.registry.GetConfiguration(ctx, "")
+		if err != nil {
+			return err
+		}
+
+		if !apputil.RouteExists(configuration, *route, nil) {
+			verr := &apptypes.ValidationError{}
+			verr.Add("route", apptypes.FieldInvalid, "route %s does not belong to any navigation entry", *route)
+			return verr
+		}
+
+		prefs.DefaultRoute = *route
+
+		return nil
+	})
+}
+
+// SetNavigationPinned pins a navigation entry to the favourites of the user or unpins it, only entries that are part
+// of the shell configuration can be pinned
+func (s *service) SetNavigationPinned(ctx context.Context, id string, pinned bool) (*model.UserPreferences, error) {
+	return s.updatePreferences(ctx, func(prefs *apptypes.UserPreferences) error {
+		prefs.Pinned = slices.DeleteFunc(prefs.Pinned, func(p string) bool { return p == id })
+		if !pinned {
+			return nil
+		}
+
+		if err := s.requireNavigationEntry(ctx, id); err != nil {
+			return err
+		}
+
+		prefs.Pinned = append(prefs.Pinned, id)
+
+		return nil
+	})
+}
 
-	cmd := rc.HGet(s.opts.Context, "apps", id)
-	if cmd.Err() != nil {
-		return cmd.Err()
+// SetNavigationHidden hides a navigation entry from the navigation of the user or shows it again
+func (s *service) SetNavigationHidden(ctx context.Context, id string, hidden bool) (*model.UserPreferences, error) {
+	return s.updatePreferences(ctx, func(prefs *apptypes.UserPreferences) error {
+		prefs.Hidden = slices.DeleteFunc(prefs.Hidden, func(h string) bool { return h == id })
+		if !hidden {
+			return nil
+		}
+
+		if err := s.requireNavigationEntry(ctx, id); err != nil {
+			return err
+		}
+
+		prefs.Hidden = append(prefs.Hidden, id)
+
+		return nil
+	})
+}
+
+// ClearRecentApps forgets the recently used apps of the user
+func (s *service) ClearRecentApps(ctx context.Context) (*model.UserPreferences, error) {
+	return s.updatePreferences(ctx, func(prefs *apptypes.UserPreferences) error {
+		prefs.Recents = nil
+		return nil
+	})
+}
+
+// RecordRecentApp remembers that the user has loaded a module of an app, called for module loads through the
+// gateway and a no-op for anonymous users
+func (s *service) RecordRecentApp(ctx context.Context, app string) error {
+	user := apputil.UserFromContext(ctx)
+	if user == "" {
+		return nil
 	}
 
-	if err := cmd.Scan(&app); err != nil {
-		return err
+	limit := apptypes.DefaultRecentsLimit
+	if cfg := s.opts.Config.Preferences; cfg != nil && cfg.RecentsLimit > 0 {
+		limit = cfg.RecentsLimit
+	}
+
+	prefs, err := s.preferences.GetUserPreferences(ctx, user)
+	if err != nil {
+		return fmt.Errorf("failed to load user preferences: %w", err)
+	}
+
+	now := time.Now()
+	if !apputil.AddRecentApp(prefs, app, now, limit) {
+		return nil
 	}
 
-	app.Available = false
+	prefs.UpdatedAt = now
+	if err := s.preferences.SaveUserPreferences(ctx, prefs); err != nil {
+		return fmt.Errorf("failed to store user preferences: %w", err)
+	}
 
-	setCmd := rc.HSet(s.opts.Context, "apps", id, app)
+	return nil
+}
+
+// updatePreferences loads the preferences of the user making the request, applies the change and stores them
+func (s *service) updatePreferences(
+	ctx context.Context, change func(prefs *apptypes.UserPreferences) error,
+) (*model.UserPreferences, error) {
+	user := apputil.UserFromContext(ctx)
+	if user == "" {
+		return nil, apptypes.ErrUserRequired
+	}
+
+	prefs, err := s.preferences.GetUserPreferences(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user preferences: %w", err)
+	}
+
+	if err := change(prefs); err != nil {
+		return nil, err
+	}
+
+	prefs.UpdatedAt = time.Now()
+	if err := s.preferences.SaveUserPreferences(ctx, prefs); err != nil {
+		return nil, fmt.Errorf("failed to store user preferences: %w", err)
+	}
+
+	return apputil.MapUserPreferencesToModel(prefs), nil
+}
+
+// requireNavigationEntry returns a validation error if there is no top level navigation entry with the given id
+func (s *service) requireNavigationEntry(ctx context.Context, id string) error {
+	configuration, err := s.registry.GetConfiguration(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	if !apputil.NavigationEntryExists(configuration, id) {
+		verr := &apptypes.ValidationError{}
+		verr.Add("id", apptypes.FieldInvalid, "navigation entry %s does not exist", id)
+		return verr
+	}
+
+	return nil
+}
+
+/************************************************************************/
+/* NAVIGATION SEARCH
+/************************************************************************/
+
+// SearchNavigation searches the navigation of the apps available to the tenant, entries that require a user are left
+// out for anonymous callers and unless includeUnavailable is set so are entries of apps that are unavailable or in
+// maintenance
+func (s *service) SearchNavigation(
+	ctx context.Context, tenant, query string, limit *int, includeUnavailable bool,
+) ([]*model.NavigationSearchResult, error) {
+	verr := &apptypes.ValidationError{}
+	if strings.TrimSpace(tenant) == "" {
+		verr.Add("tenantId", apptypes.FieldRequired, "must not be empty")
+	}
+	if strings.TrimSpace(query) == "" {
+		verr.Add("query", apptypes.FieldRequired, "must not be empty")
+	}
+
+	n := apptypes.DefaultSearchLimit
+	if limit != nil {
+		n = *limit
+		if n < 1 || n > apptypes.MaxSearchLimit {
+			verr.Add("limit", apptypes.FieldInvalid, "must be between 1 and %d", apptypes.MaxSearchLimit)
+		}
+	}
+
+	if err := verr.OrNil(); err != nil {
+		return nil, err
+	}
+
+	var (
+		authenticated = apputil.UserFromContext(ctx) != ""
+		chain         = apputil.LocaleChain(apputil.LocalesFromContext(ctx), s.opts.Config.Localization)
+		states        = make(map[string]model.ShellNavigationState)
+	)
+
+	state := func(doc *apputil.SearchDocument) model.ShellNavigationState {
+		if st, ok := states[doc.App]; ok {
+			return st
+		}
+
+		_, maintenance := s.GetMaintenance(doc.App)
+		states[doc.App] = navigationState(doc.Available, maintenance)
+
+		return states[doc.App]
+	}
+
+	hits := s.searchIndex.Search(query, n, func(doc *apputil.SearchDocument) bool {
+		if !apputil.SearchableByTenant(tenant, doc.Package, s.opts.Config.Navigation) {
+			return false
+		}
+
+		if doc.AuthRequired && !authenticated {
+			return false
+		}
+
+		return includeUnavailable || state(doc) == model.ShellNavigationStateHealthy
+	})
+
+	results := make([]*model.NavigationSearchResult, 0, len(hits))
+	for _, hit := range hits {
+		doc := hit.Document
+		tr := doc.Translations.Resolve(chain)
+
+		results = append(results, &model.NavigationSearchResult{
+			ID:          doc.ID,
+			App:         doc.App,
+			Title:       cmp.Or(tr.Title, doc.Title),
+			SubTitle:    cmp.Or(tr.SubTitle, doc.SubTitle),
+			Icon:        doc.Icon,
+			Path:        doc.Path,
+			Category:    doc.Category,
+			Breadcrumbs: doc.Breadcrumbs,
+			State:       state(doc),
+			Score:       hit.Score,
+		})
+	}
+
+	return results, nil
+}
+
+// RebuildSearchIndex rebuilds the local navigation search index from the registry, every replica keeps its own index
+// and rebuilds it whenever the shell configuration is rebuilt
+func (s *service) RebuildSearchIndex(ctx context.Context) error {
+	apps, err := s.registry.ListApps(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list registered apps: %w", err)
+	}
+
+	overrides, err := s.registry.ListNavigationOverrides(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list navigation overrides: %w", err)
+	}
+
+	s.searchIndex.Rebuild(apps, overrides, s.opts.Config.Navigation)
+	s.log.Debug().Int("documents", s.searchIndex.Len()).Msgf("rebuilt navigation search index")
+
+	return nil
+}
+
+/************************************************************************/
+/* MODULE ARTIFACTS
+/************************************************************************/
+
+// GetImportMap returns the browser import map of the registered apps
+func (s *service) GetImportMap(ctx context.Context) (*apptypes.ImportMap, error) {
+	apps, err := s.registry.ListApps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return apputil.BuildImportMap(s.overrideApps(ctx, apps)), nil
+}
+
+// GetFederationManifest returns the module federation manifest of an app, an empty app returns the manifest of the
+// shell which lists every app as a remote, both point at the developer overrides of the caller like the import map
+func (s *service) GetFederationManifest(ctx context.Context, app string) (*apptypes.FederationManifest, error) {
+	if app == "" {
+		apps, err := s.registry.ListApps(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return apputil.BuildShellFederationManifest(s.overrideApps(ctx, apps)), nil
+	}
+
+	a, err := s.registry.GetApp(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	return apputil.BuildFederationManifest(s.overrideApps(ctx, []*apptypes.App{a})[0]), nil
+}
+
+// overrideApps points apps at the developer overrides of the caller, proxied apps are left alone because the proxy
+// follows the overrides itself
+func (s *service) overrideApps(ctx context.Context, apps []*apptypes.App) []*apptypes.App {
+	overrides := apputil.OverridesFromContext(ctx)
+	if len(overrides) == 0 {
+		return apps
+	}
+
+	out := make([]*apptypes.App, 0, len(apps))
+	for _, a := range apps {
+		if override, ok := overrides[a.ID]; ok && !a.Proxy {
+			c := *a
+			c.WebURL = strings.TrimSuffix(override.String(), "/")
+			a = &c
+		}
+		out = append(out, a)
+	}
+
+	return out
+}
+
+/************************************************************************/
+/* DEVELOPER OVERRIDES
+/************************************************************************/
+
+// SignOverride validates and signs a set of developer overrides for the user making the request, the resulting value
+// and signature can be sent as headers or cookies by the developer to load their local build of an app in place of the
+// registered one until the signature expires
+func (s *service) SignOverride(ctx context.Context, req *model.SignOverrideInput) (*model.SignOverrideOutput, error) {
+	cfg := s.opts.Config.Overrides
+	if cfg == nil || !cfg.Enabled {
+		return nil, apptypes.ErrOverridesDisabled
+	}
+
+	if cfg.Secret == "" {
+		return nil, apptypes.ErrOverrideSecretMissing
+	}
+
+	user := apputil.UserFromContext(ctx)
+	if user == "" {
+		return nil, apptypes.ErrUserRequired
+	}
+
+	role := apptypes.DefaultOverrideRole
+	if cfg.Role != "" {
+		role = cfg.Role
+	}
+
+	if !apputil.HasRole(ctx, role) {
+		return nil, apptypes.ErrOverrideForbidden
+	}
+
+	entries := make([]string, 0, len(req.Overrides))
+	for _, o := range req.Overrides {
+		entries = append(entries, o.App+"="+o.URL)
+	}
+
+	value := strings.Join(entries, ",")
+	if _, err := apputil.ParseOverrides(value, cfg.AllowedHosts); err != nil {
+		return nil, err
+	}
+
+	ttl := apptypes.DefaultOverrideTTL
+	if cfg.TTL > 0 {
+		ttl = cfg.TTL
+	}
+
+	expiresAt := time.Now().Add(ttl).Truncate(time.Second)
+
+	return &model.SignOverrideOutput{
+		Value:     value,
+		Signature: apputil.SignOverrides(cfg.Secret, user, value, expiresAt),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// applyOverrides rewrites the remote entries of any overridden app so that the shell loads the developers local
+// build, proxied entries are left untouched because the proxy itself follows the override
+func (s *service) applyOverrides(ctx context.Context, configuration *model.ShellConfiguration, overrides map[string]*url.URL) {
+	for id, override := range overrides {
+		app, err := s.registry.GetApp(ctx, id)
+		if err != nil {
+			s.log.Warn().Err(err).Str("app", id).Msgf("could not load app for developer override")
+			continue
+		}
+
+		target := strings.TrimSuffix(override.String(), "/")
+		ids := make(map[string]bool, len(app.Navigation))
+		for _, n := range app.Navigation {
+			ids[n.ID] = true
+		}
+
+		for _, category := range configuration.Categories {
+			for _, entry := range category.Entries {
+				if entry == nil || !ids[entry.ID] || entry.Module == nil {
+					continue
+				}
+				entry.Module.RemoteEntry = apputil.RewriteBaseURL(entry.Module.RemoteEntry, app.WebURL, target)
+				rewriteChildRemoteEntries(entry.Children, app.WebURL, target)
+			}
+		}
+
+		for _, slot := range configuration.Slots {
+			if slot == nil || slot.Module == nil {
+				continue
+			}
+			slot.Module.RemoteEntry = apputil.RewriteBaseURL(slot.Module.RemoteEntry, app.WebURL, target)
+		}
+	}
+}
+
+/************************************************************************/
+/* MAINTENANCE
+/************************************************************************/
+
+// SetMaintenance puts an app or the whole gateway into maintenance, proxied requests are rejected with a 503 until the
+// window ends or is cleared and the shell is notified so that it can show a banner
+func (s *service) SetMaintenance(ctx context.Context, req *model.SetMaintenanceInput) (*model.MaintenanceWindow, error) {
+	now := time.Now()
+
+	if req.Until != nil && !req.Until.After(now) {
+		return nil, apptypes.ErrMaintenanceEnded
+	}
+
+	m := &apptypes.Maintenance{
+		StartedAt: now,
+		Until:     req.Until,
+		Whitelist: req.Whitelist,
+	}
+
+	if req.App != nil {
+		m.App = *req.App
+	}
+
+	if req.Message != nil {
+		m.Message = *req.Message
+	}
+
+	if err := s.registry.SaveMaintenance(ctx, maintenanceField(m.App), m); err != nil {
+		return nil, fmt.Errorf("failed to store maintenance window: %w", err)
+	}
+
+	s.log.Info().Str("app", m.App).Msgf("maintenance started")
+	s.audit(ctx, model.AuditEventTypeMaintenanceSet, m.App, apputil.ActorFromContext(ctx, apptypes.AuditActorAnonymous),
+		apputil.NewAuditChange("maintenance", nil, mapMaintenance(m)))
+	s.maintenanceChanged(ctx)
+
+	return mapMaintenance(m), nil
+}
+
+// ClearMaintenance takes an app or the whole gateway out of maintenance
+func (s *service) ClearMaintenance(ctx context.Context, req *model.ClearMaintenanceInput) error {
+	var app string
+
+	if req.App != nil {
+		app = *req.App
+	}
+
+	if err := s.registry.DeleteMaintenance(ctx, maintenanceField(app)); err != nil {
+		return fmt.Errorf("failed to clear maintenance window: %w", err)
+	}
+
+	s.log.Info().Str("app", app).Msgf("maintenance cleared")
+	s.audit(ctx, model.AuditEventTypeMaintenanceCleared, app, apputil.ActorFromContext(ctx, apptypes.AuditActorAnonymous))
+	s.maintenanceChanged(ctx)
+
+	return nil
+}
+
+// GetMaintenance returns the active maintenance window that applies to an app, gateway wide maintenance takes
+// precedence, windows are cached so this is cheap enough to call on every request
+func (s *service) GetMaintenance(app string) (*apptypes.Maintenance, bool) {
+	windows, _ := s.cachedMaintenance(s.opts.Context)
+
+	now := time.Now()
+	for _, field := range []string{apptypes.MaintenanceGatewayField, app} {
+		if m, ok := windows[field]; ok && m.Active(now) {
+			return m, true
+		}
+	}
+
+	return nil, false
+}
+
+// ReloadMaintenance reloads the cached maintenance windows, every replica calls this when maintenance changes or the
+// navigation is rebuilt so that the cache never has to be refreshed on the request path
+func (s *service) ReloadMaintenance(ctx context.Context) error {
+	_, _, err := s.loadMaintenance(ctx)
+	return err
+}
+
+// maintenanceExpiryLoop ends maintenance windows once their end time has passed, runs on the leader only so that the
+// shell is notified exactly once
+func (s *service) maintenanceExpiryLoop(ctx context.Context) {
+	ticker := time.NewTicker(apptypes.MaintenanceRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.expireMaintenance(ctx); err != nil {
+				s.log.Warn().Err(err).Msgf("could not expire maintenance windows")
+			}
+		}
+	}
+}
+
+// expireMaintenance removes the maintenance windows whose end time has passed and notifies shell subscribers
+func (s *service) expireMaintenance(ctx context.Context) error {
+	windows, err := s.registry.ListMaintenance(ctx)
+	if err != nil {
+		return err
+	}
+
+	now, expired := time.Now(), false
+	for field, m := range windows {
+		if m.Active(now) {
+			continue
+		}
+
+		if err := s.registry.DeleteMaintenance(ctx, field); err != nil {
+			return err
+		}
+
+		s.log.Info().Str("app", m.App).Msgf("maintenance ended")
+		s.audit(ctx, model.AuditEventTypeMaintenanceCleared, m.App, apptypes.AuditActorSystem)
+		expired = true
+	}
+
+	if expired {
+		s.maintenanceChanged(ctx)
+	}
+
+	return nil
+}
+
+// cachedMaintenance returns the cached maintenance windows by field and by navigation entry id, they are reloaded
+// when changes are broadcast and as a safety net once the refresh interval has passed
+func (s *service) cachedMaintenance(ctx context.Context) (windows, entries map[string]*apptypes.Maintenance) {
+	s.maintenanceMu.RLock()
+	windows, entries, loadedAt := s.maintenance, s.maintenanceEntries, s.maintenanceLoadedAt
+	s.maintenanceMu.RUnlock()
+
+	if time.Since(loadedAt) <= apptypes.MaintenanceRefreshInterval {
+		return windows, entries
+	}
+
+	windows, entries, err := s.loadMaintenance(ctx)
+	if err != nil {
+		s.log.Warn().Err(err).Msgf("could not refresh maintenance windows")
+	}
+
+	return windows, entries
+}
+
+// loadMaintenance loads all maintenance windows from the registry and resolves the navigation entries of the apps in
+// maintenance, the local copy is refreshed and on failure the previous copy is returned
+func (s *service) loadMaintenance(ctx context.Context) (windows, entries map[string]*apptypes.Maintenance, err error) {
+	windows, err = s.registry.ListMaintenance(ctx)
+	if err != nil {
+		s.maintenanceMu.Lock()
+		defer s.maintenanceMu.Unlock()
+
+		// back off until the next refresh interval instead of hitting the registry on every request
+		s.maintenanceLoadedAt = time.Now()
+
+		return s.maintenance, s.maintenanceEntries, err
+	}
+
+	entries = make(map[string]*apptypes.Maintenance)
+	for field, m := range windows {
+		if field == apptypes.MaintenanceGatewayField {
+			continue
+		}
+
+		app, err := s.registry.GetApp(ctx, field)
+		if err != nil {
+			s.log.Warn().Err(err).Str("app", field).Msgf("could not load app in maintenance")
+			continue
+		}
+
+		for _, n := range app.Navigation {
+			entries[n.ID] = m
+		}
+	}
+
+	s.maintenanceMu.Lock()
+	s.maintenance = windows
+	s.maintenanceEntries = entries
+	s.maintenanceLoadedAt = time.Now()
+	s.maintenanceMu.Unlock()
+
+	return windows, entries, nil
+}
+
+// maintenanceChanged reloads the local maintenance windows and notifies the other replicas and shell subscribers
+func (s *service) maintenanceChanged(ctx context.Context) {
+	if _, _, err := s.loadMaintenance(ctx); err != nil {
+		s.log.Warn().Err(err).Msgf("could not reload maintenance windows")
+	}
+
+	if err := s.opts.NatsUseCase.Client().Publish(apptypes.MaintenanceChangedSubject, []byte("{}")); err != nil {
+		s.log.Warn().Err(err).Msgf("failed to publish maintenance changed event")
+	}
+}
+
+// applyMaintenance flags navigation entries of apps in maintenance, gateway wide maintenance flags every entry and is
+// also set on the configuration itself so the shell can show a banner
+func applyMaintenance(configuration *model.ShellConfiguration, windows, entries map[string]*apptypes.Maintenance) {
+	var (
+		now     = time.Now()
+		gateway *model.MaintenanceWindow
+	)
+
+	if m, ok := windows[apptypes.MaintenanceGatewayField]; ok && m.Active(now) {
+		gateway = mapMaintenance(m)
+	}
+
+	configuration.Maintenance = gateway
+
+	for _, category := range configuration.Categories {
+		for _, entry := range category.Entries {
+			if entry == nil {
+				continue
+			}
+
+			window := gateway
+			if m, ok := entries[entry.ID]; ok && window == nil && m.Active(now) {
+				window = mapMaintenance(m)
+			}
+
+			entry.Maintenance = window
+			entry.State = navigationState(entry.Healthy, window != nil)
+
+			setChildState(entry.Children, window != nil)
+		}
+	}
+}
+
+/************************************************************************/
+/* HELPERS
+/************************************************************************/
+
+// rewriteChildRemoteEntries rewrites the remote entries of children at any depth, see applyOverrides
+func rewriteChildRemoteEntries(children []*model.ShellNavigationChild, base, target string) {
+	for _, child := range children {
+		if child == nil {
+			continue
+		}
+
+		if child.Module != nil {
+			child.Module.RemoteEntry = apputil.RewriteBaseURL(child.Module.RemoteEntry, base, target)
+		}
+		rewriteChildRemoteEntries(child.Children, base, target)
+	}
+}
+
+// setChildState applies the maintenance state to the children of an entry at any depth
+func setChildState(children []*model.ShellNavigationChild, maintenance bool) {
+	for _, child := range children {
+		if child != nil {
+			child.State = navigationState(child.Healthy, maintenance)
+			setChildState(child.Children, maintenance)
+		}
+	}
+}
+
+// rebuildNavigation rebuilds the navigation structure for the shell and updates the entry in the cache, this process
+// is contention free because it is and should only be run on the leader in the cluster
+//
+//nolint:prealloc
+func (s *service) rebuildNavigation() error {
+	nc := s.opts.NatsUseCase.Client()
+
+	s.log.Info().Msgf("rebuilding navigation cache due to change event")
+
+	apps, err := s.registry.ListApps(s.opts.Context)
+	if err != nil {
+		s.log.Error().Err(err).Msgf("failed to list registered apps: \n%s", string(debug.Stack()))
+		return err
+	}
+
+	overrides, err := s.registry.ListNavigationOverrides(s.opts.Context)
+	if err != nil {
+		s.log.Error().Err(err).Msgf("failed to list navigation overrides")
+		return err
+	}
+
+	var (
+		localization  = s.opts.Config.Localization
+		defaultLocale = apputil.DefaultLocale(localization)
+		locales       = apputil.CollectLocales(apps)
+	)
+
+	if !slices.Contains(locales, defaultLocale) {
+		locales = append([]string{defaultLocale}, locales...)
+	}
+
+	// every locale shares the version of the rebuild so that a client switching locale can tell they are in sync, the
+	// version is allocated by the registry since every replica rebuilds and two rebuilds must never share a version
+	current := 0
+	if previous, err := s.registry.GetConfiguration(s.opts.Context, ""); err == nil {
+		current = previous.Version
+	} else if !errors.Is(err, apptypes.ErrConfigurationNotFound) {
+		s.log.Error().Err(err).Msgf("failed to load previous shell configuration")
+		return err
+	}
+
+	version, err := s.registry.NextConfigurationVersion(s.opts.Context, current)
+	if err != nil {
+		s.log.Error().Err(err).Msgf("failed to allocate shell configuration version")
+		return err
+	}
+
+	// localized configurations are saved before the default configuration so that a locale listed in the default
+	// configuration can always be loaded, the default configuration is saved under the empty locale
+	saved := []string{""}
+	for _, locale := range locales {
+		if locale == defaultLocale {
+			continue
+		}
+
+		localized := apputil.MapAppsToNavigation(apps, overrides, s.opts.Config.Navigation,
+			apputil.LocaleChain([]string{locale}, localization))
+		localized.Locale, localized.Locales = &locale, locales
+
+		if err := s.saveConfiguration(locale, version, localized); err != nil {
+			s.log.Error().Err(err).Str("locale", locale).Msgf("failed to save localized shell configuration")
+			return err
+		}
+		saved = append(saved, locale)
+	}
+
+	mappings := apputil.MapAppsToNavigation(apps, overrides, s.opts.Config.Navigation,
+		apputil.LocaleChain(nil, localization))
+	mappings.Locale, mappings.Locales = &defaultLocale, locales
+	err = s.saveConfiguration("", version, mappings)
+
+	if err == nil {
+		s.pruneConfigurationVersions(version, saved)
+
+		if err := nc.Publish(apptypes.ShellConfigurationUpdatedSubject, []byte("{}")); err != nil {
+			s.log.Warn().Err(err).Msgf("failed to publish configuration rebuilt event")
+		}
+		s.audit(s.opts.Context, model.AuditEventTypeRebuilt, "", apptypes.AuditActorSystem)
+	}
+
+	return err
+}
+
+// saveConfiguration stamps a configuration with its version and hash and saves it as the current configuration of
+// the locale, the version is also kept in the history
+func (s *service) saveConfiguration(locale string, version int, configuration *model.ShellConfiguration) error {
+	hash, err := apputil.HashConfiguration(configuration)
+	if err != nil {
+		return err
+	}
+	configuration.Version, configuration.Hash = version, &hash
+
+	if err := s.registry.SaveConfigurationVersion(s.opts.Context, locale, configuration); err != nil {
+		return err
+	}
+
+	return s.registry.SaveConfiguration(s.opts.Context, locale, configuration)
+}
+
+// pruneConfigurationVersions trims the kept versions of every locale to the configured length and removes the versions
+// of locales that are no longer served, the kept versions are listed since versions are shared by every replica and
+// can skip numbers. Versions of other locales newer than the rebuild may have been saved by a concurrent rebuild that
+// already serves the locale and are kept
+func (s *service) pruneConfigurationVersions(version int, locales []string) {
+	history := apptypes.DefaultConfigurationHistory
+	if cfg := s.opts.Config.Navigation; cfg != nil && cfg.VersionHistory > 0 {
+		history = cfg.VersionHistory
+	}
+
+	kept, err := s.registry.ListConfigurationVersions(s.opts.Context)
+	if err != nil {
+		s.log.Warn().Err(err).Msgf("failed to list shell configuration versions")
+		return
+	}
+
+	for locale, versions := range kept {
+		var expired []int
+		if slices.Contains(locales, locale) {
+			expired = versions[:max(len(versions)-history, 0)]
+		} else {
+			expired = slices.DeleteFunc(versions, func(v int) bool {
+				return v > version
+			})
+		}
+
+		for _, v := range expired {
+			if err := s.registry.DeleteConfigurationVersion(s.opts.Context, locale, v); err != nil {
+				s.log.Warn().Err(err).Str("locale", locale).Int("version", v).Msgf("failed to delete expired shell configuration")
+			}
+		}
+	}
+}
+
+// maintenanceField returns the field a maintenance window is stored under, an empty app means the whole gateway
+func maintenanceField(app string) string {
+	if app == "" {
+		return apptypes.MaintenanceGatewayField
+	}
+
+	return app
+}
+
+// mapMaintenance maps a maintenance window to the gql model
+func mapMaintenance(m *apptypes.Maintenance) *model.MaintenanceWindow {
+	w := &model.MaintenanceWindow{
+		Until:     m.Until,
+		StartedAt: m.StartedAt,
+	}
+
+	if m.App != "" {
+		w.App = &m.App
+	}
+
+	if m.Message != "" {
+		w.Message = &m.Message
+	}
+
+	return w
+}
+
+// navigationState returns the state of a navigation entry
+func navigationState(healthy, maintenance bool) model.ShellNavigationState {
+	switch {
+	case maintenance:
+		return model.ShellNavigationStateMaintenance
+	case healthy:
+		return model.ShellNavigationStateHealthy
+	default:
+		return model.ShellNavigationStateUnhealthy
+	}
+}
+
+// markApplicationAsUnavailable marks an app as unavailable, this is called when an app stops sending
+// keep alive messages for a period of time and before the navigation is rebuilt
+func (s *service) markApplicationAsUnavailable(id string) error {
+	changed, err := s.registry.SetAppAvailable(s.opts.Context, id, false)
+	if err != nil || !changed {
+		return err
+	}
+
+	s.audit(s.opts.Context, model.AuditEventTypeUnavailable, id, apptypes.AuditActorSystem,
+		apputil.NewAuditChange("available", true, false))
+
+	s.invalidateProxyTarget(id)
+
+	return nil
+}
+
+/************************************************************************/
+/* NAVIGATION OVERRIDES
+/************************************************************************/
+
+// ListNavigationOverrides returns all navigation overrides ordered by navigation id
+func (s *service) ListNavigationOverrides(ctx context.Context) ([]*model.NavigationOverride, error) {
+	overrides, err := s.registry.ListNavigationOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list navigation overrides: %w", err)
+	}
+
+	out := make([]*model.NavigationOverride, 0, len(overrides))
+	for _, o := range overrides {
+		out = append(out, apputil.MapNavigationOverrideToModel(o))
+	}
+
+	slices.SortFunc(out, func(a, b *model.NavigationOverride) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return out, nil
+}
+
+// SetNavigationOverride replaces the override of a navigation entry and rebuilds the shell configuration, overrides
+// are stored apart from the apps so that they are applied again when the app re-registers
+func (s *service) SetNavigationOverride(ctx context.Context, req *model.SetNavigationOverrideInput) (*model.NavigationOverride, error) {
+	if err := apputil.ValidateNavigationOverride(req); err != nil {
+		return nil, err
+	}
+
+	overrides, err := s.registry.ListNavigationOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list navigation overrides: %w", err)
+	}
+
+	o := &apptypes.NavigationOverride{
+		ID:        req.ID,
+		Title:     req.Title,
+		SubTitle:  req.SubTitle,
+		Icon:      req.Icon,
+		Category:  req.Category,
+		Hidden:    req.Hidden,
+		Order:     req.Order,
+		UpdatedAt: time.Now(),
+	}
+
+	if err := s.registry.SaveNavigationOverride(ctx, o); err != nil {
+		return nil, fmt.Errorf("failed to store navigation override: %w", err)
+	}
+
+	s.log.Info().Str("navigation", o.ID).Msgf("navigation override set")
+
+	var previous *model.NavigationOverride
+	if p, ok := overrides[o.ID]; ok {
+		previous = apputil.MapNavigationOverrideToModel(p)
+	}
+	s.audit(ctx, model.AuditEventTypeNavigationOverrideSet, "", apputil.ActorFromContext(ctx, apptypes.AuditActorAnonymous),
+		apputil.NewAuditChange("navigation."+o.ID, previous, apputil.MapNavigationOverrideToModel(o)))
+
+	return apputil.MapNavigationOverrideToModel(o), s.rebuildNavigation()
+}
+
+// ClearNavigationOverride removes the override of a navigation entry so that the values registered by the app are used
+func (s *service) ClearNavigationOverride(ctx context.Context, id string) error {
+	if err := s.registry.DeleteNavigationOverride(ctx, id); err != nil {
+		return fmt.Errorf("failed to clear navigation override: %w", err)
+	}
+
+	s.log.Info().Str("navigation", id).Msgf("navigation override cleared")
+	s.audit(ctx, model.AuditEventTypeNavigationOverrideCleared, "", apputil.ActorFromContext(ctx, apptypes.AuditActorAnonymous))
+
+	return s.rebuildNavigation()
+}
+
+/************************************************************************/
+/* AUDIT TRAIL
+/************************************************************************/
+
+// audit records a change to the registry in mongo, failing to record an event is logged but never fails the change
+func (s *service) audit(ctx context.Context, eventType model.AuditEventType, app, actor string, changes ...*model.AuditChange) {
+	if cfg := s.opts.Config.Audit; cfg == nil || !cfg.Enabled || s.opts.MongoUseCase == nil {
+		return
+	}
+
+	event := &model.AuditEvent{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		Actor:     actor,
+		Changes:   changes,
+		CreatedAt: time.Now(),
+	}
+
+	if app != "" {
+		event.App = &app
+	}
+
+	if err := s.opts.MongoUseCase.GenericClient().InsertOne(ctx, apptypes.AuditCollection, event); err != nil {
+		s.log.Warn().Err(err).Str("type", string(eventType)).Str("app", app).Msgf("failed to record audit event")
+	}
+}
+
+// auditAppChange records a change to an app along with the fields that changed
+func (s *service) auditAppChange(ctx context.Context, eventType model.AuditEventType, actor string, before, after *apptypes.App) {
+	changes, err := apputil.DiffApps(before, after)
+	if err != nil {
+		s.log.Warn().Err(err).Str("app", after.ID).Msgf("failed to compute audit changes")
+	}
 
-	return setCmd.Err()
+	s.audit(ctx, eventType, after.ID, actor, changes...)
 }
 
 /************************************************************************/
 /* FACTORY
 /************************************************************************/
 
-func NewService(opts *apptypes.APIGatewayOptions, log zerolog.Logger) apptypes.InternalService {
+func NewService(
+	opts *apptypes.APIGatewayOptions, registry apptypes.Registry, preferences apptypes.PreferencesStore, log zerolog.Logger,
+) apptypes.InternalService {
 	return &service{
-		log:  log,
-		opts: opts,
+		log:         log,
+		opts:        opts,
+		registry:    registry,
+		preferences: preferences,
+		searchIndex: apputil.NewSearchIndex(),
 		targetCache: imcache.NewSharded[string, *apptypes.ProxyTarget](apptypes.CacheShards, imcache.DefaultStringHasher64{},
 			imcache.WithCleanerOption[string, *apptypes.ProxyTarget](apptypes.CacheCleanupFreq),
 			imcache.WithEvictionCallbackOption[string, *apptypes.ProxyTarget](func(key string, val *apptypes.ProxyTarget, reason imcache.EvictionReason) {
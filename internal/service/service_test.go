@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	"github.com/azarc-io/verathread-gateway/internal/preferences"
+	"github.com/azarc-io/verathread-gateway/internal/registry"
+	"github.com/azarc-io/verathread-gateway/internal/testutil"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
+	natsuc "github.com/azarc-io/verathread-next-common/usecase/nats"
+	"github.com/azarc-io/verathread-next-common/util"
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errRegistryDown = errors.New("registry down")
+
+type (
+	// failingRegistry fails to store apps but otherwise behaves like a memory registry
+	failingRegistry struct {
+		apptypes.Registry
+	}
+
+	// barrierRegistry holds every read of the current configuration until all rebuilds have read it, so that every
+	// rebuild starts from the same configuration
+	barrierRegistry struct {
+		apptypes.Registry
+
+		reads *sync.WaitGroup
+	}
+
+	// countingRegistry counts the maintenance lookups of a memory registry
+	countingRegistry struct {
+		apptypes.Registry
+
+		lists atomic.Int32
+	}
+
+	// natsUseCase hands out a connection to the embedded test server
+	natsUseCase struct {
+		natsuc.NatsUseCase
+
+		conn *nats.Conn
+	}
+)
+
+func (r *barrierRegistry) GetConfiguration(ctx context.Context, locale string) (*model.ShellConfiguration, error) {
+	configuration, err := r.Registry.GetConfiguration(ctx, locale)
+
+	r.reads.Done()
+	r.reads.Wait()
+
+	return configuration, err
+}
+
+func (r *countingRegistry) ListMaintenance(ctx context.Context) (map[string]*apptypes.Maintenance, error) {
+	r.lists.Add(1)
+	return r.Registry.ListMaintenance(ctx)
+}
+
+func (n *natsUseCase) Client() *nats.Conn {
+	return n.conn
+}
+
+func (r *failingRegistry) SaveApp(context.Context, *apptypes.App) error {
+	return errRegistryDown
+}
+
+// newTestService creates a service without audit, nats or any other external dependency
+func newTestService(reg apptypes.Registry) *service {
+	opts := &apptypes.APIGatewayOptions{
+		Context: context.Background(),
+		Config:  &apptypes.APIGatewayConfig{},
+	}
+
+	return NewService(opts, reg, preferences.NewMemoryStore(), zerolog.Nop()).(*service)
+}
+
+func registerAppInput() *model.RegisterAppInput {
+	return &model.RegisterAppInput{
+		Name:            "app",
+		ID:              "app",
+		Package:         "vth:test:app",
+		Version:         "1.0.0",
+		RemoteEntryFile: "remoteEntry.js",
+		WebURL:          "http://app:8080",
+		APIURL:          "http://app:8080",
+	}
+}
+
+func TestRegisterApp_SaveFailure(t *testing.T) {
+	var (
+		mem = registry.NewMemoryRegistry(apptypes.KeepAliveTTL)
+		s   = newTestService(&failingRegistry{Registry: mem})
+		ctx = context.Background()
+	)
+
+	_, err := s.RegisterApp(ctx, registerAppInput())
+	require.ErrorIs(t, err, errRegistryDown)
+
+	history, err := mem.ListHistory(ctx, "app")
+	require.NoError(t, err)
+	assert.Empty(t, history, "no revision is recorded for a registration that was not stored")
+
+	alive, err := mem.ListHeartbeats(ctx)
+	require.NoError(t, err)
+	assert.False(t, alive["app"], "no heartbeat is sent for a registration that was not stored")
+}
+
+func TestSearchNavigation_Tenant(t *testing.T) {
+	var (
+		mem = registry.NewMemoryRegistry(apptypes.KeepAliveTTL)
+		s   = newTestService(mem)
+		ctx = context.Background()
+	)
+
+	s.opts.Config.Navigation = &apptypes.NavigationConfig{
+		DefaultPriority: apptypes.DefaultAppPriority,
+		TenantPackages:  map[string][]string{"acme": {"vth:acme:*"}},
+	}
+
+	for _, pkg := range []string{"vth:acme:orders", "vth:other:orders"} {
+		require.NoError(t, mem.SaveApp(ctx, &apptypes.App{
+			ID:        pkg,
+			Package:   pkg,
+			Available: true,
+			Navigation: []*apptypes.Navigation{{
+				ID:       pkg + ":/orders",
+				Title:    "Orders",
+				Category: apptypes.CategoryApp,
+				Module:   &apptypes.NavigationModule{Path: "/orders"},
+			}},
+		}))
+	}
+	require.NoError(t, s.RebuildSearchIndex(ctx))
+
+	apps := func(results []*model.NavigationSearchResult) []string {
+		var out []string
+		for _, r := range results {
+			out = append(out, r.App)
+		}
+		return out
+	}
+
+	results, err := s.SearchNavigation(ctx, "acme", "orders", nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vth:acme:orders"}, apps(results), "a tenant with package patterns only finds its apps")
+
+	results, err = s.SearchNavigation(ctx, "globex", "orders", nil, false)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"vth:acme:orders", "vth:other:orders"}, apps(results), "other tenants find every app")
+
+	_, err = s.SearchNavigation(ctx, "", "orders", nil, false)
+	var verr *apptypes.ValidationError
+	require.ErrorAs(t, err, &verr, "the tenant is required")
+}
+
+func TestRebuildNavigation_ConcurrentReplicas(t *testing.T) {
+	const replicas = 10
+
+	var (
+		mem   = registry.NewMemoryRegistry(apptypes.KeepAliveTTL)
+		srv   = testutil.RunNats(t)
+		ctx   = context.Background()
+		reads sync.WaitGroup
+		wg    sync.WaitGroup
+	)
+
+	require.NoError(t, mem.SaveApp(ctx, &apptypes.App{ID: "app", Package: "vth:test:app", Available: true}))
+	reads.Add(replicas)
+
+	// the replicas share the registry and rebuild the navigation at the same time
+	for i := 0; i < replicas; i++ {
+		s := newTestService(&barrierRegistry{Registry: mem, reads: &reads})
+		s.opts.NatsUseCase = &natsUseCase{conn: testutil.ConnectNats(t, srv).Conn}
+		s.opts.Config.Navigation = &apptypes.NavigationConfig{VersionHistory: replicas}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, s.rebuildNavigation())
+		}()
+	}
+	wg.Wait()
+
+	for v := 1; v <= replicas; v++ {
+		_, err := mem.GetConfigurationVersion(ctx, "", v)
+		assert.NoError(t, err, "every rebuild saves its own version %d", v)
+	}
+}
+
+func TestGetAppConfiguration_CachedMaintenance(t *testing.T) {
+	var (
+		mem = registry.NewMemoryRegistry(apptypes.KeepAliveTTL)
+		reg = &countingRegistry{Registry: mem}
+		s   = newTestService(reg)
+		ctx = context.Background()
+	)
+
+	s.opts.NatsUseCase = &natsUseCase{conn: testutil.ConnectNats(t, testutil.RunNats(t)).Conn}
+
+	require.NoError(t, mem.SaveApp(ctx, &apptypes.App{
+		ID:        "app",
+		Package:   "vth:test:app",
+		Available: true,
+		Navigation: []*apptypes.Navigation{{
+			ID:       "vth:test:app:/orders",
+			Title:    "Orders",
+			Category: apptypes.CategoryApp,
+			Module:   &apptypes.NavigationModule{Path: "/orders"},
+		}},
+	}))
+	require.NoError(t, s.rebuildNavigation())
+
+	require.NoError(t, mem.SaveMaintenance(ctx, "app", &apptypes.Maintenance{App: "app", StartedAt: time.Now()}))
+	require.NoError(t, s.ReloadMaintenance(ctx))
+	reg.lists.Store(0)
+
+	entry := func() *model.ShellNavigation {
+		cfg, err := s.GetAppConfiguration(ctx, "")
+		require.NoError(t, err)
+
+		for _, category := range cfg.Categories {
+			for _, e := range category.Entries {
+				if e.ID == "vth:test:app:/orders" {
+					return e
+				}
+			}
+		}
+
+		require.FailNow(t, "navigation entry not found")
+		return nil
+	}
+
+	for i := 0; i < 10; i++ {
+		e := entry()
+		require.NotNil(t, e.Maintenance)
+		assert.Equal(t, model.ShellNavigationStateMaintenance, e.State)
+	}
+	assert.Zero(t, reg.lists.Load(), "the shell configuration uses the cached maintenance windows")
+
+	// the windows are only reloaded when a change is broadcast
+	require.NoError(t, mem.DeleteMaintenance(ctx, "app"))
+	require.NotNil(t, entry().Maintenance)
+
+	require.NoError(t, s.ReloadMaintenance(ctx))
+	assert.Nil(t, entry().Maintenance)
+}
+
+func TestRollbackAppRegistration_Conflict(t *testing.T) {
+	var (
+		mem = registry.NewMemoryRegistry(apptypes.KeepAliveTTL)
+		s   = newTestService(mem)
+		ctx = context.Background()
+	)
+
+	s.opts.NatsUseCase = &natsUseCase{conn: testutil.ConnectNats(t, testutil.RunNats(t)).Conn}
+
+	withOrders := func(name string) *model.RegisterAppInput {
+		req := registerAppInput()
+		req.Name, req.ID, req.Package = name, name, "vth:test:"+name
+		req.Navigation = []*model.RegisterAppNavigationInput{{
+			Title:    "Orders",
+			Icon:     "list",
+			Category: apptypes.CategoryApp,
+			Module:   &model.RegisterAppModule{Path: "/orders", ExposedModule: "./Orders", ModuleName: "orders"},
+			Children: []*model.RegisterChildAppNavigationInput{{
+				Title:           "Open",
+				Icon:            "inbox",
+				Proxy:           util.Ptr(true),
+				RemoteEntryFile: util.Ptr("open.js"),
+				Module:          &model.RegisterAppModule{Path: "/orders/open", ExposedModule: "./Open", ModuleName: "open"},
+			}},
+		}}
+		return req
+	}
+
+	_, err := s.RegisterApp(ctx, withOrders("app"))
+	require.NoError(t, err)
+	registered, err := mem.GetApp(ctx, "app")
+	require.NoError(t, err)
+
+	// the app gives up the route and another app claims it
+	_, err = s.RegisterApp(ctx, registerAppInput())
+	require.NoError(t, err)
+	_, err = s.RegisterApp(ctx, withOrders("other"))
+	require.NoError(t, err)
+
+	_, err = s.RollbackAppRegistration(ctx, "app", 1)
+	var verr *apptypes.ValidationError
+	require.ErrorAs(t, err, &verr, "the rollback cannot take back a route claimed by another app")
+
+	current, err := mem.GetApp(ctx, "app")
+	require.NoError(t, err)
+	assert.Empty(t, current.Navigation, "a rejected rollback leaves the app unchanged")
+
+	// once the route is free again the revision is restored as it was registered
+	require.NoError(t, s.UnregisterApp(ctx, "other"))
+	_, err = s.RollbackAppRegistration(ctx, "app", 1)
+	require.NoError(t, err)
+
+	current, err = mem.GetApp(ctx, "app")
+	require.NoError(t, err)
+	assert.Equal(t, registered.Navigation, current.Navigation)
+	assert.Equal(t, registered.CreatedAt, current.CreatedAt)
+}
+
+func TestRebuildNavigation_PrunesVersions(t *testing.T) {
+	var (
+		mem = registry.NewMemoryRegistry(apptypes.KeepAliveTTL)
+		s   = newTestService(mem)
+		ctx = context.Background()
+	)
+
+	s.opts.NatsUseCase = &natsUseCase{conn: testutil.ConnectNats(t, testutil.RunNats(t)).Conn}
+	s.opts.Config.Navigation = &apptypes.NavigationConfig{VersionHistory: 2}
+
+	// versions skipped by other replicas and a locale that is no longer served are left from earlier rebuilds
+	for _, v := range []int{1, 4, 7} {
+		require.NoError(t, mem.SaveConfigurationVersion(ctx, "", &model.ShellConfiguration{Version: v}))
+		require.NoError(t, mem.SaveConfigurationVersion(ctx, "de", &model.ShellConfiguration{Version: v}))
+	}
+	require.NoError(t, mem.SaveConfiguration(ctx, "", &model.ShellConfiguration{Version: 7}))
+
+	// a concurrent rebuild that already serves the locale saved a newer version
+	require.NoError(t, mem.SaveConfigurationVersion(ctx, "fr", &model.ShellConfiguration{Version: 20}))
+
+	require.NoError(t, s.rebuildNavigation())
+
+	versions, err := mem.ListConfigurationVersions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]int{"": {7, 8}, "fr": {20}}, versions)
+}
+
+func TestGetFederationManifest_Overrides(t *testing.T) {
+	var (
+		mem = registry.NewMemoryRegistry(apptypes.KeepAliveTTL)
+		s   = newTestService(mem)
+		ctx = context.Background()
+	)
+
+	for _, a := range []*apptypes.App{
+		{ID: "esm", Package: "vth:test:esm", WebURL: "http://esm:8080", RemoteEntry: "index.js", Format: apptypes.ModuleFormatESM},
+		{ID: "mf", Package: "vth:test:mf", WebURL: "http://mf:8080", RemoteEntry: "remoteEntry.js"},
+	} {
+		require.NoError(t, mem.SaveApp(ctx, a))
+	}
+
+	local, err := url.Parse("http://localhost:4200/")
+	require.NoError(t, err)
+	ctx = apputil.WithOverrides(ctx, map[string]*url.URL{"esm": local, "mf": local})
+
+	im, err := s.GetImportMap(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:4200/", im.Imports["esm/"])
+
+	shell, err := s.GetFederationManifest(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, shell.Remotes, 2)
+
+	// every remote of the shell manifest resolves to the app as the import map sees it for the same caller
+	for _, remote := range shell.Remotes {
+		manifest, err := s.GetFederationManifest(ctx, remote.Alias)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("/shell/apps/%s/mf-manifest.json", remote.Alias), remote.Entry)
+		assert.Equal(t, "http://localhost:4200/", manifest.MetaData.PublicPath, "remote %s", remote.Alias)
+	}
+}
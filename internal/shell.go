@@ -0,0 +1,173 @@
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	apputil "github.com/azarc-io/verathread-gateway/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// importMapContentType is the media type of import maps
+const importMapContentType = "application/importmap+json"
+
+type (
+	// shellEndpoints serves the shell configuration and the module artifacts of the registered apps as plain json for
+	// consumers without a graphql client such as the shells bootstrap script, responses carry a strong etag derived
+	// from their content
+	shellEndpoints struct {
+		is    apptypes.InternalService
+		cfg   *apptypes.ShellEndpointsConfig
+		users string
+	}
+)
+
+// register adds the shell endpoints to the public server, the user, override and locale middleware of the server
+// tailor the configuration to the caller the same way as for the graphql api
+func (s *shellEndpoints) register(e *echo.Echo) {
+	e.GET("/shell/config", s.serveConfig)
+	e.GET("/shell/importmap.json", s.serveImportMap)
+	e.GET("/shell/mf-manifest.json", s.serveFederationManifest)
+	e.GET("/shell/apps/:appId/mf-manifest.json", s.serveFederationManifest)
+}
+
+// serveConfig responds with the shell configuration of a tenant e.g. /shell/config?tenant=example
+func (s *shellEndpoints) serveConfig(c echo.Context) error {
+	tenant := c.QueryParam("tenant")
+	if tenant == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "tenant is required")
+	}
+
+	ctx := c.Request().Context()
+
+	configuration, err := s.is.GetAppConfiguration(ctx, tenant)
+	if err != nil {
+		if errors.Is(err, apptypes.ErrConfigurationNotFound) {
+			return echo.NewHTTPError(http.StatusServiceUnavailable, "shell configuration is not available yet").SetInternal(err)
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "could not load shell configuration").SetInternal(err)
+	}
+
+	hash := ""
+	if configuration.Hash != nil {
+		hash = *configuration.Hash
+	}
+
+	shared := apputil.UserFromContext(ctx) == "" && len(apputil.OverridesFromContext(ctx)) == 0
+
+	return s.respond(c, echo.MIMEApplicationJSON, configuration, hash, shared, "Accept-Language", s.users)
+}
+
+// serveImportMap responds with the browser import map of the registered apps
+func (s *shellEndpoints) serveImportMap(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	im, err := s.is.GetImportMap(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "could not build import map").SetInternal(err)
+	}
+
+	return s.respond(c, importMapContentType, im, "", len(apputil.OverridesFromContext(ctx)) == 0)
+}
+
+// serveFederationManifest responds with the module federation manifest of an app or of the shell when no app is given
+func (s *shellEndpoints) serveFederationManifest(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	manifest, err := s.is.GetFederationManifest(ctx, c.Param("appId"))
+	if err != nil {
+		if errors.Is(err, apptypes.ErrAppNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("app %s is not registered", c.Param("appId")))
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "could not build module federation manifest").SetInternal(err)
+	}
+
+	return s.respond(c, echo.MIMEApplicationJSON, manifest, "", len(apputil.OverridesFromContext(ctx)) == 0)
+}
+
+// respond writes a json body with its etag and cache headers, the etag is derived from the hash when given and from
+// the body otherwise. Responses may only be stored by shared caches when the endpoints are public and the response
+// is not tailored to the caller, responses always vary by developer override
+func (s *shellEndpoints) respond(c echo.Context, contentType string, body any, hash string, shared bool, vary ...string) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	if hash == "" {
+		sum := sha256.Sum256(b)
+		hash = hex.EncodeToString(sum[:])
+	}
+
+	etag := `"` + hash + `"`
+
+	h := c.Response().Header()
+	h.Set("ETag", etag)
+	h.Set("Cache-Control", s.cacheControl(shared && s.cfg != nil && s.cfg.Public))
+	h.Set("Vary", strings.Join(append(vary, apptypes.OverrideHeader, apptypes.OverrideSignatureHeader, "Cookie"), ", "))
+
+	if etagMatches(c.Request().Header.Get("If-None-Match"), etag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	return c.Blob(http.StatusOK, contentType, b)
+}
+
+// cacheControl returns the cache control header of a response, without a max age the client has to revalidate every
+// time which is cheap because unchanged configurations are answered with a 304
+func (s *shellEndpoints) cacheControl(shared bool) string {
+	scope := "private"
+	if shared {
+		scope = "public"
+	}
+
+	if s.cfg == nil || s.cfg.MaxAge <= 0 {
+		return scope + ", no-cache"
+	}
+
+	cc := fmt.Sprintf("%s, max-age=%d", scope, int(s.cfg.MaxAge.Seconds()))
+	if s.cfg.StaleWhileRevalidate > 0 {
+		cc += fmt.Sprintf(", stale-while-revalidate=%d", int(s.cfg.StaleWhileRevalidate.Seconds()))
+	}
+
+	return cc
+}
+
+// etagMatches reports whether an If-None-Match header matches an etag, the header may list several etags and uses
+// the weak comparison so that W/ prefixed etags added by intermediaries still match
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// newShellEndpoints creates the shell endpoints, the user header is needed to tell caches that responses vary by user
+func newShellEndpoints(
+	is apptypes.InternalService, cfg *apptypes.ShellEndpointsConfig, prefs *apptypes.PreferencesConfig,
+) *shellEndpoints {
+	users := apptypes.DefaultUserHeader
+	if prefs != nil && prefs.UserHeader != "" {
+		users = prefs.UserHeader
+	}
+
+	return &shellEndpoints{is: is, cfg: cfg, users: users}
+}
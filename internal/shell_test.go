@@ -0,0 +1,27 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEtagMatches(t *testing.T) {
+	const etag = `"abc"`
+
+	tests := []struct {
+		header string
+		match  bool
+	}{
+		{header: "", match: false},
+		{header: `"abc"`, match: true},
+		{header: `W/"abc"`, match: true},
+		{header: `"xyz", W/"abc"`, match: true},
+		{header: `"xyz"`, match: false},
+		{header: "*", match: true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, etagMatches(tt.header, etag), tt.header)
+	}
+}
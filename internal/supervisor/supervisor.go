@@ -0,0 +1,153 @@
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+)
+
+type (
+	// Task is a long-running background task, it must block until the context is cancelled, returning before that is
+	// treated as a failure and the task is restarted
+	Task func(ctx context.Context) error
+
+	// Supervisor runs a single instance of a leader-only task between Start and Stop, failed tasks are restarted with
+	// an exponential backoff. Starting a running supervisor is a no-op so repeated promotions never stack up tasks
+	Supervisor struct {
+		name string
+		task Task
+		log  zerolog.Logger
+
+		mu       sync.Mutex
+		cancel   context.CancelFunc
+		done     chan struct{}
+		lastErr  error
+		restarts int
+	}
+)
+
+// Start starts the task unless it is already running
+func (s *Supervisor) Start(parent context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancel != nil {
+		s.log.Debug().Str("task", s.name).Msgf("task is already running")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	s.cancel = cancel
+	s.done = make(chan struct{})
+	s.lastErr = nil
+	s.restarts = 0
+
+	s.log.Info().Str("task", s.name).Msgf("starting task")
+
+	go s.run(ctx, s.done)
+}
+
+// Stop stops the task and waits for it to exit, stopping a supervisor that is not running is a no-op
+func (s *Supervisor) Stop() {
+	s.mu.Lock()
+	cancel, done := s.cancel, s.done
+	s.cancel, s.done = nil, nil
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+
+	s.log.Info().Str("task", s.name).Msgf("stopped task")
+}
+
+// Running returns true between Start and Stop
+func (s *Supervisor) Running() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cancel != nil
+}
+
+// Health returns an error while a running task is failing, it is meant to be registered with healthz
+func (s *Supervisor) Health() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancel == nil || s.lastErr == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s failed %d time(s), last error: %w", s.name, s.restarts, s.lastErr)
+}
+
+// run runs the task until the context is cancelled, restarting it with a backoff whenever it returns
+func (s *Supervisor) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	backoff := apptypes.SupervisorMinBackoff
+
+	for {
+		started := time.Now()
+		err := s.call(ctx)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err == nil {
+			err = apptypes.ErrTaskExited
+		}
+
+		// a task that ran for a while before failing starts over with the minimum backoff
+		if time.Since(started) > apptypes.SupervisorMaxBackoff {
+			backoff = apptypes.SupervisorMinBackoff
+		}
+
+		s.mu.Lock()
+		s.lastErr = err
+		s.restarts++
+		s.mu.Unlock()
+
+		s.log.Error().Err(err).Str("task", s.name).Msgf("task failed, restarting in %s", backoff)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, apptypes.SupervisorMaxBackoff)
+	}
+}
+
+// call invokes the task and turns a panic into an error
+func (s *Supervisor) call(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", apptypes.ErrTaskPanicked, r)
+		}
+	}()
+
+	return s.task(ctx)
+}
+
+/************************************************************************/
+/* FACTORY
+/************************************************************************/
+
+// New creates a supervisor for a leader-only task, the task is not started until Start is called
+func New(name string, task Task, log zerolog.Logger) *Supervisor {
+	return &Supervisor{
+		name: name,
+		task: task,
+		log:  log,
+	}
+}
@@ -0,0 +1,131 @@
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTask = errors.New("task failed")
+
+// blockingTask counts its invocations and blocks until the context is cancelled
+func blockingTask(calls *atomic.Int32) Task {
+	return func(ctx context.Context) error {
+		calls.Add(1)
+		<-ctx.Done()
+		return nil
+	}
+}
+
+func TestSupervisor_StartIsIdempotent(t *testing.T) {
+	var calls atomic.Int32
+
+	s := New("test", blockingTask(&calls), zerolog.Nop())
+	t.Cleanup(s.Stop)
+
+	s.Start(context.Background())
+	s.Start(context.Background())
+
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond*10)
+
+	s.Start(context.Background())
+
+	// give a stacked task the chance to start
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, int32(1), calls.Load(), "repeated promotions run a single task")
+	assert.True(t, s.Running())
+}
+
+func TestSupervisor_PromoteDemotePromote(t *testing.T) {
+	var calls atomic.Int32
+
+	s := New("test", blockingTask(&calls), zerolog.Nop())
+	t.Cleanup(s.Stop)
+
+	s.Start(context.Background())
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond*10)
+
+	s.Stop()
+	assert.False(t, s.Running())
+
+	// stopping twice is a no-op
+	s.Stop()
+
+	s.Start(context.Background())
+	require.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, time.Millisecond*10)
+	assert.True(t, s.Running())
+}
+
+func TestSupervisor_StopDuringBackoff(t *testing.T) {
+	var calls atomic.Int32
+
+	s := New("test", func(ctx context.Context) error {
+		calls.Add(1)
+		return errTask
+	}, zerolog.Nop())
+	t.Cleanup(s.Stop)
+
+	s.Start(context.Background())
+	require.Eventually(t, func() bool { return s.Health() != nil }, time.Second, time.Millisecond*10)
+	assert.ErrorIs(t, s.Health(), errTask)
+
+	// the supervisor now waits for the backoff before restarting, stopping must not wait for it
+	stopped := time.Now()
+	s.Stop()
+	assert.Less(t, time.Since(stopped), apptypes.SupervisorMinBackoff)
+	assert.NoError(t, s.Health(), "a stopped supervisor is healthy")
+
+	// promoting again starts over without the failures of the previous run
+	s.Start(context.Background())
+	require.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, time.Millisecond*10)
+}
+
+func TestSupervisor_RestartsPanickingTask(t *testing.T) {
+	var calls atomic.Int32
+
+	s := New("test", func(ctx context.Context) error {
+		if calls.Add(1) == 1 {
+			panic("boom")
+		}
+		<-ctx.Done()
+		return nil
+	}, zerolog.Nop())
+	t.Cleanup(s.Stop)
+
+	s.Start(context.Background())
+
+	require.Eventually(t, func() bool { return calls.Load() == 2 }, apptypes.SupervisorMinBackoff*3, time.Millisecond*10)
+	assert.ErrorIs(t, s.Health(), apptypes.ErrTaskPanicked)
+	assert.True(t, s.Running())
+}
+
+func TestSupervisor_StopWaitsForTask(t *testing.T) {
+	var (
+		calls  atomic.Int32
+		exited atomic.Bool
+	)
+
+	s := New("test", func(ctx context.Context) error {
+		calls.Add(1)
+		<-ctx.Done()
+
+		// simulate a task that takes a moment to clean up
+		time.Sleep(time.Millisecond * 100)
+		exited.Store(true)
+
+		return nil
+	}, zerolog.Nop())
+
+	s.Start(context.Background())
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond*10)
+
+	s.Stop()
+	assert.True(t, exited.Load(), "stop returns after the task exited")
+}
@@ -0,0 +1,8 @@
+package templates
+
+import "embed"
+
+// Errors contains the built-in error pages, they can be replaced by providing a directory in the gateway config
+//
+//go:embed *.html
+var Errors embed.FS
@@ -0,0 +1,65 @@
+// Package testutil holds helpers that are shared by the tests of several packages
+package testutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+)
+
+// natsReadyTimeout is how long to wait for the embedded server to accept connections
+const natsReadyTimeout = time.Second * 5
+
+type (
+	// NatsClient hands out a connection to an embedded server, it satisfies the parts of the nats use case the
+	// gateway uses
+	NatsClient struct {
+		Conn *nats.Conn
+	}
+)
+
+func (c *NatsClient) Client() *nats.Conn {
+	return c.Conn
+}
+
+// RunNats starts an embedded nats server with jetstream enabled on a random port, the server is shut down when the
+// test finishes
+func RunNats(t *testing.T) *server.Server {
+	t.Helper()
+
+	srv, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("could not create nats server: %v", err)
+	}
+
+	go srv.Start()
+	t.Cleanup(srv.Shutdown)
+
+	if !srv.ReadyForConnections(natsReadyTimeout) {
+		t.Fatal("nats server did not become ready")
+	}
+
+	return srv
+}
+
+// ConnectNats connects to an embedded server, every connection stands in for another replica of the gateway
+func ConnectNats(t *testing.T, srv *server.Server) *NatsClient {
+	t.Helper()
+
+	nc, err := nats.Connect(srv.ClientURL())
+	if err != nil {
+		t.Fatalf("could not connect to nats server: %v", err)
+	}
+	t.Cleanup(nc.Close)
+
+	return &NatsClient{Conn: nc}
+}
@@ -4,10 +4,50 @@ import "time"
 
 const (
 	ShellConfigurationUpdatedSubject = "gateway.shell.v1.configuration.rebuilt"
+	ProxyTargetInvalidatedSubject    = "gateway.proxy.v1.target.invalidated"
 	TargetURLKey                     = "targetUrl"
 	AppNameKey                       = "appName"
+	AssetRequestKey                  = "assetRequest"
+	CacheStatusHeader                = "X-Gateway-Cache"
+	FallbackHeader                   = "X-Gateway-Fallback"
+	FallbackVersionHeader            = "X-Gateway-Fallback-Version"
+	MaintenanceChangedSubject        = "gateway.shell.v1.maintenance.changed"
+	MaintenanceKey                   = "maintenance"
+	MaintenanceGatewayField          = "*"
+	NavigationOverridesKey           = "navigation:overrides"
+	DefaultUserHeader                = "X-User-Id"
+	DefaultRolesHeader               = "X-User-Roles"
+	DefaultLocale                    = "en"
+	RegistryRedis                    = "redis"
+	RegistryMemory                   = "memory"
+	RegistryNats                     = "nats"
+	DefaultRegistryBucket            = "gateway"
+	ConflictPolicyReject             = "reject"
+	ConflictPolicyFirstWins          = "first-wins"
+	ConflictPolicyNamespace          = "namespace"
+	CategoryApp                      = "App"
+	CategorySetting                  = "Setting"
+	CategoryDashboard                = "Dashboard"
+	CategoryFavourites               = "Favourites"
+	CategoryPolicyFallback           = "fallback"
+	CategoryPolicyDrop               = "drop"
+	CategoryPolicyReject             = "reject"
+	AuditCollection                  = "audit_events"
+	PreferencesCollection            = "user_preferences"
+	AuditActorSystem                 = "system"
+	AuditActorAppPrefix              = "app:"
+	AuditActorAnonymous              = "anonymous"
+	AuditActorUnauthenticated        = "unauthenticated"
+	DefaultAuditActorHeader          = "X-User-Id"
 	KeySpaceExpiryChannel            = "__key*__:expired"
 	KeepAliveKeySpacePrefix          = "app:keepalive"
+	OverrideHeader                   = "vth-override"
+	ProxyRemoteEntryFile             = "remoteEntry.js"
+	OverrideSignatureHeader          = "vth-override-sig"
+	DefaultOverrideRole              = "developer"
+	ModuleFormatRemoteEntry          = "remoteEntry"
+	ModuleFormatESM                  = "esm"
+	ModuleFormatSystemJS             = "systemjs"
 )
 
 var (
@@ -15,4 +55,36 @@ var (
 	CacheCleanupFreq    = time.Second * 30
 	TargetCacheDuration = time.Minute * 2
 	KeepAliveTTL        = time.Second * 10
+
+	DefaultReconcileInterval = time.Second * 30
+	DefaultHistoryLimit      = 10
+	SupervisorMinBackoff     = time.Second
+	SupervisorMaxBackoff     = time.Second * 30
+
+	MaintenanceRefreshInterval   = time.Second * 5
+	DefaultMaintenanceRetryAfter = time.Minute * 5
+
+	DefaultOverrideTTL = time.Hour * 8
+
+	DefaultRecentsLimit = 10
+	RecentVisitInterval = time.Minute
+
+	DefaultSearchLimit = 10
+	MaxSearchLimit     = 50
+
+	DefaultConfigurationHistory = 10
+
+	DefaultAppPriority   = 100
+	DefaultPriorityRules = []*PriorityRule{{Pattern: "vth:azarc*", Priority: 0}}
+	DefaultCategories    = []*NavigationCategory{
+		{Key: CategoryDashboard, Title: "Dashboards", Priority: 2},
+		{Key: CategoryApp, Title: "Apps", Priority: 0},
+		{Key: CategorySetting, Title: "Settings", Priority: 1},
+	}
+
+	DefaultAssetCacheMemory          int64 = 64 << 20
+	DefaultAssetEntryMaxAge                = time.Second * 10
+	DefaultAssetStaleWhileRevalidate       = time.Minute
+	DefaultAssetImmutableMaxAge            = time.Hour * 24 * 365
+	DefaultHashedAssetPattern              = `[.\-_][0-9a-fA-F]{8,}\.(js|mjs|css|woff2?|ttf|png|jpe?g|gif|svg|ico|map)$`
 )
@@ -1,60 +1,86 @@
 package types
 
 import (
+	"cmp"
 	"encoding/json"
-
-	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	"fmt"
+	"slices"
 	"time"
 )
 
 type (
 	App struct {
-		ID                      string            `json:"id" bson:"_id,omitempty"`
-		Name                    string            `json:"name" bson:"name,omitempty"`
-		Package                 string            `json:"package" bson:"package,omitempty"`
-		Version                 string            `json:"version" bson:"version,omitempty"`
-		APIURL                  string            `json:"apiURL" bson:"apiURL,omitempty"`
-		WebURL                  string            `json:"webURL" bson:"webURL,omitempty" yaml:"WebURL"`
-		RemoteEntry             string            `json:"remoteEntry,omitempty" bson:"remoteEntry"`
-		Proxy                   bool              `json:"proxy" bson:"proxy,omitempty" yaml:"proxy"`
-		Navigation              []*Navigation     `json:"navigation" bson:"navigation,omitempty"`
-		Slot1                   *NavigationSlot   `json:"slot1,omitempty" bson:"slot1,omitempty" yaml:"slot1"`
-		Slot2                   *NavigationSlot   `json:"slot2,omitempty" bson:"slot2,omitempty" yaml:"slot2"`
-		Slot3                   *NavigationSlot   `json:"slot3,omitempty" bson:"slot3,omitempty" yaml:"slot3"`
-		CreatedAt               time.Time         `json:"createdAt" bson:"createdAt,omitempty"`
-		UpdatedAt               time.Time         `json:"updatedAt" bson:"updatedAt,omitempty"`
-		Adopted                 bool              `json:"adopted" bson:"adopted,omitempty"`
-		Available               bool              `json:"available" bson:"available,omitempty"`
-		RemoteEntryRewriteRegEx map[string]string `json:"remoteEntryRewriteRegEx,omitempty" bson:"remoteEntryRewriteRegEx,omitempty"`
+		ID                      string                `json:"id" bson:"_id,omitempty"`
+		Name                    string                `json:"name" bson:"name,omitempty"`
+		Package                 string                `json:"package" bson:"package,omitempty"`
+		Version                 string                `json:"version" bson:"version,omitempty"`
+		APIURL                  string                `json:"apiURL" bson:"apiURL,omitempty"`
+		WebURL                  string                `json:"webURL" bson:"webURL,omitempty" yaml:"WebURL"`
+		RemoteEntry             string                `json:"remoteEntry,omitempty" bson:"remoteEntry"`
+		Proxy                   bool                  `json:"proxy" bson:"proxy,omitempty" yaml:"proxy"`
+		Navigation              []*Navigation         `json:"navigation" bson:"navigation,omitempty"`
+		Categories              []*NavigationCategory `json:"categories,omitempty" bson:"categories,omitempty"`
+		Slots                   []*NavigationSlot     `json:"slots,omitempty" bson:"slots,omitempty" yaml:"slots"`
+		CreatedAt               time.Time             `json:"createdAt" bson:"createdAt,omitempty"`
+		UpdatedAt               time.Time             `json:"updatedAt" bson:"updatedAt,omitempty"`
+		Adopted                 bool                  `json:"adopted" bson:"adopted,omitempty"`
+		Available               bool                  `json:"available" bson:"available,omitempty"`
+		RemoteEntryRewriteRegEx map[string]string     `json:"remoteEntryRewriteRegEx,omitempty" bson:"remoteEntryRewriteRegEx,omitempty"`
+		// Format is how the remote entry is loaded, one of remoteEntry (default), esm or systemjs
+		Format string              `json:"format,omitempty" bson:"format,omitempty"`
+		Shared []*SharedDependency `json:"shared,omitempty" bson:"shared,omitempty"`
+	}
+
+	// SharedDependency is a dependency an app shares with the shell and other apps, dependencies with a url are
+	// added to the import map
+	SharedDependency struct {
+		Name            string `json:"name" bson:"name"`
+		Version         string `json:"version" bson:"version"`
+		RequiredVersion string `json:"requiredVersion,omitempty" bson:"requiredVersion,omitempty"`
+		Singleton       bool   `json:"singleton,omitempty" bson:"singleton,omitempty"`
+		Eager           bool   `json:"eager,omitempty" bson:"eager,omitempty"`
+		URL             string `json:"url,omitempty" bson:"url,omitempty"`
 	}
 
+	// Navigation is a navigation entry of an app, children use the same structure to any depth and carry their own
+	// module and remote entry
 	Navigation struct {
-		ID           string                    `json:"id" bson:"id,omitempty" yaml:"id"`
-		Title        string                    `json:"title" bson:"title,omitempty" yaml:"title"`
-		SubTitle     string                    `json:"subTitle,omitempty" bson:"subTitle,omitempty" yaml:"subTitle"`
-		AuthRequired bool                      `json:"authRequired,omitempty" bson:"authRequired,omitempty" yaml:"authRequired"`
-		Hidden       bool                      `json:"hidden,omitempty" bson:"hidden,omitempty" yaml:"hidden"`
-		Category     model.RegisterAppCategory `json:"category" bson:"category,omitempty" yaml:"category"`
-		Children     []*Navigation             `json:"children,omitempty" bson:"children,omitempty" yaml:"children"`
-		RemoteEntry  string                    `json:"remoteEntry" bson:"remoteEntry,omitempty" yaml:"remoteEntry"`
-		Module       *NavigationModule         `json:"module,omitempty" bson:"module,omitempty" yaml:"module"`
-		Icon         string                    `json:"icon,omitempty" bson:"icon" yaml:"icon"`
-	}
-
-	NavigationChild struct {
-		Title        string             `json:"title,omitempty" bson:"title"`
-		SubTitle     string             `json:"subTitle,omitempty" bson:"subTitle"`
-		AuthRequired bool               `json:"authRequired,omitempty" bson:"authRequired"`
-		Path         string             `json:"path,omitempty" bson:"path"`
-		Children     []*NavigationChild `json:"children,omitempty" bson:"children"`
-		Icon         string             `json:"icon,omitempty" bson:"icon"`
-		Module       *NavigationModule  `json:"module,omitempty" bson:"module"`
+		ID           string            `json:"id" bson:"id,omitempty" yaml:"id"`
+		Title        string            `json:"title" bson:"title,omitempty" yaml:"title"`
+		SubTitle     string            `json:"subTitle,omitempty" bson:"subTitle,omitempty" yaml:"subTitle"`
+		AuthRequired bool              `json:"authRequired,omitempty" bson:"authRequired,omitempty" yaml:"authRequired"`
+		Hidden       bool              `json:"hidden,omitempty" bson:"hidden,omitempty" yaml:"hidden"`
+		Category     string            `json:"category" bson:"category,omitempty" yaml:"category"`
+		Children     []*Navigation     `json:"children,omitempty" bson:"children,omitempty" yaml:"children"`
+		RemoteEntry  string            `json:"remoteEntry" bson:"remoteEntry,omitempty" yaml:"remoteEntry"`
+		Proxy        bool              `json:"proxy,omitempty" bson:"proxy,omitempty" yaml:"proxy"`
+		Format       string            `json:"format,omitempty" bson:"format,omitempty" yaml:"format"`
+		Module       *NavigationModule `json:"module,omitempty" bson:"module,omitempty" yaml:"module"`
+		Icon         string            `json:"icon,omitempty" bson:"icon" yaml:"icon"`
+		Order        int               `json:"order,omitempty" bson:"order,omitempty" yaml:"order"`
+		Translations Translations      `json:"translations,omitempty" bson:"translations,omitempty" yaml:"translations"`
+		Keywords     []string          `json:"keywords,omitempty" bson:"keywords,omitempty" yaml:"keywords"`
+		Synonyms     []string          `json:"synonyms,omitempty" bson:"synonyms,omitempty" yaml:"synonyms"`
 	}
 
+	// Translations are the localized texts of a navigation entry or slot keyed by canonical BCP-47 tag
+	Translations map[string]*Translation
+
+	// Translation holds the texts of a single locale, empty texts fall back along the locale chain
+	Translation struct {
+		Title       string `json:"title,omitempty" bson:"title,omitempty" yaml:"title"`
+		SubTitle    string `json:"subTitle,omitempty" bson:"subTitle,omitempty" yaml:"subTitle"`
+		Description string `json:"description,omitempty" bson:"description,omitempty" yaml:"description"`
+	}
+
+	// NavigationSlot is a contribution of an app to a named shell slot e.g. header-right or user-menu
 	NavigationSlot struct {
+		Slot         string                `json:"slot"`
 		Description  string                `json:"description,omitempty"`
 		AuthRequired bool                  `json:"authRequired,omitempty"`
 		Module       *NavigationSlotModule `json:"module,omitempty"`
+		Order        int                   `json:"order,omitempty"`
+		Translations Translations          `json:"translations,omitempty"`
 	}
 
 	NavigationModule struct {
@@ -64,13 +90,128 @@ type (
 		Outlet        string `json:"outlet,omitempty" bson:"outlet"`
 	}
 
+	// Maintenance is a maintenance window for a single app or the whole gateway when the app is empty, a window
+	// without an end time lasts until it is cleared
+	Maintenance struct {
+		App       string     `json:"app,omitempty"`
+		Message   string     `json:"message,omitempty"`
+		Until     *time.Time `json:"until,omitempty"`
+		StartedAt time.Time  `json:"startedAt"`
+		Whitelist []string   `json:"whitelist,omitempty"`
+	}
+
+	// NavigationCategory groups navigation entries in the shell, categories are defined in the gateway config or
+	// registered by privileged apps and navigation entries reference them by key
+	NavigationCategory struct {
+		Key      string `json:"key" yaml:"key"`
+		Title    string `json:"title" yaml:"title"`
+		Icon     string `json:"icon,omitempty" yaml:"icon"`
+		Priority int    `json:"priority" yaml:"priority"`
+		Hidden   bool   `json:"hidden,omitempty" yaml:"hidden"`
+	}
+
+	// NavigationOverride replaces fields of an app provided navigation entry, it is keyed by the navigation id and kept
+	// apart from the app so that it survives re-registration, nil fields keep the value the app registered
+	NavigationOverride struct {
+		ID        string    `json:"id"`
+		Title     *string   `json:"title,omitempty"`
+		SubTitle  *string   `json:"subTitle,omitempty"`
+		Icon      *string   `json:"icon,omitempty"`
+		Category  *string   `json:"category,omitempty"`
+		Hidden    *bool     `json:"hidden,omitempty"`
+		Order     *int      `json:"order,omitempty"`
+		UpdatedAt time.Time `json:"updatedAt"`
+	}
+
+	// UserPreferences are the shell settings chosen by a single user, pinned and hidden entries are navigation ids
+	UserPreferences struct {
+		User         string       `json:"user" bson:"user"`
+		DefaultRoute string       `json:"defaultRoute,omitempty" bson:"defaultRoute,omitempty"`
+		Pinned       []string     `json:"pinned,omitempty" bson:"pinned,omitempty"`
+		Hidden       []string     `json:"hidden,omitempty" bson:"hidden,omitempty"`
+		Recents      []*RecentApp `json:"recents,omitempty" bson:"recents,omitempty"`
+		UpdatedAt    time.Time    `json:"updatedAt" bson:"updatedAt"`
+	}
+
+	// RecentApp is an app the user has loaded a module of
+	RecentApp struct {
+		App       string    `json:"app" bson:"app"`
+		VisitedAt time.Time `json:"visitedAt" bson:"visitedAt"`
+	}
+
+	// AppRevision is a single registration of an app kept in its registration history
+	AppRevision struct {
+		Revision     int       `json:"revision"`
+		RegisteredAt time.Time `json:"registeredAt"`
+		RollbackOf   int       `json:"rollbackOf,omitempty"`
+		App          *App      `json:"app"`
+	}
+
 	NavigationSlotModule struct {
 		Path          string `json:"path,omitempty" bson:"path"`
 		ExposedModule string `json:"exposedModule,omitempty" bson:"exposedModule"`
 		ModuleName    string `json:"moduleName,omitempty" bson:"moduleName"`
 	}
+
+	// RegistryStatus reports whether reads are being served from the last known good snapshot because the registry is
+	// unavailable, since is when the registry became unavailable and snapshotAt when the snapshot was taken
+	RegistryStatus struct {
+		Degraded   bool       `json:"degraded"`
+		Since      *time.Time `json:"since,omitempty"`
+		SnapshotAt *time.Time `json:"snapshotAt,omitempty"`
+	}
 )
 
+// Active returns true if the maintenance window has not ended yet
+func (m *Maintenance) Active(now time.Time) bool {
+	return m.Until == nil || now.Before(*m.Until)
+}
+
+// Allows returns true if a user id or ip address has been whitelisted for this maintenance window
+func (m *Maintenance) Allows(ids ...string) bool {
+	for _, id := range ids {
+		if id != "" && slices.Contains(m.Whitelist, id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Resolve returns the texts for a locale chain, every text is taken from the first locale in the chain that provides
+// it and texts that no locale provides are empty
+func (t Translations) Resolve(chain []string) Translation {
+	var out Translation
+	for i := len(chain) - 1; i >= 0; i-- {
+		tr, ok := t[chain[i]]
+		if !ok {
+			continue
+		}
+
+		out.Title = cmp.Or(tr.Title, out.Title)
+		out.SubTitle = cmp.Or(tr.SubTitle, out.SubTitle)
+		out.Description = cmp.Or(tr.Description, out.Description)
+	}
+
+	return out
+}
+
+func (m Maintenance) MarshalBinary() (data []byte, err error) {
+	return json.Marshal(m)
+}
+
+func (m *Maintenance) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, &m)
+}
+
+func (o NavigationOverride) MarshalBinary() (data []byte, err error) {
+	return json.Marshal(o)
+}
+
+func (o *NavigationOverride) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, &o)
+}
+
 func (a App) MarshalBinary() (data []byte, err error) {
 	return json.Marshal(a)
 }
@@ -78,3 +219,39 @@ func (a App) MarshalBinary() (data []byte, err error) {
 func (a *App) UnmarshalBinary(data []byte) error {
 	return json.Unmarshal(data, &a)
 }
+
+// UnmarshalJSON moves the slot1, slot2 and slot3 contributions of apps stored before slots were named into slots, so
+// that they keep their slots until they register again
+func (a *App) UnmarshalJSON(data []byte) error {
+	type app App
+
+	stored := struct {
+		*app
+		Slot1 *NavigationSlot `json:"slot1,omitempty"`
+		Slot2 *NavigationSlot `json:"slot2,omitempty"`
+		Slot3 *NavigationSlot `json:"slot3,omitempty"`
+	}{app: (*app)(a)}
+
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+
+	for i, slot := range []*NavigationSlot{stored.Slot1, stored.Slot2, stored.Slot3} {
+		if slot == nil {
+			continue
+		}
+
+		if slot.Slot == "" {
+			slot.Slot = LegacySlotName(i + 1)
+		}
+
+		a.Slots = append(a.Slots, slot)
+	}
+
+	return nil
+}
+
+// LegacySlotName returns the name of the slot that the deprecated numbered slot fields contribute to
+func LegacySlotName(n int) string {
+	return fmt.Sprintf("slot-%d", n)
+}
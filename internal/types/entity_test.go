@@ -0,0 +1,37 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_UnmarshalLegacySlots(t *testing.T) {
+	const stored = `{
+		"id": "app",
+		"version": "1.0.0",
+		"slot1": {"description": "first", "module": {"path": "/first"}},
+		"slot3": {"description": "third"}
+	}`
+
+	var app App
+	require.NoError(t, json.Unmarshal([]byte(stored), &app))
+
+	assert.Equal(t, "1.0.0", app.Version)
+	require.Len(t, app.Slots, 2)
+	assert.Equal(t, "slot-1", app.Slots[0].Slot)
+	assert.Equal(t, "first", app.Slots[0].Description)
+	assert.Equal(t, "/first", app.Slots[0].Module.Path)
+	assert.Equal(t, "slot-3", app.Slots[1].Slot)
+
+	// apps are written back without the legacy fields
+	b, err := json.Marshal(&app)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "slot1")
+
+	var migrated App
+	require.NoError(t, migrated.UnmarshalBinary(b))
+	assert.Equal(t, app.Slots, migrated.Slots, "migrated apps are read back unchanged")
+}
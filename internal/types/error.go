@@ -1,8 +1,73 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 var (
 	ErrRebuildNavigationFailed = errors.New("failed to rebuild navigation")
 	ErrGatewayNotReady         = errors.New("gateway is not ready")
+	ErrOverridesDisabled       = errors.New("developer overrides are not enabled")
+	ErrOverrideSignature       = errors.New("developer override signature is invalid")
+	ErrOverrideHostNotAllowed  = errors.New("developer override host is not allowed")
+	ErrOverrideMalformed       = errors.New("developer override is malformed")
+	ErrOverrideExpired         = errors.New("developer override signature has expired")
+	ErrOverrideSecretMissing   = errors.New("developer override secret is not configured")
+	ErrOverrideForbidden       = errors.New("user is not allowed to use developer overrides")
+	ErrAppNotFound             = errors.New("app not found")
+	ErrRevisionNotFound        = errors.New("app registration revision not found")
+	ErrConfigurationNotFound   = errors.New("shell configuration has not been built yet")
+	ErrTaskExited              = errors.New("task exited unexpectedly")
+	ErrTaskPanicked            = errors.New("task panicked")
+	ErrRegistryUnavailable     = errors.New("registry is unavailable and there is no snapshot to serve")
+	ErrUnknownConflictPolicy   = errors.New("unknown registration conflict policy")
+	ErrUnknownCategoryPolicy   = errors.New("unknown navigation category policy")
+	ErrUnknownRegistry         = errors.New("unknown registry backend")
+	ErrMaintenanceEnded        = errors.New("maintenance end time is in the past")
+	ErrUserRequired            = errors.New("user could not be identified")
+)
+
+const (
+	FieldRequired = "REQUIRED"
+	FieldInvalid  = "INVALID"
+	FieldConflict = "CONFLICT"
+)
+
+type (
+	// FieldError describes a problem with a single input field, the field is the path of the field in the input
+	FieldError struct {
+		Field   string
+		Code    string
+		Message string
+	}
+
+	// ValidationError collects all field errors found while validating a request
+	ValidationError struct {
+		Errors []*FieldError
+	}
 )
+
+// Add records a field error
+func (e *ValidationError) Add(field, code, format string, args ...any) {
+	e.Errors = append(e.Errors, &FieldError{Field: field, Code: code, Message: fmt.Sprintf(format, args...)})
+}
+
+// OrNil returns nil if no field errors were recorded so the result can be returned as an error
+func (e *ValidationError) OrNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, fe := range e.Errors {
+		messages = append(messages, fe.Field+": "+fe.Message)
+	}
+
+	return "validation failed: " + strings.Join(messages, ", ")
+}
@@ -19,6 +19,13 @@ type (
 		Navigation []*AppModule `json:"navigation"`
 	}
 
+	// ProxyTargetInvalidatedEvent is broadcast to all replicas when an apps proxy target changes, urls contains the
+	// previous urls of the app so that proxies cached for them can be dropped
+	ProxyTargetInvalidatedEvent struct {
+		App  string   `json:"app"`
+		URLs []string `json:"urls,omitempty"`
+	}
+
 	AppModule struct {
 		ID                      string            `json:"id"`
 		Proxy                   bool              `json:"proxy"`
@@ -0,0 +1,88 @@
+package types
+
+type (
+	// ImportMap is a browser import map of the registered apps, es and SystemJS modules are mapped by app name and
+	// shared dependencies with a url are mapped globally when they are singletons or scoped to their app otherwise
+	ImportMap struct {
+		Imports map[string]string            `json:"imports"`
+		Scopes  map[string]map[string]string `json:"scopes,omitempty"`
+	}
+
+	// FederationManifest is a module federation 2 manifest (mf-manifest.json) of an app or of the shell, the manifest of
+	// the shell lists every app as a remote
+	FederationManifest struct {
+		ID       string              `json:"id"`
+		Name     string              `json:"name"`
+		MetaData *FederationMetaData `json:"metaData"`
+		Shared   []*FederationShared `json:"shared"`
+		Remotes  []*FederationRemote `json:"remotes"`
+		Exposes  []*FederationExpose `json:"exposes"`
+	}
+
+	FederationMetaData struct {
+		Name          string                 `json:"name"`
+		Type          string                 `json:"type"`
+		BuildInfo     *FederationBuildInfo   `json:"buildInfo"`
+		RemoteEntry   *FederationRemoteEntry `json:"remoteEntry"`
+		Types         *FederationTypes       `json:"types"`
+		GlobalName    string                 `json:"globalName"`
+		PluginVersion string                 `json:"pluginVersion"`
+		PublicPath    string                 `json:"publicPath"`
+	}
+
+	FederationBuildInfo struct {
+		BuildVersion string `json:"buildVersion"`
+		BuildName    string `json:"buildName"`
+	}
+
+	// FederationRemoteEntry is the remote entry of a manifest relative to the public path, the type is one of global
+	// for module federation containers, module for es modules or system for SystemJS modules
+	FederationRemoteEntry struct {
+		Name string `json:"name"`
+		Path string `json:"path"`
+		Type string `json:"type"`
+	}
+
+	FederationTypes struct {
+		Path string `json:"path"`
+		Name string `json:"name"`
+	}
+
+	// FederationShared is a shared dependency declared by an app, the gateway does not know the files of a dependency
+	// so its assets are left empty
+	FederationShared struct {
+		ID              string            `json:"id"`
+		Name            string            `json:"name"`
+		Version         string            `json:"version"`
+		Singleton       bool              `json:"singleton"`
+		RequiredVersion string            `json:"requiredVersion"`
+		Eager           bool              `json:"eager,omitempty"`
+		Assets          *FederationAssets `json:"assets"`
+	}
+
+	// FederationRemote is an app listed in the manifest of the shell, the entry is the url of the manifest of the app
+	FederationRemote struct {
+		FederationContainerName string `json:"federationContainerName"`
+		ModuleName              string `json:"moduleName"`
+		Alias                   string `json:"alias"`
+		Entry                   string `json:"entry"`
+	}
+
+	// FederationExpose is a module exposed by an app, taken from the modules of its navigation and slots
+	FederationExpose struct {
+		ID     string            `json:"id"`
+		Name   string            `json:"name"`
+		Path   string            `json:"path"`
+		Assets *FederationAssets `json:"assets"`
+	}
+
+	FederationAssets struct {
+		JS  *FederationAssetFiles `json:"js"`
+		CSS *FederationAssetFiles `json:"css"`
+	}
+
+	FederationAssetFiles struct {
+		Sync  []string `json:"sync"`
+		Async []string `json:"async"`
+	}
+)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
 )
@@ -15,10 +16,102 @@ type (
 
 	InternalService interface {
 		GetAppConfiguration(ctx context.Context, tenant string) (*model.ShellConfiguration, error)
+		GetAppConfigurationVersion(ctx context.Context, tenant string, version int) (*model.ShellConfiguration, error)
 		RegisterApp(ctx context.Context, req *model.RegisterAppInput) (*model.RegisterAppOutput, error)
 		KeepAlive(ctx context.Context, req *model.KeepAliveAppInput) (*model.KeepAliveAppOutput, error)
+		GetAppRegistrationHistory(ctx context.Context, id string) ([]*model.AppRegistrationRevision, error)
+		RollbackAppRegistration(ctx context.Context, id string, revision int) (*model.RegisterAppOutput, error)
+		UnregisterApp(ctx context.Context, id string) error
 		GetProxyTarget(app string) (*ProxyTarget, bool)
-		Watch() error
-		UnWatch() error
+		EvictProxyTarget(app string) (*ProxyTarget, bool)
+		SignOverride(ctx context.Context, req *model.SignOverrideInput) (*model.SignOverrideOutput, error)
+		SetMaintenance(ctx context.Context, req *model.SetMaintenanceInput) (*model.MaintenanceWindow, error)
+		ClearMaintenance(ctx context.Context, req *model.ClearMaintenanceInput) error
+		GetMaintenance(app string) (*Maintenance, bool)
+		ReloadMaintenance(ctx context.Context) error
+		ListNavigationOverrides(ctx context.Context) ([]*model.NavigationOverride, error)
+		SetNavigationOverride(ctx context.Context, req *model.SetNavigationOverrideInput) (*model.NavigationOverride, error)
+		ClearNavigationOverride(ctx context.Context, id string) error
+		GetUserPreferences(ctx context.Context) (*model.UserPreferences, error)
+		SetDefaultRoute(ctx context.Context, route *string) (*model.UserPreferences, error)
+		SetNavigationPinned(ctx context.Context, id string, pinned bool) (*model.UserPreferences, error)
+		SetNavigationHidden(ctx context.Context, id string, hidden bool) (*model.UserPreferences, error)
+		ClearRecentApps(ctx context.Context) (*model.UserPreferences, error)
+		RecordRecentApp(ctx context.Context, app string) error
+		SearchNavigation(ctx context.Context, tenant, query string, limit *int, includeUnavailable bool) ([]*model.NavigationSearchResult, error)
+		RebuildSearchIndex(ctx context.Context) error
+		GetImportMap(ctx context.Context) (*ImportMap, error)
+		GetFederationManifest(ctx context.Context, app string) (*FederationManifest, error)
+		Watch(ctx context.Context) error
+	}
+
+	// LeaderElection reports when this replica becomes or stops being the leader of the cluster, the callback is run
+	// in its own goroutine and receives a value on the promote and demote channels for every change
+	LeaderElection interface {
+		SubscribeToElectionEvents(fn func(onPromote <-chan time.Time, onDemote <-chan time.Time))
+	}
+
+	// Registry stores registered apps, their heartbeats, maintenance windows, navigation overrides and the generated shell configuration,
+	// apps that stop sending heartbeats are reported through WatchExpired so that they can be marked as unavailable
+	Registry interface {
+		AppRegistry
+		ConfigurationRegistry
+		MaintenanceRegistry
+		OverrideRegistry
+	}
+
+	// AppRegistry stores registered apps, their registration history and heartbeats
+	AppRegistry interface {
+		GetApp(ctx context.Context, id string) (*App, error)
+		ListApps(ctx context.Context) ([]*App, error)
+		SaveApp(ctx context.Context, app *App) error
+		DeleteApp(ctx context.Context, id string) error
+		// SetAppAvailable changes only the availability of an app without overwriting concurrent changes to the rest of
+		// the app, returns true if the availability changed
+		SetAppAvailable(ctx context.Context, id string, available bool) (bool, error)
+		AppendHistory(ctx context.Context, rev *AppRevision, limit int) error
+		ListHistory(ctx context.Context, id string) ([]*AppRevision, error)
+		Heartbeat(ctx context.Context, id string) error
+		RefreshHeartbeat(ctx context.Context, id string) (bool, error)
+		ListHeartbeats(ctx context.Context) (map[string]bool, error)
+		WatchExpired(ctx context.Context, fn func(id string)) error
+	}
+
+	// ConfigurationRegistry stores the generated shell configuration of every locale and its earlier versions
+	ConfigurationRegistry interface {
+		// GetConfiguration and SaveConfiguration read and write the shell configuration of a locale, the configuration
+		// for the default locale is stored under the empty locale
+		GetConfiguration(ctx context.Context, locale string) (*model.ShellConfiguration, error)
+		SaveConfiguration(ctx context.Context, locale string, configuration *model.ShellConfiguration) error
+		// GetConfigurationVersion, SaveConfigurationVersion and DeleteConfigurationVersion keep earlier versions of the
+		// shell configuration of a locale so that clients can be sent a patch instead of the full configuration
+		GetConfigurationVersion(ctx context.Context, locale string, version int) (*model.ShellConfiguration, error)
+		SaveConfigurationVersion(ctx context.Context, locale string, configuration *model.ShellConfiguration) error
+		DeleteConfigurationVersion(ctx context.Context, locale string, version int) error
+		// ListConfigurationVersions returns the kept versions of every locale in ascending order
+		ListConfigurationVersions(ctx context.Context) (map[string][]int, error)
+		// NextConfigurationVersion allocates the version of the next shell configuration, versions are unique across
+		// replicas and higher than current which seeds the counter from the configuration saved before it existed
+		NextConfigurationVersion(ctx context.Context, current int) (int, error)
+	}
+
+	// MaintenanceRegistry stores the maintenance windows of apps and of the whole gateway
+	MaintenanceRegistry interface {
+		ListMaintenance(ctx context.Context) (map[string]*Maintenance, error)
+		SaveMaintenance(ctx context.Context, field string, m *Maintenance) error
+		DeleteMaintenance(ctx context.Context, field string) error
+	}
+
+	// OverrideRegistry stores the navigation overrides set by administrators
+	OverrideRegistry interface {
+		ListNavigationOverrides(ctx context.Context) (map[string]*NavigationOverride, error)
+		SaveNavigationOverride(ctx context.Context, o *NavigationOverride) error
+		DeleteNavigationOverride(ctx context.Context, id string) error
+	}
+
+	// PreferencesStore keeps the shell preferences of users, unknown users get empty preferences
+	PreferencesStore interface {
+		GetUserPreferences(ctx context.Context, user string) (*UserPreferences, error)
+		SaveUserPreferences(ctx context.Context, p *UserPreferences) error
 	}
 )
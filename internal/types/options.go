@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	authzuc "github.com/azarc-io/verathread-next-common/usecase/authz"
 	httpuc "github.com/azarc-io/verathread-next-common/usecase/http"
@@ -21,6 +22,7 @@ type (
 		PublicHTTPUseCase  httpuc.HttpUseCase
 		PrivateHTTPUseCase httpuc.HttpUseCase
 		ServiceName        string
+		DataDir            string
 		RedisUseCase       redisuc.RedisUseCase
 		Context            context.Context
 		NatsUseCase        natsuc.NatsUseCase
@@ -35,6 +37,157 @@ type (
 		Services      map[string]Service     `yaml:"services"`
 		BackofficeOrg string                 `yaml:"backoffice_org"`
 		AssetsToScan  []string               `yaml:"assets_to_scan"`
+		Overrides     *OverrideConfig        `yaml:"overrides"`
+		AssetCache    *AssetCacheConfig      `yaml:"asset_cache"`
+		Fallback      *FallbackConfig        `yaml:"fallback"`
+		ErrorPages    *ErrorPagesConfig      `yaml:"error_pages"`
+		Maintenance   *MaintenanceConfig     `yaml:"maintenance"`
+		Registry      *RegistryConfig        `yaml:"registry"`
+		Registration  *RegistrationConfig    `yaml:"registration"`
+		Audit         *AuditConfig           `yaml:"audit"`
+		Navigation    *NavigationConfig      `yaml:"navigation"`
+		Preferences   *PreferencesConfig     `yaml:"preferences"`
+		Localization  *LocalizationConfig    `yaml:"localization"`
+		Shell         *ShellEndpointsConfig  `yaml:"shell"`
+		Trust         *TrustConfig           `yaml:"trust"`
+	}
+
+	// TrustConfig lists the networks of the authenticating proxies in front of the gateway, the user and roles headers
+	// as well as the forwarded client address are only trusted on requests coming from one of these networks so that
+	// without any proxies configured every caller is anonymous
+	TrustConfig struct {
+		Proxies     []string `yaml:"proxies"`
+		RolesHeader string   `yaml:"roles_header"`
+	}
+
+	// OverrideConfig controls per request developer overrides of an apps remote entry, when enabled a developer can
+	// point a single browser session at a locally running micro frontend without touching the shared registry. Overrides
+	// are signed for the user requesting them, only users with the role can sign and apply them and a signature is
+	// valid for the ttl, overrides are rejected while no secret is configured
+	OverrideConfig struct {
+		Enabled      bool          `yaml:"enabled"`
+		Secret       string        `yaml:"secret"`
+		AllowedHosts []string      `yaml:"allowed_hosts"`
+		Role         string        `yaml:"role"`
+		TTL          time.Duration `yaml:"ttl"`
+	}
+
+	// AssetCacheConfig configures the gateway side cache for federated module assets, hashed chunks are cached for a
+	// long time while mutable files such as remoteEntry.js are revalidated against the upstream
+	AssetCacheConfig struct {
+		Enabled              bool          `yaml:"enabled"`
+		MaxMemoryBytes       int64         `yaml:"max_memory_bytes"`
+		Disk                 bool          `yaml:"disk"`
+		EntryMaxAge          time.Duration `yaml:"entry_max_age"`
+		StaleWhileRevalidate time.Duration `yaml:"stale_while_revalidate"`
+		ImmutableMaxAge      time.Duration `yaml:"immutable_max_age"`
+		HashedAssetPattern   string        `yaml:"hashed_asset_pattern"`
+	}
+
+	// FallbackConfig enables serving the last known good assets of an app from the data directory when the app is down
+	FallbackConfig struct {
+		Enabled bool `yaml:"enabled"`
+	}
+
+	// ErrorPagesConfig points at a directory of html templates that replace the built-in error pages, templates are
+	// looked up by status code e.g. 404.html, then app-unavailable.html for unreachable apps and finally error.html
+	ErrorPagesConfig struct {
+		Dir string `yaml:"dir"`
+	}
+
+	// MaintenanceConfig controls who can still use apps while they are in maintenance, whitelist entries are matched
+	// against the id of the authenticated user and against the client ip address
+	MaintenanceConfig struct {
+		Whitelist []string `yaml:"whitelist"`
+	}
+
+	// RegistryConfig selects where registered apps and the shell configuration are stored, one of redis (default),
+	// memory for single node development or nats which uses jetstream key value buckets prefixed with the bucket name,
+	// with snapshot enabled a last known good copy is kept in the data directory and served while the registry is down
+	RegistryConfig struct {
+		Backend           string        `yaml:"backend"`
+		Bucket            string        `yaml:"bucket"`
+		ReconcileInterval time.Duration `yaml:"reconcile_interval"`
+		Snapshot          bool          `yaml:"snapshot"`
+	}
+
+	// RegistrationConfig controls how conflicts between the routes and slots of different apps are handled, one of
+	// reject (default) which fails the registration, first-wins which drops the conflicting entries of the app being
+	// registered or namespace which prefixes the conflicting paths with the app name, the last history limit
+	// registrations of every app are kept so that they can be rolled back
+	RegistrationConfig struct {
+		ConflictPolicy string `yaml:"conflict_policy"`
+		HistoryLimit   int    `yaml:"history_limit"`
+	}
+
+	// AuditConfig enables recording registry changes to mongo, the actor of a change made through the private api is
+	// read from the actor header, apps registering themselves and changes made by the gateway use their own identity
+	AuditConfig struct {
+		Enabled     bool   `yaml:"enabled"`
+		ActorHeader string `yaml:"actor_header"`
+	}
+
+	// NavigationConfig controls how the shell navigation is built, apps are prioritised by the first rule whose package
+	// pattern matches and apps without a matching rule get the default priority, a lower priority is placed first.
+	// Categories replace the built-in App, Setting and Dashboard categories, apps whose package matches one of the
+	// category packages can register their own, entries in an unknown category are handled by the unknown category
+	// policy, one of fallback (default) which moves them to the fallback category, drop or reject which fails the registration
+	NavigationConfig struct {
+		DefaultPriority  int                   `yaml:"default_priority"`
+		PriorityRules    []*PriorityRule       `yaml:"priority_rules"`
+		Categories       []*NavigationCategory `yaml:"categories"`
+		CategoryPackages []string              `yaml:"category_packages"`
+		UnknownCategory  string                `yaml:"unknown_category"`
+		FallbackCategory string                `yaml:"fallback_category"`
+		// DefaultRoute and TenantDefaultRoutes are where the shell lands when the user has not chosen a route
+		DefaultRoute        string            `yaml:"default_route"`
+		TenantDefaultRoutes map[string]string `yaml:"tenant_default_routes"`
+		// TenantPackages limits the apps a tenant finds in the navigation search to the packages matching one of its
+		// patterns, tenants that are not listed find every app
+		TenantPackages map[string][]string `yaml:"tenant_packages"`
+		// Slots are the shell slots apps may contribute to, when empty any slot name is accepted
+		Slots []*SlotDefinition `yaml:"slots"`
+		// VersionHistory is the number of earlier shell configuration versions kept to send patches from
+		VersionHistory int `yaml:"version_history"`
+	}
+
+	// SlotDefinition is a named slot in the shell, at most Max contributions are shown in the slot and a Max of 0
+	// means the slot is unlimited
+	SlotDefinition struct {
+		Name string `yaml:"name"`
+		Max  int    `yaml:"max"`
+	}
+
+	// PreferencesConfig controls how users are identified when reading and storing their shell preferences, the
+	// user id is taken from the user header which is only trusted when set by one of the trusted proxies. Pinned entries
+	// are shown in a synthetic favourites category when FavouritesCategory is set
+	PreferencesConfig struct {
+		UserHeader         string `yaml:"user_header"`
+		RecentsLimit       int    `yaml:"recents_limit"`
+		FavouritesCategory bool   `yaml:"favourites_category"`
+	}
+
+	// LocalizationConfig controls how localized navigation texts are resolved, the plain texts of a registration are
+	// in the default locale and a locale falls back to its parent e.g. de-CH to de, then to its configured fallbacks
+	// and finally to the default locale
+	LocalizationConfig struct {
+		DefaultLocale string              `yaml:"default_locale"`
+		Fallbacks     map[string][]string `yaml:"fallbacks"`
+	}
+
+	// ShellEndpointsConfig controls the cache headers of the plain http shell endpoints e.g. /shell/config, responses
+	// are revalidated with their etag once max age has passed and are private unless public is set, even then responses
+	// tailored to a user or a developer override are never shared
+	ShellEndpointsConfig struct {
+		MaxAge               time.Duration `yaml:"max_age"`
+		StaleWhileRevalidate time.Duration `yaml:"stale_while_revalidate"`
+		Public               bool          `yaml:"public"`
+	}
+
+	// PriorityRule assigns a priority to all apps with a matching package, patterns use path.Match syntax e.g. vth:azarc*
+	PriorityRule struct {
+		Pattern  string `yaml:"pattern"`
+		Priority int    `yaml:"priority"`
 	}
 
 	Service struct {
@@ -108,3 +261,9 @@ func WithServiceName(name string) APIGatewayOption {
 		o.ServiceName = name
 	}
 }
+
+func WithDataDir(dir string) APIGatewayOption {
+	return func(o *APIGatewayOptions) {
+		o.DataDir = dir
+	}
+}
@@ -13,13 +13,24 @@ type (
 	ProxyTarget struct {
 		ID           string
 		Name         string
+		Version      string
 		WebURL       *url.URL
 		APIURL       *url.URL
 		Meta         echo.Map
 		RegexRewrite map[*regexp.Regexp]string
+		// Overridden is set when the web url points at a developers local build which must never be cached
+		Overridden bool `json:"-"`
 	}
 )
 
+// WithWebURL returns a copy of the target that loads web modules from a different url, used by developer overrides
+func (a *ProxyTarget) WithWebURL(webURL *url.URL) *ProxyTarget {
+	t := *a
+	t.WebURL = webURL
+	t.Overridden = true
+	return &t
+}
+
 func (a ProxyTarget) MarshalBinary() (data []byte, err error) {
 	return json.Marshal(a)
 }
@@ -0,0 +1,112 @@
+package apputil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/azarc-io/verathread-next-common/util"
+)
+
+type actorContextKey struct{}
+
+// auditIgnoredFields are app fields that change on every registration and would only add noise to the audit trail
+var auditIgnoredFields = []string{"createdAt", "updatedAt"}
+
+// WithActor stores the identity of the caller making a change in the context
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the identity of the caller making a change, falls back to the given actor if there is none
+func ActorFromContext(ctx context.Context, fallback string) string {
+	if actor, ok := ctx.Value(actorContextKey{}).(string); ok && actor != "" {
+		return actor
+	}
+
+	return fallback
+}
+
+// DiffApps compares two versions of an app field by field, the before and after values of every change are json
+// encoded, a nil app is treated as an app without any fields set
+func DiffApps(before, after *apptypes.App) ([]*model.AuditChange, error) {
+	a, err := appFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := appFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	var changes []*model.AuditChange
+	for _, k := range keys {
+		if slices.Contains(auditIgnoredFields, k) {
+			continue
+		}
+
+		va, vb := a[k], b[k]
+		if bytes.Equal(va, vb) {
+			continue
+		}
+
+		change := &model.AuditChange{Field: k}
+		if va != nil {
+			change.Before = util.Ptr(string(va))
+		}
+		if vb != nil {
+			change.After = util.Ptr(string(vb))
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func appFields(app *apptypes.App) (map[string]json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+	if app == nil {
+		return fields, nil
+	}
+
+	b, err := json.Marshal(app)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, json.Unmarshal(b, &fields)
+}
+
+// NewAuditChange creates a change for a single field, nil values are left out and anything else is json encoded
+func NewAuditChange(field string, before, after any) *model.AuditChange {
+	change := &model.AuditChange{Field: field}
+
+	if before != nil {
+		if b, err := json.Marshal(before); err == nil {
+			change.Before = util.Ptr(string(b))
+		}
+	}
+
+	if after != nil {
+		if b, err := json.Marshal(after); err == nil {
+			change.After = util.Ptr(string(b))
+		}
+	}
+
+	return change
+}
@@ -0,0 +1,114 @@
+package apputil
+
+import (
+	"cmp"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+)
+
+// ResolveCategories returns the navigation categories known to the gateway, the configured categories (or the
+// built-in ones) come first in their configured order followed by the categories registered by apps, a category
+// registered by an app never replaces one with the same key that is already known
+func ResolveCategories(apps []*apptypes.App, cfg *apptypes.NavigationConfig) []*apptypes.NavigationCategory {
+	configured := apptypes.DefaultCategories
+	if cfg != nil && len(cfg.Categories) > 0 {
+		configured = cfg.Categories
+	}
+
+	var (
+		categories = slices.Clone(configured)
+		registered []*apptypes.NavigationCategory
+		known      = make(map[string]bool, len(configured))
+	)
+
+	for _, c := range configured {
+		known[c.Key] = true
+	}
+
+	// apps are visited in id order so that the first registration of a key wins regardless of the registry order
+	sorted := slices.Clone(apps)
+	slices.SortFunc(sorted, func(a, b *apptypes.App) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	for _, a := range sorted {
+		if !CanRegisterCategories(a.Package, cfg) {
+			continue
+		}
+
+		for _, c := range a.Categories {
+			if !known[c.Key] {
+				known[c.Key] = true
+				registered = append(registered, c)
+			}
+		}
+	}
+
+	slices.SortFunc(registered, func(a, b *apptypes.NavigationCategory) int {
+		return cmp.Or(cmp.Compare(a.Priority, b.Priority), strings.Compare(a.Key, b.Key))
+	})
+
+	return append(categories, registered...)
+}
+
+// CanRegisterCategories reports whether an app with the given package is allowed to register its own categories
+func CanRegisterCategories(pkg string, cfg *apptypes.NavigationConfig) bool {
+	if cfg == nil {
+		return false
+	}
+
+	for _, pattern := range cfg.CategoryPackages {
+		if ok, err := path.Match(pattern, pkg); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UnknownCategoryPolicy returns the configured policy for entries that reference a category that does not exist
+func UnknownCategoryPolicy(cfg *apptypes.NavigationConfig) string {
+	if cfg == nil || cfg.UnknownCategory == "" {
+		return apptypes.CategoryPolicyFallback
+	}
+
+	return cfg.UnknownCategory
+}
+
+// FallbackCategory returns the key of the category that receives entries whose category does not exist
+func FallbackCategory(cfg *apptypes.NavigationConfig) string {
+	if cfg == nil || cfg.FallbackCategory == "" {
+		return apptypes.CategoryApp
+	}
+
+	return cfg.FallbackCategory
+}
+
+// MapRegisterCategoriesToEntity maps the categories registered by an app to entities
+func MapRegisterCategoriesToEntity(req []*model.RegisterNavigationCategoryInput) []*apptypes.NavigationCategory {
+	categories := make([]*apptypes.NavigationCategory, 0, len(req))
+	for _, c := range req {
+		category := &apptypes.NavigationCategory{
+			Key:   c.Key,
+			Title: c.Title,
+		}
+
+		if c.Icon != nil {
+			category.Icon = *c.Icon
+		}
+		if c.Priority != nil {
+			category.Priority = *c.Priority
+		}
+		if c.Hidden != nil {
+			category.Hidden = *c.Hidden
+		}
+
+		categories = append(categories, category)
+	}
+
+	return categories
+}
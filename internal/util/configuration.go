@@ -0,0 +1,159 @@
+package apputil
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+)
+
+// JSON-Patch operations produced by DiffConfiguration
+const (
+	PatchAdd     = "add"
+	PatchRemove  = "remove"
+	PatchReplace = "replace"
+)
+
+// HashConfiguration returns a hash of the content of a configuration, the version, hash and patch fields are not part
+// of the content
+func HashConfiguration(configuration *model.ShellConfiguration) (string, error) {
+	b, err := json.Marshal(configurationContent(configuration))
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// DiffConfiguration returns the JSON-Patch style operations that turn the base configuration into the next one,
+// objects are compared field by field while lists of a different length are replaced as a whole
+func DiffConfiguration(base, next *model.ShellConfiguration) ([]*model.ShellConfigurationPatchOperation, error) {
+	from, err := toJSONValue(configurationContent(base))
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := toJSONValue(configurationContent(next))
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]*model.ShellConfigurationPatchOperation, 0)
+	diffJSONValue(&ops, "", from, to)
+
+	return ops, nil
+}
+
+// ConfigurationPatch returns a configuration that only holds the patch from the base configuration to the next one
+func ConfigurationPatch(base, next *model.ShellConfiguration) (*model.ShellConfiguration, error) {
+	ops, err := DiffConfiguration(base, next)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ShellConfiguration{
+		Version:     next.Version,
+		Hash:        next.Hash,
+		BaseVersion: &base.Version,
+		Patch:       ops,
+	}, nil
+}
+
+// ConfigurationSince returns the patch from the configuration a client holds to the current one, the full current
+// configuration is returned when the base version is no longer known or its hash does not match the clients hash
+func ConfigurationSince(base, current *model.ShellConfiguration, hash *string) (*model.ShellConfiguration, error) {
+	if base == nil || (hash != nil && (base.Hash == nil || *base.Hash != *hash)) {
+		return current, nil
+	}
+
+	return ConfigurationPatch(base, current)
+}
+
+// configurationContent returns a copy of the configuration without the version, hash and patch fields
+func configurationContent(configuration *model.ShellConfiguration) *model.ShellConfiguration {
+	c := *configuration
+	c.Version, c.Hash, c.BaseVersion, c.Patch = 0, nil, nil, nil
+
+	return &c
+}
+
+func toJSONValue(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var out any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func diffJSONValue(ops *[]*model.ShellConfigurationPatchOperation, path string, from, to any) {
+	switch f := from.(type) {
+	case map[string]any:
+		t, ok := to.(map[string]any)
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(f)+len(t))
+		for k := range f {
+			keys = append(keys, k)
+		}
+		for k := range t {
+			if _, exists := f[k]; !exists {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			p := path + "/" + escapePointer(k)
+			fv, inFrom := f[k]
+			tv, inTo := t[k]
+
+			switch {
+			case !inTo || tv == nil:
+				if inFrom && fv != nil {
+					*ops = append(*ops, &model.ShellConfigurationPatchOperation{Op: PatchRemove, Path: p})
+				}
+			case !inFrom || fv == nil:
+				*ops = append(*ops, &model.ShellConfigurationPatchOperation{Op: PatchAdd, Path: p, Value: tv})
+			default:
+				diffJSONValue(ops, p, fv, tv)
+			}
+		}
+
+		return
+	case []any:
+		t, ok := to.([]any)
+		if !ok || len(f) != len(t) {
+			break
+		}
+
+		for i := range f {
+			diffJSONValue(ops, fmt.Sprintf("%s/%d", path, i), f[i], t[i])
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*ops = append(*ops, &model.ShellConfigurationPatchOperation{Op: PatchReplace, Path: path, Value: to})
+	}
+}
+
+// escapePointer escapes a key for use in a JSON pointer
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,209 @@
+package apputil
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+)
+
+// shellFederationName is the name of the shell in its module federation manifest
+const shellFederationName = "shell"
+
+// BuildImportMap builds the browser import map of the registered apps, es and SystemJS modules are mapped by app name
+// along with a prefix mapping for their other files. Shared singletons are mapped globally by the first app declaring
+// them while other shared dependencies are scoped to the public path of their app
+func BuildImportMap(apps []*apptypes.App) *apptypes.ImportMap {
+	im := &apptypes.ImportMap{
+		Imports: make(map[string]string),
+		Scopes:  make(map[string]map[string]string),
+	}
+
+	for _, a := range sortedApps(apps) {
+		publicPath := PublicPath(a)
+
+		if a.Format == apptypes.ModuleFormatESM || a.Format == apptypes.ModuleFormatSystemJS {
+			im.Imports[a.ID] = RemoteEntryURL(a)
+			im.Imports[a.ID+"/"] = publicPath
+		}
+
+		for _, dep := range a.Shared {
+			if dep.URL == "" {
+				continue
+			}
+
+			if !dep.Singleton {
+				if im.Scopes[publicPath] == nil {
+					im.Scopes[publicPath] = make(map[string]string)
+				}
+				im.Scopes[publicPath][dep.Name] = dep.URL
+				continue
+			}
+
+			if _, ok := im.Imports[dep.Name]; !ok {
+				im.Imports[dep.Name] = dep.URL
+			}
+		}
+	}
+
+	return im
+}
+
+// BuildFederationManifest builds the module federation 2 manifest of an app, the modules it exposes are taken from
+// its navigation and slots
+func BuildFederationManifest(a *apptypes.App) *apptypes.FederationManifest {
+	publicPath := PublicPath(a)
+	entry := strings.TrimPrefix(RemoteEntryURL(a), publicPath)
+
+	dir := path.Dir(entry)
+	if dir == "." {
+		dir = ""
+	}
+
+	m := &apptypes.FederationManifest{
+		ID:   a.ID,
+		Name: a.ID,
+		MetaData: &apptypes.FederationMetaData{
+			Name:      a.ID,
+			Type:      "app",
+			BuildInfo: &apptypes.FederationBuildInfo{BuildVersion: a.Version, BuildName: a.Package},
+			RemoteEntry: &apptypes.FederationRemoteEntry{
+				Name: path.Base(entry),
+				Path: dir,
+				Type: remoteEntryType(a.Format),
+			},
+			Types:      &apptypes.FederationTypes{},
+			GlobalName: a.ID,
+			PublicPath: publicPath,
+		},
+		Shared:  make([]*apptypes.FederationShared, 0, len(a.Shared)),
+		Remotes: []*apptypes.FederationRemote{},
+		Exposes: []*apptypes.FederationExpose{},
+	}
+
+	for _, dep := range a.Shared {
+		m.Shared = append(m.Shared, &apptypes.FederationShared{
+			ID:              a.ID + ":" + dep.Name,
+			Name:            dep.Name,
+			Version:         dep.Version,
+			Singleton:       dep.Singleton,
+			RequiredVersion: dep.RequiredVersion,
+			Eager:           dep.Eager,
+			Assets:          emptyFederationAssets(),
+		})
+	}
+
+	for _, exposed := range exposedModules(a) {
+		name := strings.TrimPrefix(exposed, "./")
+		m.Exposes = append(m.Exposes, &apptypes.FederationExpose{
+			ID:     a.ID + ":" + name,
+			Name:   name,
+			Path:   "./" + name,
+			Assets: emptyFederationAssets(),
+		})
+	}
+
+	return m
+}
+
+// BuildShellFederationManifest builds the module federation 2 manifest of the shell, every app is listed as a remote
+// whose entry is the manifest of the app served by the gateway
+func BuildShellFederationManifest(apps []*apptypes.App) *apptypes.FederationManifest {
+	m := &apptypes.FederationManifest{
+		ID:   shellFederationName,
+		Name: shellFederationName,
+		MetaData: &apptypes.FederationMetaData{
+			Name:        shellFederationName,
+			Type:        "app",
+			BuildInfo:   &apptypes.FederationBuildInfo{},
+			RemoteEntry: &apptypes.FederationRemoteEntry{Type: remoteEntryType(apptypes.ModuleFormatRemoteEntry)},
+			Types:       &apptypes.FederationTypes{},
+			GlobalName:  shellFederationName,
+			PublicPath:  "/",
+		},
+		Shared:  []*apptypes.FederationShared{},
+		Remotes: make([]*apptypes.FederationRemote, 0, len(apps)),
+		Exposes: []*apptypes.FederationExpose{},
+	}
+
+	for _, a := range sortedApps(apps) {
+		m.Remotes = append(m.Remotes, &apptypes.FederationRemote{
+			FederationContainerName: a.ID,
+			ModuleName:              "*",
+			Alias:                   a.ID,
+			Entry:                   fmt.Sprintf("/shell/apps/%s/mf-manifest.json", a.ID),
+		})
+	}
+
+	return m
+}
+
+// PublicPath returns the url the files of an app are loaded from, proxied apps are served by the gateway
+func PublicPath(a *apptypes.App) string {
+	if a.Proxy {
+		return fmt.Sprintf("/app/%s/", a.ID)
+	}
+
+	return strings.TrimSuffix(a.WebURL, "/") + "/"
+}
+
+// remoteEntryType returns the module federation remote entry type of a module format
+func remoteEntryType(format string) string {
+	switch format {
+	case apptypes.ModuleFormatESM:
+		return "module"
+	case apptypes.ModuleFormatSystemJS:
+		return "system"
+	default:
+		return "global"
+	}
+}
+
+// exposedModules returns the distinct modules an app exposes through its navigation at any depth and its slots
+func exposedModules(a *apptypes.App) []string {
+	var modules []string
+
+	add := func(exposed string) {
+		if exposed != "" && !slices.Contains(modules, exposed) {
+			modules = append(modules, exposed)
+		}
+	}
+
+	var walk func(navigation []*apptypes.Navigation)
+	walk = func(navigation []*apptypes.Navigation) {
+		for _, n := range navigation {
+			if n.Module != nil {
+				add(n.Module.ExposedModule)
+			}
+			walk(n.Children)
+		}
+	}
+
+	walk(a.Navigation)
+	for _, slot := range a.Slots {
+		if slot.Module != nil {
+			add(slot.Module.ExposedModule)
+		}
+	}
+
+	return modules
+}
+
+func emptyFederationAssets() *apptypes.FederationAssets {
+	return &apptypes.FederationAssets{
+		JS:  &apptypes.FederationAssetFiles{Sync: []string{}, Async: []string{}},
+		CSS: &apptypes.FederationAssetFiles{Sync: []string{}, Async: []string{}},
+	}
+}
+
+// sortedApps returns the apps ordered by id so that generated artifacts are stable
+func sortedApps(apps []*apptypes.App) []*apptypes.App {
+	sorted := slices.Clone(apps)
+	slices.SortFunc(sorted, func(a, b *apptypes.App) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return sorted
+}
@@ -0,0 +1,169 @@
+package apputil
+
+import (
+	"context"
+	"slices"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"golang.org/x/text/language"
+)
+
+type localeContextKey struct{}
+
+// WithLocales stores the locales preferred by the caller in the context, most preferred first
+func WithLocales(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locales)
+}
+
+// LocalesFromContext returns the locales preferred by the caller, empty if the caller has no preference
+func LocalesFromContext(ctx context.Context) []string {
+	if locales, ok := ctx.Value(localeContextKey{}).([]string); ok {
+		return locales
+	}
+
+	return nil
+}
+
+// PreferLocale places an explicitly requested locale in front of the locales already in the context
+func PreferLocale(ctx context.Context, locale *string) context.Context {
+	if locale == nil || *locale == "" {
+		return ctx
+	}
+
+	return WithLocales(ctx, append([]string{*locale}, LocalesFromContext(ctx)...))
+}
+
+// ParseAcceptLanguage returns the locales of an Accept-Language header ordered by their quality, invalid headers
+// result in no locales
+func ParseAcceptLanguage(header string) []string {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil
+	}
+
+	locales := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		locales = append(locales, tag.String())
+	}
+
+	return locales
+}
+
+// CanonicalLocale returns the canonical form of a BCP-47 tag e.g. de-ch becomes de-CH
+func CanonicalLocale(locale string) (string, error) {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", err
+	}
+
+	return tag.String(), nil
+}
+
+// DefaultLocale returns the locale of the plain texts of a registration
+func DefaultLocale(cfg *apptypes.LocalizationConfig) string {
+	if cfg != nil && cfg.DefaultLocale != "" {
+		return cfg.DefaultLocale
+	}
+
+	return apptypes.DefaultLocale
+}
+
+// LocaleChain returns the locales texts are looked up in for a list of requested locales, every requested locale is
+// followed by its parents and configured fallbacks and the chain always ends with the default locale
+func LocaleChain(requested []string, cfg *apptypes.LocalizationConfig) []string {
+	var chain []string
+
+	add := func(locale string) {
+		if !slices.Contains(chain, locale) {
+			chain = append(chain, locale)
+		}
+	}
+
+	for _, locale := range requested {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			continue
+		}
+
+		canonical := tag.String()
+		for ; tag != language.Und; tag = tag.Parent() {
+			add(tag.String())
+		}
+
+		if cfg != nil {
+			for _, fallback := range cfg.Fallbacks[canonical] {
+				if c, err := CanonicalLocale(fallback); err == nil {
+					add(c)
+				}
+			}
+		}
+	}
+
+	add(DefaultLocale(cfg))
+
+	return chain
+}
+
+// CollectLocales returns the sorted locales any app provides translations for
+func CollectLocales(apps []*apptypes.App) []string {
+	var locales []string
+
+	add := func(translations apptypes.Translations) {
+		for locale := range translations {
+			if !slices.Contains(locales, locale) {
+				locales = append(locales, locale)
+			}
+		}
+	}
+
+	var walk func(navigation []*apptypes.Navigation)
+	walk = func(navigation []*apptypes.Navigation) {
+		for _, n := range navigation {
+			add(n.Translations)
+			walk(n.Children)
+		}
+	}
+
+	for _, a := range apps {
+		walk(a.Navigation)
+		for _, slot := range a.Slots {
+			add(slot.Translations)
+		}
+	}
+
+	slices.Sort(locales)
+
+	return locales
+}
+
+// MapTranslationsToEntity maps registered translations to the entity keyed by canonical locale, translations are
+// expected to have been validated
+func MapTranslationsToEntity(in []*model.TranslationInput) apptypes.Translations {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(apptypes.Translations, len(in))
+	for _, t := range in {
+		locale, err := CanonicalLocale(t.Locale)
+		if err != nil {
+			continue
+		}
+
+		tr := &apptypes.Translation{}
+		if t.Title != nil {
+			tr.Title = *t.Title
+		}
+		if t.SubTitle != nil {
+			tr.SubTitle = *t.SubTitle
+		}
+		if t.Description != nil {
+			tr.Description = *t.Description
+		}
+
+		out[locale] = tr
+	}
+
+	return out
+}
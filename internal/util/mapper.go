@@ -3,6 +3,7 @@ package apputil
 import (
 	"cmp"
 	"fmt"
+	"path"
 	"slices"
 	"strings"
 
@@ -14,25 +15,43 @@ import (
 	"github.com/azarc-io/verathread-next-common/util"
 )
 
-type priorityWrapper struct {
+// navigationEntry is a mapped navigation entry with any operator override applied
+type navigationEntry struct {
+	app      string
 	priority int
-	app      *apptypes.App
+	order    int
+	category string
+	nav      *model.ShellNavigation
 }
 
-const (
-	AppPriority       = 0
-	SettingsPriority  = 1
-	DashboardCategory = 2
-)
+// slotEntry is a slot contribution of an app waiting to be placed in its slot
+type slotEntry struct {
+	app      *apptypes.App
+	priority int
+	position int
+	slot     *apptypes.NavigationSlot
+}
 
-// MapNavInputToNavEntity maps gql navigation data to entity data
-func MapNavInputToNavEntity(an *model.RegisterAppNavigationInput, n *apptypes.Navigation) {
+// MapNavInputToNavEntity maps gql navigation data to entity data, the remote entry of the entry and its children is
+// resolved against the app
+func MapNavInputToNavEntity(an *model.RegisterAppNavigationInput, n *apptypes.Navigation, app *apptypes.App) {
 	n.Title = an.Title
 	n.SubTitle = an.SubTitle
 	n.AuthRequired = an.AuthRequired
 	n.Hidden = an.Hidden
+	n.Icon = an.Icon
+	n.Proxy = an.Proxy
+	n.Format = app.Format
+	n.RemoteEntry = navigationRemoteEntry(app, an.Proxy, app.RemoteEntry)
 	n.Children = make([]*apptypes.Navigation, 0)
 	n.Category = an.Category
+	n.Translations = MapTranslationsToEntity(an.Translations)
+	n.Keywords = an.Keywords
+	n.Synonyms = an.Synonyms
+
+	if an.Order != nil {
+		n.Order = *an.Order
+	}
 
 	if an.Module != nil {
 		n.Module = &apptypes.NavigationModule{
@@ -45,17 +64,48 @@ func MapNavInputToNavEntity(an *model.RegisterAppNavigationInput, n *apptypes.Na
 
 	for _, child := range an.Children {
 		nc := &apptypes.Navigation{}
-		MapChildNavInputToNavEntity(nc, child)
+		MapChildNavInputToNavEntity(nc, child, n, app)
 		n.Children = append(n.Children, nc)
 	}
 }
 
-// MapChildNavInputToNavEntity maps child navigation to entity
-func MapChildNavInputToNavEntity(n *apptypes.Navigation, an *model.RegisterChildAppNavigationInput) {
+// MapChildNavInputToNavEntity maps child navigation to entity, the proxy flag and remote entry file are inherited
+// from the parent when the child does not set them
+func MapChildNavInputToNavEntity(
+	n *apptypes.Navigation, an *model.RegisterChildAppNavigationInput, parent *apptypes.Navigation, app *apptypes.App,
+) {
 	n.Title = an.Title
 	n.SubTitle = an.SubTitle
 	n.AuthRequired = an.AuthRequired
+	n.Icon = an.Icon
+	n.Category = parent.Category
+	n.Proxy = parent.Proxy
+	n.Format = parent.Format
+	n.RemoteEntry = parent.RemoteEntry
 	n.Children = make([]*apptypes.Navigation, 0)
+	n.Translations = MapTranslationsToEntity(an.Translations)
+	n.Keywords = an.Keywords
+	n.Synonyms = an.Synonyms
+
+	if an.Hidden != nil {
+		n.Hidden = *an.Hidden
+	}
+
+	if an.Proxy != nil {
+		n.Proxy = *an.Proxy
+	}
+
+	if an.Proxy != nil || an.RemoteEntryFile != nil {
+		file := app.RemoteEntry
+		if an.RemoteEntryFile != nil {
+			file = *an.RemoteEntryFile
+		}
+		n.RemoteEntry = navigationRemoteEntry(app, n.Proxy, file)
+	}
+
+	if an.Order != nil {
+		n.Order = *an.Order
+	}
 
 	if an.Module != nil {
 		n.Module = &apptypes.NavigationModule{
@@ -64,132 +114,233 @@ func MapChildNavInputToNavEntity(n *apptypes.Navigation, an *model.RegisterChild
 			ModuleName:    an.Module.ModuleName,
 			Outlet:        an.Module.Outlet,
 		}
+
+		if n.Module.Path == "" {
+			n.Module.Path = an.Path
+		}
 	}
 
 	for _, child := range an.Children {
 		nc := &apptypes.Navigation{}
-		MapChildNavInputToNavEntity(nc, child)
+		MapChildNavInputToNavEntity(nc, child, n, app)
 		n.Children = append(n.Children, nc)
 	}
 }
 
-// MapAppsToNavigation maps apps to shell configuration data for the gql api
-func MapAppsToNavigation(data []*apptypes.App) *model.ShellConfiguration {
+// navigationRemoteEntry returns the remote entry of a navigation entry, proxied entries are loaded through the
+// gateway and a remote entry file is relative to the web url of the app. Proxied module federation containers are
+// always served as remoteEntry.js while es and SystemJS modules keep their file name
+func navigationRemoteEntry(app *apptypes.App, proxy bool, file string) string {
+	if proxy {
+		if app.Format == "" || app.Format == apptypes.ModuleFormatRemoteEntry {
+			file = apptypes.ProxyRemoteEntryFile
+		}
+		return fmt.Sprintf("/app/%s/%s", app.ID, strings.TrimPrefix(file, "/"))
+	}
+
+	return fmt.Sprintf("%s/%s", app.WebURL, strings.TrimPrefix(file, "/"))
+}
+
+// MapAppsToNavigation maps apps to shell configuration data for the gql api, navigation overrides are keyed by the
+// navigation id and replace the values registered by the app, texts are localized for the locale chain
+func MapAppsToNavigation(
+	data []*apptypes.App, overrides map[string]*apptypes.NavigationOverride, cfg *apptypes.NavigationConfig,
+	locales []string,
+) *model.ShellConfiguration {
 	result := &model.ShellConfiguration{
 		DefaultRoute: util.Ptr(""),
 		Categories:   []*model.ShellNavigationCategory{},
 	}
 
 	var (
-		appCategory = &model.ShellNavigationCategory{
-			Title:    "Apps",
-			Priority: AppPriority,
-			Category: model.RegisterAppCategoryApp,
-			Entries:  make([]*model.ShellNavigation, 0),
-		}
-		settingsCategory = &model.ShellNavigationCategory{
-			Title:    "Settings",
-			Priority: SettingsPriority,
-			Category: model.RegisterAppCategorySetting,
+		categories = make(map[string]*model.ShellNavigationCategory)
+		entries    []*navigationEntry
+		slots      []*slotEntry
+	)
+
+	for _, c := range ResolveCategories(data, cfg) {
+		category := &model.ShellNavigationCategory{
+			Title:    c.Title,
+			Priority: c.Priority,
+			Category: c.Key,
+			Hidden:   c.Hidden,
 			Entries:  make([]*model.ShellNavigation, 0),
 		}
-		dashboardCategory = &model.ShellNavigationCategory{
-			Title:    "Dashboards",
-			Priority: DashboardCategory,
-			Category: model.RegisterAppCategoryDashboard,
-			Entries:  make([]*model.ShellNavigation, 0),
+
+		if c.Icon != "" {
+			category.Icon = util.Ptr(c.Icon)
 		}
 
-		slotIndex = 0
-		slots     []*model.ShellNavigationSlot
-		addSlotFn func(slot *apptypes.NavigationSlot, a *apptypes.App)
+		categories[c.Key] = category
+		result.Categories = append(result.Categories, category)
+	}
 
-		prioritizedApps = make([]*priorityWrapper, len(data))
-	)
+	for _, a := range data {
+		priority := PackagePriority(a.Package, cfg)
 
-	addSlotFn = func(slot *apptypes.NavigationSlot, a *apptypes.App) {
-		slots = append(slots, &model.ShellNavigationSlot{
-			Priority:     util.Ptr(slotIndex),
-			Slot:         fmt.Sprintf("slot-%d", slotIndex),
-			Description:  slot.Description,
-			AuthRequired: util.Ptr(slot.AuthRequired),
-			Module: &model.ShellNavigationSlotModule{
-				Path:          slot.Module.Path,
-				ExposedModule: slot.Module.ExposedModule,
-				ModuleName:    slot.Module.ModuleName,
-				RemoteEntry:   fmt.Sprintf("%s/%s", a.WebURL, a.RemoteEntry),
-			},
-		})
+		if a.Navigation == nil {
+			log.Warn().Str("app", a.ID).Msgf("navigation data is empty")
+		}
+
+		for _, navigation := range a.Navigation {
+			e := &navigationEntry{
+				app:      a.ID,
+				priority: priority,
+				order:    navigation.Order,
+				category: navigation.Category,
+				nav:      &model.ShellNavigation{},
+			}
+			util2.MapFromEntity(e.nav, navigation, a.Available, locales...)
+			applyNavigationOverride(e, overrides[navigation.ID])
+			entries = append(entries, e)
+		}
 
-		slotIndex += 1
+		for position, slot := range a.Slots {
+			slots = append(slots, &slotEntry{app: a, priority: priority, position: position, slot: slot})
+		}
 	}
 
-	// assign a priority to all native apps, so we sort and make sure that
-	// verathread apps always take the first slots
-	for i, a := range data {
-		p := 100
+	// nav items are sorted by the priority of their app and then by their own order, ties are broken by app id, title
+	// and id so that the result does not depend on the order in which the registry returns apps
+	slices.SortFunc(entries, func(a, b *navigationEntry) int {
+		return cmp.Or(
+			cmp.Compare(a.priority, b.priority),
+			cmp.Compare(a.order, b.order),
+			strings.Compare(a.app, b.app),
+			strings.Compare(a.nav.Title, b.nav.Title),
+			strings.Compare(a.nav.ID, b.nav.ID),
+		)
+	})
 
-		if strings.HasPrefix(a.Package, "vth:azarc") {
-			p = 0
+	// entries in a category that no longer exists are dropped or moved to the fallback category, with the reject
+	// policy such entries can only appear when the app that registered the category goes away
+	for _, e := range entries {
+		category, ok := categories[e.category]
+		if !ok && UnknownCategoryPolicy(cfg) != apptypes.CategoryPolicyDrop {
+			category, ok = categories[FallbackCategory(cfg)]
 		}
 
-		prioritizedApps[i] = &priorityWrapper{
-			priority: p,
-			app:      a,
+		if !ok {
+			log.Warn().Str("app", e.app).Str("category", e.category).Msgf("dropping navigation entry with unknown category")
+			continue
 		}
+
+		category.Entries = append(category.Entries, e.nav)
 	}
 
-	// sort apps by priority
-	slices.SortFunc(prioritizedApps, func(a, b *priorityWrapper) int {
-		return cmp.Compare(a.priority, b.priority)
+	// slot contributions are grouped by slot and then follow the same rules, the position of the contribution within
+	// the app breaks the remaining ties
+	slices.SortFunc(slots, func(a, b *slotEntry) int {
+		return cmp.Or(
+			strings.Compare(a.slot.Slot, b.slot.Slot),
+			cmp.Compare(a.priority, b.priority),
+			cmp.Compare(a.slot.Order, b.slot.Order),
+			strings.Compare(a.app.ID, b.app.ID),
+			cmp.Compare(a.position, b.position),
+		)
 	})
 
-	for _, pa := range prioritizedApps {
-		a := pa.app
-		if a.Navigation != nil {
-			// sort nav items by title
-			slices.SortFunc(a.Navigation, func(a, b *apptypes.Navigation) int {
-				return strings.Compare(a.Title, b.Title)
-			})
-
-			for _, navigation := range a.Navigation {
-				e := &model.ShellNavigation{}
-				util2.MapFromEntity(e, navigation, a.Available)
-
-				switch navigation.Category {
-				case model.RegisterAppCategoryDashboard:
-					dashboardCategory.Entries = append(dashboardCategory.Entries, e)
-				case model.RegisterAppCategoryApp:
-					appCategory.Entries = append(appCategory.Entries, e)
-				case model.RegisterAppCategorySetting:
-					settingsCategory.Entries = append(settingsCategory.Entries, e)
-				}
-			}
-		} else {
-			log.Warn().Msgf("navigation data is empty")
+	limits := make(map[string]int)
+	if cfg != nil {
+		for _, d := range cfg.Slots {
+			limits[d.Name] = d.Max
 		}
+	}
 
-		// register azarc slots first
-		if a.Slot1 != nil {
-			addSlotFn(a.Slot1, a)
-		}
-		if a.Slot2 != nil {
-			addSlotFn(a.Slot2, a)
+	filled := make(map[string]int)
+	for _, se := range slots {
+		name := se.slot.Slot
+		if limit := limits[name]; limit > 0 && filled[name] >= limit {
+			log.Warn().Str("app", se.app.ID).Str("slot", name).Msgf("dropping slot contribution, slot is full")
+			continue
 		}
-		if a.Slot3 != nil {
-			addSlotFn(a.Slot3, a)
+
+		result.Slots = append(result.Slots, &model.ShellNavigationSlot{
+			Priority:     util.Ptr(filled[name]),
+			Slot:         name,
+			App:          util.Ptr(se.app.ID),
+			Description:  cmp.Or(se.slot.Translations.Resolve(locales).Description, se.slot.Description),
+			AuthRequired: util.Ptr(se.slot.AuthRequired),
+			Module: &model.ShellNavigationSlotModule{
+				Path:          se.slot.Module.Path,
+				ExposedModule: se.slot.Module.ExposedModule,
+				ModuleName:    se.slot.Module.ModuleName,
+				RemoteEntry:   RemoteEntryURL(se.app),
+				Format:        util2.MapModuleFormat(se.app.Format),
+			},
+		})
+		filled[name]++
+	}
+
+	return result
+}
+
+// RemoteEntryURL returns the url the shell loads the remote entry of an app from, proxied apps are served by the
+// gateway under /app/:appId
+func RemoteEntryURL(a *apptypes.App) string {
+	return navigationRemoteEntry(a, a.Proxy, a.RemoteEntry)
+}
+
+// PackagePriority returns the priority of the first rule matching the package, the built-in rules place azarc apps
+// first when no navigation config is given
+func PackagePriority(pkg string, cfg *apptypes.NavigationConfig) int {
+	priority, rules := apptypes.DefaultAppPriority, apptypes.DefaultPriorityRules
+	if cfg != nil {
+		priority, rules = cfg.DefaultPriority, cfg.PriorityRules
+	}
+
+	for _, rule := range rules {
+		if ok, err := path.Match(rule.Pattern, pkg); err == nil && ok {
+			return rule.Priority
 		}
 	}
 
-	result.Categories = append(result.Categories, dashboardCategory, appCategory, settingsCategory)
-	result.Slots = slots
+	return priority
+}
 
-	return result
+// applyNavigationOverride replaces the fields of a navigation entry that are set on the override
+func applyNavigationOverride(e *navigationEntry, o *apptypes.NavigationOverride) {
+	if o == nil {
+		return
+	}
+
+	if o.Title != nil {
+		e.nav.Title = *o.Title
+	}
+	if o.SubTitle != nil {
+		e.nav.SubTitle = *o.SubTitle
+	}
+	if o.Icon != nil {
+		e.nav.Icon = *o.Icon
+	}
+	if o.Hidden != nil {
+		e.nav.Hidden = *o.Hidden
+	}
+	if o.Category != nil {
+		e.category = *o.Category
+	}
+	if o.Order != nil {
+		e.order = *o.Order
+	}
+}
+
+// MapNavigationOverrideToModel maps a navigation override entity to the gql model
+func MapNavigationOverrideToModel(o *apptypes.NavigationOverride) *model.NavigationOverride {
+	return &model.NavigationOverride{
+		ID:        o.ID,
+		Title:     o.Title,
+		SubTitle:  o.SubTitle,
+		Icon:      o.Icon,
+		Category:  o.Category,
+		Hidden:    o.Hidden,
+		Order:     o.Order,
+		UpdatedAt: o.UpdatedAt,
+	}
 }
 
 // MapRegisterSlotToEntity maps slot model to slot entity
 func MapRegisterSlotToEntity(req *model.RegisterAppSlot) *apptypes.NavigationSlot {
-	return &apptypes.NavigationSlot{
+	slot := &apptypes.NavigationSlot{
 		Description:  req.Description,
 		AuthRequired: req.AuthRequired,
 		Module: &apptypes.NavigationSlotModule{
@@ -198,4 +349,268 @@ func MapRegisterSlotToEntity(req *model.RegisterAppSlot) *apptypes.NavigationSlo
 			ModuleName:    req.Module.ModuleName,
 		},
 	}
+
+	if req.Slot != nil {
+		slot.Slot = *req.Slot
+	}
+
+	slot.Translations = MapTranslationsToEntity(req.Translations)
+
+	if req.Order != nil {
+		slot.Order = *req.Order
+	}
+
+	return slot
+}
+
+// MapModuleFormatToEntity maps the module format of a registration to the entity, module federation containers are
+// the default
+func MapModuleFormatToEntity(format *model.ModuleFormat) string {
+	if format == nil {
+		return apptypes.ModuleFormatRemoteEntry
+	}
+
+	switch *format {
+	case model.ModuleFormatEsm:
+		return apptypes.ModuleFormatESM
+	case model.ModuleFormatSystemJs:
+		return apptypes.ModuleFormatSystemJS
+	default:
+		return apptypes.ModuleFormatRemoteEntry
+	}
+}
+
+// MapSharedDependenciesToEntity maps the shared dependencies of a registration to the entity, the required version
+// defaults to the version
+func MapSharedDependenciesToEntity(in []*model.RegisterSharedDependencyInput) []*apptypes.SharedDependency {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*apptypes.SharedDependency, 0, len(in))
+	for _, d := range in {
+		dep := &apptypes.SharedDependency{
+			Name:            d.Name,
+			Version:         d.Version,
+			RequiredVersion: d.Version,
+		}
+
+		if d.RequiredVersion != nil && *d.RequiredVersion != "" {
+			dep.RequiredVersion = *d.RequiredVersion
+		}
+		if d.Singleton != nil {
+			dep.Singleton = *d.Singleton
+		}
+		if d.Eager != nil {
+			dep.Eager = *d.Eager
+		}
+		if d.URL != nil {
+			dep.URL = *d.URL
+		}
+
+		out = append(out, dep)
+	}
+
+	return out
+}
+
+// MapRevisionToModel maps a stored registration revision to the history entry returned by the api
+func MapRevisionToModel(rev *apptypes.AppRevision) *model.AppRegistrationRevision {
+	out := &model.AppRegistrationRevision{
+		Revision:     rev.Revision,
+		Version:      rev.App.Version,
+		Package:      rev.App.Package,
+		RegisteredAt: rev.RegisteredAt,
+	}
+
+	if rev.RollbackOf > 0 {
+		out.RollbackOf = &rev.RollbackOf
+	}
+
+	for _, navigation := range rev.App.Navigation {
+		e := &model.ShellNavigation{}
+		util2.MapFromEntity(e, navigation, rev.App.Available)
+		out.Navigation = append(out.Navigation, e)
+	}
+
+	return out
+}
+
+// MapAppToRegisterInput maps a stored app back to the registration it was created from so that it can be validated
+// again, e.g. when a revision is rolled back
+func MapAppToRegisterInput(app *apptypes.App) *model.RegisterAppInput {
+	format := util2.MapModuleFormat(app.Format)
+	req := &model.RegisterAppInput{
+		Name:            app.Name,
+		ID:              app.ID,
+		Package:         app.Package,
+		Version:         app.Version,
+		RemoteEntryFile: app.RemoteEntry,
+		Proxy:           app.Proxy,
+		WebURL:          app.WebURL,
+		APIURL:          app.APIURL,
+		Format:          &format,
+		Navigation:      make([]*model.RegisterAppNavigationInput, 0, len(app.Navigation)),
+		Slots:           make([]*model.RegisterAppSlot, 0, len(app.Slots)),
+	}
+
+	for _, n := range app.Navigation {
+		in := &model.RegisterAppNavigationInput{
+			Title:        n.Title,
+			SubTitle:     n.SubTitle,
+			AuthRequired: n.AuthRequired,
+			Hidden:       n.Hidden,
+			Category:     n.Category,
+			Proxy:        n.Proxy,
+			Icon:         n.Icon,
+			Order:        util.Ptr(n.Order),
+			Translations: mapTranslationsToInput(n.Translations),
+			Keywords:     n.Keywords,
+			Synonyms:     n.Synonyms,
+		}
+
+		if n.Module != nil {
+			in.Module = &model.RegisterAppModule{
+				Path:          n.Module.Path,
+				ExposedModule: n.Module.ExposedModule,
+				ModuleName:    n.Module.ModuleName,
+				Outlet:        n.Module.Outlet,
+			}
+		}
+
+		for _, child := range n.Children {
+			in.Children = append(in.Children, mapChildNavToInput(child, n, app))
+		}
+
+		req.Navigation = append(req.Navigation, in)
+	}
+
+	for _, c := range app.Categories {
+		req.Categories = append(req.Categories, &model.RegisterNavigationCategoryInput{
+			Key:      c.Key,
+			Title:    c.Title,
+			Icon:     util.Ptr(c.Icon),
+			Priority: util.Ptr(c.Priority),
+			Hidden:   util.Ptr(c.Hidden),
+		})
+	}
+
+	for _, slot := range app.Slots {
+		in := &model.RegisterAppSlot{
+			Slot:         util.Ptr(slot.Slot),
+			Description:  slot.Description,
+			AuthRequired: slot.AuthRequired,
+			Order:        util.Ptr(slot.Order),
+			Translations: mapTranslationsToInput(slot.Translations),
+		}
+
+		if slot.Module != nil {
+			in.Module = &model.RegisterAppSlotModule{
+				Path:          slot.Module.Path,
+				ExposedModule: slot.Module.ExposedModule,
+				ModuleName:    slot.Module.ModuleName,
+			}
+		}
+
+		req.Slots = append(req.Slots, in)
+	}
+
+	for _, d := range app.Shared {
+		req.Shared = append(req.Shared, &model.RegisterSharedDependencyInput{
+			Name:            d.Name,
+			Version:         d.Version,
+			RequiredVersion: util.Ptr(d.RequiredVersion),
+			Singleton:       util.Ptr(d.Singleton),
+			Eager:           util.Ptr(d.Eager),
+			URL:             util.Ptr(d.URL),
+		})
+	}
+
+	return req
+}
+
+// mapChildNavToInput maps a child navigation entry back to its registration, the proxy flag and remote entry file are
+// only set when the child does not inherit them from its parent
+func mapChildNavToInput(n, parent *apptypes.Navigation, app *apptypes.App) *model.RegisterChildAppNavigationInput {
+	in := &model.RegisterChildAppNavigationInput{
+		Title:        n.Title,
+		SubTitle:     n.SubTitle,
+		AuthRequired: n.AuthRequired,
+		Icon:         n.Icon,
+		Hidden:       util.Ptr(n.Hidden),
+		Order:        util.Ptr(n.Order),
+		Translations: mapTranslationsToInput(n.Translations),
+		Keywords:     n.Keywords,
+		Synonyms:     n.Synonyms,
+	}
+
+	if n.Proxy != parent.Proxy || n.RemoteEntry != parent.RemoteEntry {
+		prefix := app.WebURL + "/"
+		if n.Proxy {
+			prefix = fmt.Sprintf("/app/%s/", app.ID)
+		}
+		in.Proxy = util.Ptr(n.Proxy)
+		in.RemoteEntryFile = util.Ptr(strings.TrimPrefix(n.RemoteEntry, prefix))
+	}
+
+	if n.Module != nil {
+		in.Path = n.Module.Path
+		in.Module = &model.RegisterAppModule{
+			Path:          n.Module.Path,
+			ExposedModule: n.Module.ExposedModule,
+			ModuleName:    n.Module.ModuleName,
+			Outlet:        n.Module.Outlet,
+		}
+	}
+
+	for _, child := range n.Children {
+		in.Children = append(in.Children, mapChildNavToInput(child, n, app))
+	}
+
+	return in
+}
+
+// mapTranslationsToInput maps stored translations back to registration input ordered by locale
+func mapTranslationsToInput(in apptypes.Translations) []*model.TranslationInput {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*model.TranslationInput, 0, len(in))
+	for locale, t := range in {
+		out = append(out, &model.TranslationInput{
+			Locale:      locale,
+			Title:       util.Ptr(t.Title),
+			SubTitle:    util.Ptr(t.SubTitle),
+			Description: util.Ptr(t.Description),
+		})
+	}
+
+	slices.SortFunc(out, func(a, b *model.TranslationInput) int {
+		return cmp.Compare(a.Locale, b.Locale)
+	})
+
+	return out
+}
+
+// MapUserPreferencesToModel maps stored user preferences to the gql model
+func MapUserPreferencesToModel(p *apptypes.UserPreferences) *model.UserPreferences {
+	out := &model.UserPreferences{
+		Pinned:  make([]string, 0, len(p.Pinned)),
+		Hidden:  make([]string, 0, len(p.Hidden)),
+		Recents: make([]*model.RecentApp, 0, len(p.Recents)),
+	}
+
+	if p.DefaultRoute != "" {
+		out.DefaultRoute = &p.DefaultRoute
+	}
+
+	out.Pinned = append(out.Pinned, p.Pinned...)
+	out.Hidden = append(out.Hidden, p.Hidden...)
+
+	for _, r := range p.Recents {
+		out.Recents = append(out.Recents, &model.RecentApp{App: r.App, VisitedAt: r.VisitedAt})
+	}
+
+	return out
 }
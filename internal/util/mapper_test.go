@@ -0,0 +1,127 @@
+package apputil
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// registerApps maps registration inputs to apps the way the service registers them, navigation ids are the package
+// and module path so that the golden files stay readable
+func registerApps(t *testing.T, file string) []*apptypes.App {
+	t.Helper()
+
+	b, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	var inputs []*model.RegisterAppInput
+	require.NoError(t, json.Unmarshal(b, &inputs))
+
+	apps := make([]*apptypes.App, 0, len(inputs))
+	for _, req := range inputs {
+		app := &apptypes.App{
+			ID:          req.ID,
+			Name:        req.Name,
+			Package:     req.Package,
+			WebURL:      req.WebURL,
+			RemoteEntry: req.RemoteEntryFile,
+			Proxy:       req.Proxy,
+			Format:      MapModuleFormatToEntity(req.Format),
+			Categories:  MapRegisterCategoriesToEntity(req.Categories),
+			Available:   true,
+		}
+
+		for _, navigation := range req.Navigation {
+			n := &apptypes.Navigation{ID: req.Package + ":" + navigation.Module.Path}
+			MapNavInputToNavEntity(navigation, n, app)
+			app.Navigation = append(app.Navigation, n)
+		}
+
+		apps = append(apps, app)
+	}
+
+	return apps
+}
+
+func TestMapAppsToNavigation_Golden(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *apptypes.NavigationConfig
+	}{
+		// children at any depth inherit the category, proxy flag and remote entry of their parent unless they set
+		// their own, child modules without a path use the path of the child
+		{name: "deep_tree"},
+		// entries in unknown categories move to the fallback category
+		{name: "category_fallback", cfg: &apptypes.NavigationConfig{
+			DefaultPriority:  apptypes.DefaultAppPriority,
+			FallbackCategory: apptypes.CategorySetting,
+		}},
+		// with the drop policy entries in unknown categories are left out
+		{name: "category_drop", cfg: &apptypes.NavigationConfig{
+			DefaultPriority: apptypes.DefaultAppPriority,
+			UnknownCategory: apptypes.CategoryPolicyDrop,
+		}},
+		// entries are ordered by the priority of their app, their own order, the app id, title and id
+		{name: "ordering", cfg: &apptypes.NavigationConfig{
+			DefaultPriority: apptypes.DefaultAppPriority,
+			PriorityRules:   []*apptypes.PriorityRule{{Pattern: "vth:core:*", Priority: 10}},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apps := registerApps(t, filepath.Join("testdata", tt.name+".json"))
+
+			got, err := json.MarshalIndent(MapAppsToNavigation(apps, nil, tt.cfg, nil), "", "  ")
+			require.NoError(t, err)
+			got = append(got, '\n')
+
+			golden := filepath.Join("testdata", tt.name+".golden")
+			if *update {
+				require.NoError(t, os.WriteFile(golden, got, 0o600))
+			}
+
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			require.Equal(t, string(want), string(got))
+		})
+	}
+}
+
+func TestMapAppToRegisterInput_RoundTrip(t *testing.T) {
+	for _, name := range []string{"deep_tree", "category_fallback", "ordering"} {
+		t.Run(name, func(t *testing.T) {
+			for _, app := range registerApps(t, filepath.Join("testdata", name+".json")) {
+				req := MapAppToRegisterInput(app)
+
+				got := &apptypes.App{
+					ID:          req.ID,
+					Name:        req.Name,
+					Package:     req.Package,
+					WebURL:      req.WebURL,
+					RemoteEntry: req.RemoteEntryFile,
+					Proxy:       req.Proxy,
+					Format:      MapModuleFormatToEntity(req.Format),
+					Categories:  MapRegisterCategoriesToEntity(req.Categories),
+					Available:   true,
+				}
+
+				for _, navigation := range req.Navigation {
+					n := &apptypes.Navigation{ID: req.Package + ":" + navigation.Module.Path}
+					MapNavInputToNavEntity(navigation, n, got)
+					got.Navigation = append(got.Navigation, n)
+				}
+
+				require.Equal(t, app, got, "a stored app maps back to the registration it was created from")
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package apputil
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+)
+
+type overridesContextKey struct{}
+
+// ParseOverrides parses a developer override value in the form `app1=http://localhost:4201,app2=http://localhost:4202`,
+// every url must point at one of the allowed hosts otherwise the whole value is rejected
+func ParseOverrides(value string, allowedHosts []string) (map[string]*url.URL, error) {
+	result := make(map[string]*url.URL)
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		app, raw, ok := strings.Cut(entry, "=")
+		if !ok || app == "" || raw == "" {
+			return nil, fmt.Errorf("%w: %s", apptypes.ErrOverrideMalformed, entry)
+		}
+
+		u, err := url.Parse(raw)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("%w: %s", apptypes.ErrOverrideMalformed, entry)
+		}
+
+		host := u.Host
+		if h, _, err := net.SplitHostPort(u.Host); err == nil {
+			host = h
+		}
+
+		if !slices.Contains(allowedHosts, host) {
+			return nil, fmt.Errorf("%w: %s", apptypes.ErrOverrideHostNotAllowed, host)
+		}
+
+		result[app] = u
+	}
+
+	return result, nil
+}
+
+// RewriteBaseURL replaces the base url of a remote entry, entries that do not start with the base url are returned as is
+func RewriteBaseURL(remoteEntry, baseURL, target string) string {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	if baseURL == "" || !strings.HasPrefix(remoteEntry, baseURL+"/") {
+		return remoteEntry
+	}
+
+	return target + strings.TrimPrefix(remoteEntry, baseURL)
+}
+
+// SignOverrides generates the signature of an override value for a user, the signature is formatted as
+// <unix expiry>.<hmac> where the hmac covers the user, the expiry and the value
+func SignOverrides(secret, user, value string, expiresAt time.Time) string {
+	expiry := strconv.FormatInt(expiresAt.Unix(), 10)
+	return expiry + "." + overrideMAC(secret, user, expiry, value)
+}
+
+// VerifyOverrides checks the signature of an override value for a user, values are rejected when no secret is
+// configured, when they were signed for another user or when the signature has expired
+func VerifyOverrides(secret, user, value, signature string, now time.Time) error {
+	if secret == "" {
+		return apptypes.ErrOverrideSecretMissing
+	}
+
+	expiry, mac, ok := strings.Cut(signature, ".")
+	if !ok || !hmac.Equal([]byte(overrideMAC(secret, user, expiry, value)), []byte(mac)) {
+		return apptypes.ErrOverrideSignature
+	}
+
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return apptypes.ErrOverrideSignature
+	}
+
+	if now.Unix() >= expiresAt {
+		return apptypes.ErrOverrideExpired
+	}
+
+	return nil
+}
+
+func overrideMAC(secret, user, expiry, value string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(user + "\n" + expiry + "\n" + value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// WithOverrides stores the developer overrides for the current request in the context
+func WithOverrides(ctx context.Context, overrides map[string]*url.URL) context.Context {
+	return context.WithValue(ctx, overridesContextKey{}, overrides)
+}
+
+// OverridesFromContext returns the developer overrides for the current request, nil if there are none
+func OverridesFromContext(ctx context.Context) map[string]*url.URL {
+	if overrides, ok := ctx.Value(overridesContextKey{}).(map[string]*url.URL); ok {
+		return overrides
+	}
+
+	return nil
+}
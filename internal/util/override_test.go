@@ -0,0 +1,48 @@
+package apputil
+
+import (
+	"testing"
+	"time"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyOverrides(t *testing.T) {
+	const (
+		secret = "secret"
+		value  = "app=http://localhost:4201"
+	)
+
+	now := time.Unix(1700000000, 0)
+	signature := SignOverrides(secret, "alice", value, now.Add(time.Hour))
+
+	tests := []struct {
+		name      string
+		secret    string
+		user      string
+		value     string
+		signature string
+		now       time.Time
+		err       error
+	}{
+		{name: "valid", secret: secret, user: "alice", value: value, signature: signature, now: now},
+		{name: "no secret", user: "alice", value: value, signature: signature, now: now, err: apptypes.ErrOverrideSecretMissing},
+		{name: "other user", secret: secret, user: "bob", value: value, signature: signature, now: now, err: apptypes.ErrOverrideSignature},
+		{name: "other value", secret: secret, user: "alice", value: "app=http://localhost:1", signature: signature, now: now, err: apptypes.ErrOverrideSignature},
+		{name: "other secret", secret: "other", user: "alice", value: value, signature: signature, now: now, err: apptypes.ErrOverrideSignature},
+		{name: "malformed", secret: secret, user: "alice", value: value, signature: "abc", now: now, err: apptypes.ErrOverrideSignature},
+		{name: "expired", secret: secret, user: "alice", value: value, signature: signature, now: now.Add(time.Hour), err: apptypes.ErrOverrideExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyOverrides(tt.secret, tt.user, tt.value, tt.signature, tt.now)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
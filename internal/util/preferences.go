@@ -0,0 +1,78 @@
+package apputil
+
+import (
+	"slices"
+	"time"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+)
+
+// ApplyUserPreferences removes the entries a user has hidden from the configuration and, when favourites is set, adds
+// a favourites category in front of all other categories holding the pinned entries in the order they were pinned
+func ApplyUserPreferences(configuration *model.ShellConfiguration, prefs *apptypes.UserPreferences, favourites bool) {
+	entries := make(map[string]*model.ShellNavigation)
+	for _, category := range configuration.Categories {
+		category.Entries = slices.DeleteFunc(category.Entries, func(e *model.ShellNavigation) bool {
+			return e != nil && slices.Contains(prefs.Hidden, e.ID)
+		})
+
+		for _, e := range category.Entries {
+			if e != nil && !category.Hidden {
+				entries[e.ID] = e
+			}
+		}
+	}
+
+	if !favourites {
+		return
+	}
+
+	category := &model.ShellNavigationCategory{
+		Title:    apptypes.CategoryFavourites,
+		Category: apptypes.CategoryFavourites,
+		Entries:  make([]*model.ShellNavigation, 0, len(prefs.Pinned)),
+	}
+
+	for _, id := range prefs.Pinned {
+		if e, ok := entries[id]; ok {
+			category.Entries = append(category.Entries, e)
+		}
+	}
+
+	if len(category.Entries) > 0 {
+		configuration.Categories = append([]*model.ShellNavigationCategory{category}, configuration.Categories...)
+	}
+}
+
+// NavigationEntryExists reports whether a top level navigation entry with the given id is part of the configuration
+func NavigationEntryExists(configuration *model.ShellConfiguration, id string) bool {
+	for _, category := range configuration.Categories {
+		for _, e := range category.Entries {
+			if e != nil && e.ID == id {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// AddRecentApp moves an app to the front of the recently used apps of a user and keeps at most limit apps, returns
+// false without changing the preferences when the app was already the most recent one within the visit interval
+func AddRecentApp(prefs *apptypes.UserPreferences, app string, now time.Time, limit int) bool {
+	if len(prefs.Recents) > 0 && prefs.Recents[0].App == app && now.Sub(prefs.Recents[0].VisitedAt) < apptypes.RecentVisitInterval {
+		return false
+	}
+
+	prefs.Recents = slices.DeleteFunc(prefs.Recents, func(r *apptypes.RecentApp) bool {
+		return r.App == app
+	})
+	prefs.Recents = append([]*apptypes.RecentApp{{App: app, VisitedAt: now}}, prefs.Recents...)
+
+	if len(prefs.Recents) > limit {
+		prefs.Recents = prefs.Recents[:limit]
+	}
+
+	return true
+}
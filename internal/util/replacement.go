@@ -33,7 +33,12 @@ func ShouldReplace(path string, fileList []string) bool {
 // ReplaceTokens replaces tokens in static files and caches the file based on the content hash in order to avoid
 // scanning the same files over and over. Note that the cache will be lost on restart
 func ReplaceTokens(c echo.Context, content []byte) []byte {
-	appName := c.Get(apptypes.AppNameKey).(string)
+	return ReplaceTokensForApp(c.Get(apptypes.AppNameKey).(string), content)
+}
+
+// ReplaceTokensForApp replaces tokens in static files of the named app, used when there is no request context such as
+// when cached assets are revalidated in the background
+func ReplaceTokensForApp(appName string, content []byte) []byte {
 	matches := hmrRegex.FindSubmatch(content)
 	if len(matches) > 1 {
 		sum := md5.Sum(content) //nolint:gosec
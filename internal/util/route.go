@@ -0,0 +1,122 @@
+package apputil
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+)
+
+type (
+	userContextKey  struct{}
+	rolesContextKey struct{}
+)
+
+// WithUser stores the id of the user making the request in the context
+func WithUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+// UserFromContext returns the id of the user making the request, empty if the user is not known
+func UserFromContext(ctx context.Context) string {
+	if user, ok := ctx.Value(userContextKey{}).(string); ok {
+		return user
+	}
+
+	return ""
+}
+
+// WithRoles stores the roles of the user making the request in the context
+func WithRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, rolesContextKey{}, roles)
+}
+
+// HasRole reports whether the user making the request has the given role
+func HasRole(ctx context.Context, role string) bool {
+	roles, _ := ctx.Value(rolesContextKey{}).([]string)
+	return slices.Contains(roles, role)
+}
+
+// ResolveDefaultRoute returns the first candidate route that leads to an entry the user can open, when none of the
+// candidates can be used the first visible dashboard entry is used followed by the first visible entry of any category
+func ResolveDefaultRoute(configuration *model.ShellConfiguration, candidates []string, authenticated bool) string {
+	for _, route := range candidates {
+		if RouteExists(configuration, route, func(authRequired bool, state model.ShellNavigationState) bool {
+			return canOpen(authRequired, state, authenticated)
+		}) {
+			return route
+		}
+	}
+
+	var fallback string
+	for _, category := range configuration.Categories {
+		if category.Hidden {
+			continue
+		}
+
+		for _, e := range category.Entries {
+			if e.Hidden || e.Module == nil || !canOpen(e.AuthRequired, e.State, authenticated) {
+				continue
+			}
+
+			if category.Category == apptypes.CategoryDashboard {
+				return e.Module.Path
+			}
+
+			if fallback == "" {
+				fallback = e.Module.Path
+			}
+		}
+	}
+
+	return fallback
+}
+
+// RouteExists reports whether a route leads to a navigation entry or one of its children that matches the filter,
+// a nil filter matches every entry
+func RouteExists(configuration *model.ShellConfiguration, route string, filter func(authRequired bool, state model.ShellNavigationState) bool) bool {
+	if filter == nil {
+		filter = func(bool, model.ShellNavigationState) bool { return true }
+	}
+
+	for _, category := range configuration.Categories {
+		for _, e := range category.Entries {
+			if e.Module != nil && routeMatches(e.Module.Path, route) {
+				return filter(e.AuthRequired, e.State)
+			}
+
+			if childRouteExists(e.Children, route, filter) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func childRouteExists(children []*model.ShellNavigationChild, route string, filter func(bool, model.ShellNavigationState) bool) bool {
+	for _, c := range children {
+		if c.Module != nil && routeMatches(c.Module.Path, route) {
+			return filter(c.AuthRequired, c.State)
+		}
+
+		if childRouteExists(c.Children, route, filter) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// routeMatches compares routes ignoring leading and trailing slashes, a route below an entry path also matches it
+func routeMatches(path, route string) bool {
+	path, route = strings.Trim(path, "/"), strings.Trim(route, "/")
+	return path == route || strings.HasPrefix(route, path+"/")
+}
+
+// canOpen reports whether an entry is healthy and either public or the user is authenticated
+func canOpen(authRequired bool, state model.ShellNavigationState, authenticated bool) bool {
+	return state == model.ShellNavigationStateHealthy && (!authRequired || authenticated)
+}
@@ -0,0 +1,372 @@
+package apputil
+
+import (
+	"cmp"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+)
+
+// weights of the texts a navigation entry can be found by, a match in the title counts most
+const (
+	titleWeight      = 3.0
+	synonymWeight    = 2.5
+	keywordWeight    = 2.0
+	subTitleWeight   = 1.5
+	breadcrumbWeight = 1.0
+	titlePrefixBonus = 2.0
+)
+
+type (
+	// SearchDocument is a navigation entry or child in the search index
+	SearchDocument struct {
+		ID           string
+		App          string
+		Package      string
+		Title        string
+		SubTitle     string
+		Icon         string
+		Path         string
+		Category     string
+		Breadcrumbs  []string
+		AuthRequired bool
+		Available    bool
+		Translations apptypes.Translations
+
+		terms []searchTerm
+	}
+
+	// SearchHit is a document matching a search
+	SearchHit struct {
+		Document *SearchDocument
+		Score    float64
+	}
+
+	// SearchIndex is an in memory index of the navigation of all apps, it is rebuilt whenever the shell configuration
+	// changes and is safe for concurrent use
+	SearchIndex struct {
+		mu        sync.RWMutex
+		documents []*SearchDocument
+	}
+
+	searchTerm struct {
+		tokens []string
+		weight float64
+	}
+)
+
+// NewSearchIndex creates an empty search index
+func NewSearchIndex() *SearchIndex {
+	return &SearchIndex{}
+}
+
+// Len returns the number of documents in the index
+func (i *SearchIndex) Len() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return len(i.documents)
+}
+
+// Rebuild replaces the documents of the index with the navigation of the given apps, overrides and categories are
+// applied the same way as when the shell configuration is built and hidden entries are left out
+func (i *SearchIndex) Rebuild(
+	apps []*apptypes.App, overrides map[string]*apptypes.NavigationOverride, cfg *apptypes.NavigationConfig,
+) {
+	categories := make(map[string]*apptypes.NavigationCategory)
+	for _, c := range ResolveCategories(apps, cfg) {
+		categories[c.Key] = c
+	}
+
+	var documents []*SearchDocument
+	for _, a := range apps {
+		for _, n := range a.Navigation {
+			doc := &SearchDocument{
+				ID:           n.ID,
+				App:          a.ID,
+				Package:      a.Package,
+				Title:        n.Title,
+				SubTitle:     n.SubTitle,
+				Icon:         n.Icon,
+				Category:     n.Category,
+				AuthRequired: n.AuthRequired,
+				Available:    a.Available,
+				Translations: n.Translations,
+			}
+
+			hidden := applySearchOverride(doc, n.Hidden, overrides[n.ID])
+
+			category, ok := categories[doc.Category]
+			if !ok && UnknownCategoryPolicy(cfg) != apptypes.CategoryPolicyDrop {
+				category, ok = categories[FallbackCategory(cfg)]
+			}
+
+			if hidden || !ok || category.Hidden {
+				continue
+			}
+
+			doc.Category = category.Key
+			doc.Breadcrumbs = []string{category.Title}
+			if n.Module != nil {
+				doc.Path = n.Module.Path
+			}
+			doc.terms = searchTerms(doc, n)
+
+			documents = append(documents, doc)
+			documents = appendChildDocuments(documents, doc, n.Children)
+		}
+	}
+
+	i.mu.Lock()
+	i.documents = documents
+	i.mu.Unlock()
+}
+
+// Search returns the documents matching every word of the query ordered by their score, the filter decides which
+// documents the caller may see and a limit of 0 returns all matches
+func (i *SearchIndex) Search(query string, limit int, filter func(*SearchDocument) bool) []*SearchHit {
+	words := tokenize(query)
+	if len(words) == 0 {
+		return nil
+	}
+
+	i.mu.RLock()
+	documents := i.documents
+	i.mu.RUnlock()
+
+	var (
+		hits   []*SearchHit
+		phrase = strings.ToLower(strings.TrimSpace(query))
+	)
+
+	for _, doc := range documents {
+		if filter != nil && !filter(doc) {
+			continue
+		}
+
+		score, ok := scoreDocument(doc, words)
+		if !ok {
+			continue
+		}
+
+		if strings.HasPrefix(strings.ToLower(doc.Title), phrase) {
+			score += titlePrefixBonus
+		}
+
+		hits = append(hits, &SearchHit{Document: doc, Score: score})
+	}
+
+	slices.SortFunc(hits, func(a, b *SearchHit) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			strings.Compare(a.Document.Title, b.Document.Title),
+			strings.Compare(a.Document.Path, b.Document.Path),
+		)
+	})
+
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	return hits
+}
+
+// SearchableByTenant returns whether the users of a tenant may find the navigation of a package, tenants without
+// package patterns find every app
+func SearchableByTenant(tenant, pkg string, cfg *apptypes.NavigationConfig) bool {
+	if cfg == nil {
+		return true
+	}
+
+	patterns, ok := cfg.TenantPackages[tenant]
+	if !ok {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, pkg); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appendChildDocuments adds the children of a document at any depth, hidden children and their descendants are left out
+func appendChildDocuments(documents []*SearchDocument, parent *SearchDocument, children []*apptypes.Navigation) []*SearchDocument {
+	for _, c := range children {
+		if c.Hidden {
+			continue
+		}
+
+		doc := &SearchDocument{
+			ID:           parent.ID,
+			App:          parent.App,
+			Package:      parent.Package,
+			Title:        c.Title,
+			SubTitle:     c.SubTitle,
+			Icon:         c.Icon,
+			Category:     parent.Category,
+			Breadcrumbs:  append(slices.Clone(parent.Breadcrumbs), parent.Title),
+			AuthRequired: c.AuthRequired || parent.AuthRequired,
+			Available:    parent.Available,
+			Translations: c.Translations,
+		}
+
+		if c.Module != nil {
+			doc.Path = c.Module.Path
+		}
+		doc.terms = searchTerms(doc, c)
+
+		documents = append(documents, doc)
+		documents = appendChildDocuments(documents, doc, c.Children)
+	}
+
+	return documents
+}
+
+// searchTerms returns the weighted texts a document can be found by, titles of every locale are included so that an
+// entry can be found in any language
+func searchTerms(doc *SearchDocument, n *apptypes.Navigation) []searchTerm {
+	terms := []searchTerm{
+		{tokens: tokenize(doc.Title), weight: titleWeight},
+		{tokens: tokenize(doc.SubTitle), weight: subTitleWeight},
+	}
+
+	for _, tr := range n.Translations {
+		terms = append(terms,
+			searchTerm{tokens: tokenize(tr.Title), weight: titleWeight},
+			searchTerm{tokens: tokenize(tr.SubTitle), weight: subTitleWeight},
+		)
+	}
+
+	for _, synonym := range n.Synonyms {
+		terms = append(terms, searchTerm{tokens: tokenize(synonym), weight: synonymWeight})
+	}
+
+	for _, keyword := range n.Keywords {
+		terms = append(terms, searchTerm{tokens: tokenize(keyword), weight: keywordWeight})
+	}
+
+	for _, crumb := range doc.Breadcrumbs {
+		terms = append(terms, searchTerm{tokens: tokenize(crumb), weight: breadcrumbWeight})
+	}
+
+	return terms
+}
+
+// scoreDocument sums the best match of every query word, a document only matches if every word matches some term
+func scoreDocument(doc *SearchDocument, words []string) (float64, bool) {
+	var total float64
+	for _, word := range words {
+		var best float64
+		for _, term := range doc.terms {
+			for _, token := range term.tokens {
+				best = max(best, matchToken(word, token)*term.weight)
+			}
+		}
+
+		if best == 0 {
+			return 0, false
+		}
+		total += best
+	}
+
+	return total, true
+}
+
+// matchToken scores how well a query word matches a token, exact matches score highest followed by prefixes,
+// substrings and finally tokens within a small edit distance of the word
+func matchToken(word, token string) float64 {
+	switch {
+	case word == token:
+		return 1
+	case strings.HasPrefix(token, word):
+		return 0.8
+	case strings.Contains(token, word):
+		return 0.5
+	}
+
+	w, t := []rune(word), []rune(token)
+	if len(w) < 4 {
+		return 0
+	}
+
+	allowed := 1
+	if len(w) >= 8 {
+		allowed = 2
+	}
+
+	// the word may be a misspelled prefix of the token while the user is still typing
+	distance := editDistance(w, t)
+	if len(t) > len(w) {
+		distance = min(distance, editDistance(w, t[:len(w)]))
+	}
+
+	if distance > allowed {
+		return 0
+	}
+
+	return 0.4 - 0.1*float64(distance-1)
+}
+
+// editDistance returns the levenshtein distance of two words
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// tokenize splits a text into lower case words
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// applySearchOverride replaces the fields of a document that are set on the override and returns whether the entry
+// is hidden
+func applySearchOverride(doc *SearchDocument, hidden bool, o *apptypes.NavigationOverride) bool {
+	if o == nil {
+		return hidden
+	}
+
+	if o.Title != nil {
+		doc.Title = *o.Title
+	}
+	if o.SubTitle != nil {
+		doc.SubTitle = *o.SubTitle
+	}
+	if o.Icon != nil {
+		doc.Icon = *o.Icon
+	}
+	if o.Category != nil {
+		doc.Category = *o.Category
+	}
+	if o.Hidden != nil {
+		hidden = *o.Hidden
+	}
+
+	return hidden
+}
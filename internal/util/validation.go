@@ -0,0 +1,381 @@
+package apputil
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/azarc-io/verathread-next-common/util"
+)
+
+// appNamePattern app names are used in /app/:appId routes and in colon delimited cache keys
+var appNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,62}$`)
+
+// ValidateRegistration validates an app registration, all problems are collected into a single validation error
+func ValidateRegistration(req *model.RegisterAppInput) error {
+	verr := &apptypes.ValidationError{}
+
+	if !appNamePattern.MatchString(req.Name) {
+		verr.Add("name", apptypes.FieldInvalid,
+			"must start with a letter or digit and only contain letters, digits, '.', '_' or '-' (max 63 characters)")
+	}
+
+	required(verr, "id", req.ID)
+	required(verr, "package", req.Package)
+	required(verr, "version", req.Version)
+	required(verr, "remoteEntryFile", req.RemoteEntryFile)
+	validateURL(verr, "webUrl", req.WebURL)
+	validateURL(verr, "apiUrl", req.APIURL)
+
+	paths := make(map[string]string)
+	for i, n := range req.Navigation {
+		field := fmt.Sprintf("navigation[%d]", i)
+		if n == nil {
+			verr.Add(field, apptypes.FieldRequired, "must not be null")
+			continue
+		}
+
+		required(verr, field+".title", n.Title)
+		required(verr, field+".icon", n.Icon)
+		required(verr, field+".category", n.Category)
+		validateModule(verr, field+".module", n.Module)
+
+		if n.Module != nil && n.Module.Path != "" {
+			if other, ok := paths[n.Module.Path]; ok {
+				verr.Add(field+".module.path", apptypes.FieldConflict, "path %s is also used by %s", n.Module.Path, other)
+			}
+			paths[n.Module.Path] = field
+		}
+
+		validateTranslations(verr, field+".translations", n.Translations)
+		validateChildren(verr, field, n.Children)
+	}
+
+	for i, slot := range req.Slots {
+		field := fmt.Sprintf("slots[%d]", i)
+		if slot == nil {
+			verr.Add(field, apptypes.FieldRequired, "must not be null")
+			continue
+		}
+
+		if slot.Slot == nil || *slot.Slot == "" {
+			verr.Add(field+".slot", apptypes.FieldRequired, "must not be empty")
+		}
+
+		if slot.Module == nil {
+			verr.Add(field+".module", apptypes.FieldRequired, "must not be null")
+			continue
+		}
+
+		required(verr, field+".module.path", slot.Module.Path)
+		required(verr, field+".module.exposedModule", slot.Module.ExposedModule)
+		required(verr, field+".module.moduleName", slot.Module.ModuleName)
+		validateTranslations(verr, field+".translations", slot.Translations)
+	}
+
+	validateShared(verr, req.Shared)
+
+	return verr.OrNil()
+}
+
+// MigrateLegacySlots moves the deprecated slot1, slot2 and slot3 fields of a registration into slots, they contribute
+// to the slot-1, slot-2 and slot-3 slots unless a slot name is given
+func MigrateLegacySlots(req *model.RegisterAppInput) {
+	for i, slot := range []*model.RegisterAppSlot{req.Slot1, req.Slot2, req.Slot3} {
+		if slot == nil {
+			continue
+		}
+
+		if slot.Slot == nil || *slot.Slot == "" {
+			slot.Slot = util.Ptr(apptypes.LegacySlotName(i + 1))
+		}
+
+		req.Slots = append(req.Slots, slot)
+	}
+
+	req.Slot1, req.Slot2, req.Slot3 = nil, nil, nil
+}
+
+// ValidateSlots checks that every slot contribution targets a slot defined in the gateway config, any slot name is
+// accepted when no slots are configured
+func ValidateSlots(req *model.RegisterAppInput, cfg *apptypes.NavigationConfig) error {
+	if cfg == nil || len(cfg.Slots) == 0 {
+		return nil
+	}
+
+	var (
+		verr    = &apptypes.ValidationError{}
+		defined = make(map[string]bool)
+	)
+
+	for _, d := range cfg.Slots {
+		defined[d.Name] = true
+	}
+
+	for i, slot := range req.Slots {
+		if slot.Slot != nil && !defined[*slot.Slot] {
+			verr.Add(fmt.Sprintf("slots[%d].slot", i), apptypes.FieldInvalid, "unknown slot %s", *slot.Slot)
+		}
+	}
+
+	return verr.OrNil()
+}
+
+// ValidateCategories checks the categories registered by an app against the categories that are already known and,
+// when the unknown category policy is reject, that every navigation entry references a known category
+func ValidateCategories(req *model.RegisterAppInput, apps []*apptypes.App, cfg *apptypes.NavigationConfig) error {
+	policy := UnknownCategoryPolicy(cfg)
+	switch policy {
+	case apptypes.CategoryPolicyFallback, apptypes.CategoryPolicyDrop, apptypes.CategoryPolicyReject:
+	default:
+		return fmt.Errorf("%w: %s", apptypes.ErrUnknownCategoryPolicy, policy)
+	}
+
+	var (
+		verr   = &apptypes.ValidationError{}
+		known  = make(map[string]bool)
+		others = slices.DeleteFunc(slices.Clone(apps), func(a *apptypes.App) bool {
+			return a.ID == req.Name
+		})
+	)
+
+	for _, c := range ResolveCategories(others, cfg) {
+		known[c.Key] = true
+	}
+
+	if len(req.Categories) > 0 && !CanRegisterCategories(req.Package, cfg) {
+		verr.Add("categories", apptypes.FieldInvalid, "package %s is not allowed to register categories", req.Package)
+	}
+
+	for i, c := range req.Categories {
+		field := fmt.Sprintf("categories[%d]", i)
+		required(verr, field+".key", c.Key)
+		required(verr, field+".title", c.Title)
+
+		if known[c.Key] {
+			verr.Add(field+".key", apptypes.FieldConflict, "category %s is already defined", c.Key)
+		}
+		known[c.Key] = true
+	}
+
+	if policy == apptypes.CategoryPolicyReject {
+		for i, n := range req.Navigation {
+			if n != nil && n.Category != "" && !known[n.Category] {
+				verr.Add(fmt.Sprintf("navigation[%d].category", i), apptypes.FieldInvalid, "unknown category %s", n.Category)
+			}
+		}
+	}
+
+	return verr.OrNil()
+}
+
+// ResolveConflicts checks the routes and slots of a registration against the other registered apps and against its
+// own earlier entries and applies the conflict policy, with first-wins and namespace the registration is modified in
+// place. Namespacing only separates an entry from other apps, an entry that repeats a route of the same registration is
+// rejected unless the first one wins
+func ResolveConflicts(req *model.RegisterAppInput, apps []*apptypes.App, policy string) error {
+	var (
+		verr   = &apptypes.ValidationError{}
+		routes = make(map[string]string)
+		slots  = make(map[string]string)
+	)
+
+	switch policy {
+	case "", apptypes.ConflictPolicyReject, apptypes.ConflictPolicyFirstWins, apptypes.ConflictPolicyNamespace:
+	default:
+		return fmt.Errorf("%w: %s", apptypes.ErrUnknownConflictPolicy, policy)
+	}
+
+	for _, app := range apps {
+		if app.ID == req.Name {
+			continue
+		}
+
+		for _, n := range app.Navigation {
+			if n.Module != nil {
+				routes[n.Module.Path] = app.Name
+			}
+		}
+
+		for _, slot := range app.Slots {
+			if slot.Module != nil {
+				slots[slotKey(slot.Slot, slot.Module.Path)] = app.Name
+			}
+		}
+	}
+
+	navigation := make([]*model.RegisterAppNavigationInput, 0, len(req.Navigation))
+	for i, n := range req.Navigation {
+		owner, conflict := routes[n.Module.Path]
+		if !conflict {
+			routes[n.Module.Path] = req.Name
+			navigation = append(navigation, n)
+			continue
+		}
+
+		switch {
+		case policy == apptypes.ConflictPolicyFirstWins:
+			// the app or entry that registered the route first keeps it, the entry is dropped
+		case policy == apptypes.ConflictPolicyNamespace && owner != req.Name:
+			n.Module.Path = namespacePath(req.Name, n.Module.Path)
+			routes[n.Module.Path] = req.Name
+			navigation = append(navigation, n)
+		default:
+			verr.Add(fmt.Sprintf("navigation[%d].module.path", i), apptypes.FieldConflict,
+				"path %s is already registered by %s", n.Module.Path, owner)
+		}
+	}
+	req.Navigation = navigation
+
+	contributions := make([]*model.RegisterAppSlot, 0, len(req.Slots))
+	for i, slot := range req.Slots {
+		owner, conflict := slots[slotKey(*slot.Slot, slot.Module.Path)]
+		if !conflict {
+			slots[slotKey(*slot.Slot, slot.Module.Path)] = req.Name
+			contributions = append(contributions, slot)
+			continue
+		}
+
+		switch {
+		case policy == apptypes.ConflictPolicyFirstWins:
+			// the app or contribution that added the module to the slot first keeps it, the contribution is dropped
+		case policy == apptypes.ConflictPolicyNamespace && owner != req.Name:
+			slot.Module.Path = namespacePath(req.Name, slot.Module.Path)
+			slots[slotKey(*slot.Slot, slot.Module.Path)] = req.Name
+			contributions = append(contributions, slot)
+		default:
+			verr.Add(fmt.Sprintf("slots[%d].module.path", i), apptypes.FieldConflict,
+				"slot path %s is already registered in %s by %s", slot.Module.Path, *slot.Slot, owner)
+		}
+	}
+	req.Slots = contributions
+
+	return verr.OrNil()
+}
+
+// slotKey identifies a module within a slot, the same path may be contributed to different slots
+func slotKey(slot, path string) string {
+	return slot + "|" + path
+}
+
+// validateChildren validates child navigation entries recursively
+func validateChildren(verr *apptypes.ValidationError, parent string, children []*model.RegisterChildAppNavigationInput) {
+	for i, child := range children {
+		field := fmt.Sprintf("%s.children[%d]", parent, i)
+		if child == nil {
+			verr.Add(field, apptypes.FieldRequired, "must not be null")
+			continue
+		}
+
+		required(verr, field+".title", child.Title)
+		required(verr, field+".icon", child.Icon)
+
+		// the path of the child is used when the module has none
+		module := child.Module
+		if module != nil && module.Path == "" && child.Path != "" {
+			module = &model.RegisterAppModule{
+				Path:          child.Path,
+				ExposedModule: module.ExposedModule,
+				ModuleName:    module.ModuleName,
+			}
+		}
+		validateModule(verr, field+".module", module)
+		validateTranslations(verr, field+".translations", child.Translations)
+		validateChildren(verr, field, child.Children)
+	}
+}
+
+// validateTranslations checks that every translation has a valid BCP-47 tag and that no locale is given twice
+func validateTranslations(verr *apptypes.ValidationError, field string, translations []*model.TranslationInput) {
+	seen := make(map[string]bool)
+	for i, t := range translations {
+		locale, err := CanonicalLocale(t.Locale)
+		if err != nil {
+			verr.Add(fmt.Sprintf("%s[%d].locale", field, i), apptypes.FieldInvalid, "must be a BCP-47 language tag")
+			continue
+		}
+
+		if seen[locale] {
+			verr.Add(fmt.Sprintf("%s[%d].locale", field, i), apptypes.FieldConflict, "locale %s is given more than once", locale)
+		}
+		seen[locale] = true
+	}
+}
+
+// validateShared checks that every shared dependency is named, versioned and only declared once
+func validateShared(verr *apptypes.ValidationError, shared []*model.RegisterSharedDependencyInput) {
+	seen := make(map[string]string)
+	for i, d := range shared {
+		field := fmt.Sprintf("shared[%d]", i)
+
+		required(verr, field+".name", d.Name)
+		required(verr, field+".version", d.Version)
+
+		if other, ok := seen[d.Name]; ok {
+			verr.Add(field+".name", apptypes.FieldConflict, "dependency %s is also declared by %s", d.Name, other)
+		}
+		seen[d.Name] = field
+
+		if d.URL != nil {
+			validateURL(verr, field+".url", *d.URL)
+		}
+	}
+}
+
+// validateModule checks that all module fields needed to load a remote module are set
+func validateModule(verr *apptypes.ValidationError, field string, m *model.RegisterAppModule) {
+	if m == nil {
+		verr.Add(field, apptypes.FieldRequired, "must not be null")
+		return
+	}
+
+	required(verr, field+".path", m.Path)
+	required(verr, field+".exposedModule", m.ExposedModule)
+	required(verr, field+".moduleName", m.ModuleName)
+}
+
+// validateURL checks that a value is an absolute url
+func validateURL(verr *apptypes.ValidationError, field, value string) {
+	if value == "" {
+		verr.Add(field, apptypes.FieldRequired, "must not be empty")
+		return
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		verr.Add(field, apptypes.FieldInvalid, "must be an absolute http or https url")
+	}
+}
+
+// ValidateNavigationOverride validates a navigation override, all problems are collected into a single validation error
+func ValidateNavigationOverride(req *model.SetNavigationOverrideInput) error {
+	verr := &apptypes.ValidationError{}
+
+	required(verr, "id", req.ID)
+
+	if req.Title != nil {
+		required(verr, "title", *req.Title)
+	}
+
+	if req.Category != nil {
+		required(verr, "category", *req.Category)
+	}
+
+	return verr.OrNil()
+}
+
+// required records an error if a value is blank
+func required(verr *apptypes.ValidationError, field, value string) {
+	if strings.TrimSpace(value) == "" {
+		verr.Add(field, apptypes.FieldRequired, "must not be empty")
+	}
+}
+
+// namespacePath prefixes a path with the app name
+func namespacePath(app, path string) string {
+	return app + "/" + strings.TrimPrefix(path, "/")
+}
@@ -0,0 +1,80 @@
+package apputil
+
+import (
+	"testing"
+
+	"github.com/azarc-io/verathread-gateway/internal/gql/graph/common/model"
+	apptypes "github.com/azarc-io/verathread-gateway/internal/types"
+	"github.com/azarc-io/verathread-next-common/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveConflicts(t *testing.T) {
+	// other already owns /orders and the /orders module of the header slot
+	other := &apptypes.App{
+		ID:         "other",
+		Name:       "other",
+		Navigation: []*apptypes.Navigation{{Module: &apptypes.NavigationModule{Path: "/orders"}}},
+		Slots:      []*apptypes.NavigationSlot{{Slot: "header", Module: &apptypes.NavigationSlotModule{Path: "/orders"}}},
+	}
+
+	tests := []struct {
+		name       string
+		policy     string
+		navigation []string
+		slots      []string
+		wantErr    bool
+		wantNav    []string
+		wantSlots  []string
+	}{
+		{name: "no conflict", navigation: []string{"/billing"}, slots: []string{"/billing"},
+			wantNav: []string{"/billing"}, wantSlots: []string{"/billing"}},
+		{name: "reject other app", policy: apptypes.ConflictPolicyReject, navigation: []string{"/orders"}, wantErr: true},
+		{name: "reject other app slot", policy: apptypes.ConflictPolicyReject, slots: []string{"/orders"}, wantErr: true},
+		{name: "reject own duplicate slot", policy: apptypes.ConflictPolicyReject, slots: []string{"/billing", "/billing"}, wantErr: true},
+		{name: "first wins drops other app", policy: apptypes.ConflictPolicyFirstWins,
+			navigation: []string{"/orders", "/billing"}, slots: []string{"/orders"},
+			wantNav: []string{"/billing"}, wantSlots: []string{}},
+		{name: "first wins keeps first own slot", policy: apptypes.ConflictPolicyFirstWins,
+			slots: []string{"/billing", "/billing"}, wantNav: []string{}, wantSlots: []string{"/billing"}},
+		{name: "namespace other app", policy: apptypes.ConflictPolicyNamespace,
+			navigation: []string{"/orders"}, slots: []string{"/orders"},
+			wantNav: []string{"app/orders"}, wantSlots: []string{"app/orders"}},
+		{name: "namespace rejects own duplicate slot", policy: apptypes.ConflictPolicyNamespace,
+			slots: []string{"/billing", "/billing"}, wantErr: true},
+		{name: "namespaced path is claimed", policy: apptypes.ConflictPolicyNamespace,
+			slots: []string{"/orders", "app/orders"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &model.RegisterAppInput{Name: "app"}
+			for _, path := range tt.navigation {
+				req.Navigation = append(req.Navigation, &model.RegisterAppNavigationInput{Module: &model.RegisterAppModule{Path: path}})
+			}
+			for _, path := range tt.slots {
+				req.Slots = append(req.Slots, &model.RegisterAppSlot{Slot: util.Ptr("header"), Module: &model.RegisterAppSlotModule{Path: path}})
+			}
+
+			err := ResolveConflicts(req, []*apptypes.App{other}, tt.policy)
+			if tt.wantErr {
+				var verr *apptypes.ValidationError
+				assert.ErrorAs(t, err, &verr)
+				return
+			}
+			assert.NoError(t, err)
+
+			nav := []string{}
+			for _, n := range req.Navigation {
+				nav = append(nav, n.Module.Path)
+			}
+			slots := []string{}
+			for _, s := range req.Slots {
+				slots = append(slots, s.Module.Path)
+			}
+
+			assert.Equal(t, tt.wantNav, nav)
+			assert.Equal(t, tt.wantSlots, slots)
+		})
+	}
+}